@@ -66,11 +66,20 @@
 //
 // Try it on the Go Playground: https://go.dev/play/p/3CNStlPoWmE
 //
-// Note that this library does not handle Unicode-normalized collation; that
-// may be covered at a later time but for now it was deemed too much to
-// implement for version 1.0, given the large amount of data from Unicode that
-// must be added to the library for it to function and possible dependence on
-// locale-specific settings.
+// Where text must be in a single consistent representation, such as before it
+// is compared, searched, or stored, [Editor.Normalize] can be used to convert
+// it to one of the normalization forms in UAX #15. The Normalization member of
+// [Options] can be set to have text normalized automatically as it is added to
+// an Editor.
+//
+//	// both are converted to "fianc\u00e9e"
+//	normalized1 := rosed.Edit("fianc\u00e9e").Normalize(rosed.NFC).String()
+//	normalized2 := rosed.Edit("fiance\u0301e").Normalize(rosed.NFC).String()
+//
+// Note that this library does not handle Unicode collation; that may be covered
+// at a later time but for now it was deemed too much to implement, given the
+// large amount of data from Unicode that must be added to the library for it to
+// function and possible dependence on locale-specific settings.
 //
 // # Basic Usage
 //
@@ -169,7 +178,9 @@
 //
 // To edit only a portion of the text in an Editor, a sub-editor can be created
 // using [Editor.Chars], [Editor.CharsFrom], [Editor.CharsTo], [Editor.Lines],
-// [Editor.LinesFrom], or [Editor.LinesTo]. The Editor retured from these
+// [Editor.LinesFrom], [Editor.LinesTo], [Editor.Sentences],
+// [Editor.SentencesFrom], [Editor.SentencesTo], [Editor.Words],
+// [Editor.WordsFrom], or [Editor.WordsTo]. The Editor retured from these
 // functions will perform operations only on the section specified, and any
 // positions or lengths used in it will be relative to that sub-section's start
 // and end. Writing past the end of the sub-editor's text is allowed and does
@@ -239,9 +250,10 @@
 //
 // The rosed package supports several operations on Editor text out of the box,
 // however these are insufficient for all uses. To address cases where custom
-// functionality may be needed, two functions are provided. They are
-// [Editor.Apply] and [Editor.ApplyParagraphs]. These allow the user to provide
-// a custom function to operate on text on a per-line or per-paragraph basis.
+// functionality may be needed, three functions are provided. They are
+// [Editor.Apply], [Editor.ApplyParagraphs], and [Editor.ApplySentences]. These
+// allow the user to provide a custom function to operate on text on a
+// per-line, per-paragraph, or per-sentence basis.
 //
 //	textBody := "John\nRose\nDave\nJade"
 //
@@ -258,9 +270,9 @@
 //	// Beta Kid #3: Dave
 //	// Beta Kid #4: Jade
 //
-// Do note that as the [LineOperation] or [ParagraphOperation] passed to the
-// above functions will be user-defined, they will not be grapheme-aware unless
-// the user ensures that this is the case.
+// Do note that as the [LineOperation], [ParagraphOperation], or
+// [SentenceOperation] passed to the above functions will be user-defined, they
+// will not be grapheme-aware unless the user ensures that this is the case.
 //
 // [UAX #15: Unicode Normalization Forms]: https://unicode.org/reports/tr15/
 // [UAX #29: Unicode Text Segmentation]: https://unicode.org/reports/tr29/
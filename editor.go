@@ -2,8 +2,10 @@ package rosed
 
 import (
 	"strings"
+	"unicode/utf8"
 
 	"github.com/dekarrin/rosed/internal/gem"
+	"github.com/dekarrin/rosed/internal/manip"
 )
 
 // This file contains basic structural elements of Editor as well as functions
@@ -101,6 +103,17 @@ func (ed Editor) LineCount() int {
 	return len(ed.lines())
 }
 
+// SentenceCount returns the number of sentences in the Editor's text. Sentences
+// are found the same way as in [Editor.Sentences].
+//
+// This function is affected by the following [Options]:
+//
+//   - LineSeparator is treated as a space within paragraphs.
+//   - ParagraphSeparator specifies the string that paragraphs are split by.
+func (ed Editor) SentenceCount() int {
+	return len(ed.sentenceIndexes())
+}
+
 // WithOptions returns an Editor identical to the current one but with its
 // Options replaced by the given Options. This does not affect the Editor
 // it was called on.
@@ -116,6 +129,13 @@ func (ed Editor) WithOptions(opts Options) Editor {
 	return ed
 }
 
+// WordCount returns the number of words in the Editor's text. Words are found
+// the same way as in [Editor.Words]; any spaces and punctuation between them
+// are not counted.
+func (ed Editor) WordCount() int {
+	return len(ed.wordIndexes())
+}
+
 func (ed Editor) lines() []string {
 	return ed.linesSep(ed.Options.WithDefaults().LineSeparator)
 }
@@ -133,3 +153,126 @@ func (ed Editor) linesSep(sep string) []string {
 	}
 	return lines
 }
+
+// gets the byte start and end indexes of every word in the text. The end
+// indexes are exclusive.
+func (ed Editor) wordIndexes() [][]int {
+	return ed.byteIndexes(gem.WordIndexes([]rune(ed.Text)))
+}
+
+// gets the byte start and end indexes of every sentence in the text. The end
+// indexes are exclusive. Each paragraph is split into sentences on its own.
+func (ed Editor) sentenceIndexes() [][]int {
+	opts := ed.Options.WithDefaults()
+
+	var runeIndexes [][]int
+	paraStart := 0
+	for _, para := range strings.Split(ed.Text, opts.ParagraphSeparator) {
+		for _, idx := range paragraphSentenceIndexes(para, opts) {
+			runeIndexes = append(runeIndexes, []int{paraStart + idx[0], paraStart + idx[1]})
+		}
+		paraStart += utf8.RuneCountInString(para) + utf8.RuneCountInString(opts.ParagraphSeparator)
+	}
+	return ed.byteIndexes(runeIndexes)
+}
+
+// gets the rune start and end indexes of every sentence in the given paragraph.
+// The end indexes are exclusive. Each LineSeparator in the paragraph is treated
+// as spaces, so that a sentence is not ended by a line being wrapped.
+func paragraphSentenceIndexes(para string, opts Options) [][]int {
+	sepLen := utf8.RuneCountInString(opts.LineSeparator)
+	spaced := strings.ReplaceAll(para, opts.LineSeparator, strings.Repeat(" ", sepLen))
+	return gem.SentenceIndexes([]rune(spaced))
+}
+
+// converts pairs of rune start and end indexes within the text to pairs of byte
+// start and end indexes.
+func (ed Editor) byteIndexes(runeIndexes [][]int) [][]int {
+	byteIdx := make([]int, 0, len(ed.Text)+1)
+	for b := range ed.Text {
+		byteIdx = append(byteIdx, b)
+	}
+	byteIdx = append(byteIdx, len(ed.Text))
+
+	indexes := make([][]int, len(runeIndexes))
+	for i := range runeIndexes {
+		indexes[i] = []int{byteIdx[runeIndexes[i][0]], byteIdx[runeIndexes[i][1]]}
+	}
+	return indexes
+}
+
+// insert adds text at the given position without any processing of the text.
+func (ed Editor) insert(charPos int, text string) Editor {
+	before := ed.CharsTo(charPos).Text
+	after := ed.CharsFrom(charPos).Text
+
+	ed.Text = before + text + after
+	return ed
+}
+
+// insertTable adds the table created from spec at the given position, followed
+// by a LineSeparator unless opts has NoTrailingLineSeparators set. opts must
+// already have had its defaults set.
+func (ed Editor) insertTable(pos int, spec manip.TableSpec, opts Options) Editor {
+	tableBlock := manip.MakeTableFromSpec(spec, gem.New(opts.LineSeparator), opts.layout())
+	table := tableBlock.Join().String()
+
+	if !opts.NoTrailingLineSeparators && len(table) > 0 {
+		table += opts.LineSeparator
+	}
+
+	return ed.insert(pos, table)
+}
+
+// columnWidths gives the width of each of numCols columns that are laid out
+// side by side with minSpaceBetween between each pair of them so that they
+// take up the given width. The first columns are sized by colPercents as in
+// InsertColumns and the rest evenly share what remains, with those to the left
+// given the extra when it cannot be shared evenly. No column is narrower than
+// 2.
+func columnWidths(numCols int, colPercents []float64, minSpaceBetween int, width int) []int {
+	// it unreasonable to wrap each column to anything less than 2; need at
+	// least 1 char for the next in a word and 1 for a continuation dash.
+	minColWidth := 2
+	minWidth := minSpaceBetween*(numCols-1) + minColWidth*numCols
+	if width < minWidth {
+		width = minWidth
+	}
+
+	avail := width - minSpaceBetween*(numCols-1)
+	remaining := avail
+
+	widths := make([]int, numCols)
+	sized := 0
+	for ; sized < numCols && sized < len(colPercents); sized++ {
+		pct := colPercents[sized]
+		if pct < 0.0 {
+			pct = 0.0
+		}
+		if pct > 1.0 {
+			pct = 1.0
+		}
+		widths[sized] = int(float64(avail) * pct)
+		if widths[sized] < minColWidth {
+			widths[sized] = minColWidth
+		}
+		remaining -= widths[sized]
+	}
+
+	if unsized := numCols - sized; unsized > 0 {
+		if remaining < 0 {
+			remaining = 0
+		}
+		for i := sized; i < numCols; i++ {
+			widths[i] = remaining / unsized
+			if i-sized < remaining%unsized {
+				widths[i]++
+			}
+			if widths[i] < minColWidth {
+				widths[i] = minColWidth
+			}
+		}
+	}
+
+	return widths
+}
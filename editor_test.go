@@ -206,3 +206,58 @@ func Test_Editor_LineCount(t *testing.T) {
 		})
 	}
 }
+
+func Test_Editor_SentenceCount(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expect int
+	}{
+		{"empty string", "", 0},
+		{"only whitespace", "  \n ", 0},
+		{"one sentence", "This is a test.", 1},
+		{"no terminator", "this is a test", 1},
+		{"multiple sentences", "One. Two? Three!", 3},
+		{"abbreviations and decimals", "See e.g. the docs. It costs 3.50 dollars.", 2},
+		{"wrapped lines", "This is a wrapped\nparagraph that goes on. It has\ntwo sentences.", 2},
+		{"paragraphs", "First paragraph\n\nSecond paragraph", 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := Edit(tc.input).SentenceCount()
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_Editor_WordCount(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expect int
+	}{
+		{"empty string", "", 0},
+		{"one word", "test", 1},
+		{"multiple words", "some testing text", 3},
+		{"punctuation is not counted", "Hello, world! How are you?", 5},
+		{"only punctuation", "... ?!", 0},
+		{"contraction", "can't won't", 2},
+		{"numbers", "3.14 and 3,456.789", 3},
+		{"hyphenated", "well-known", 2},
+		{"ideographs", "日本語", 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := Edit(tc.input).WordCount()
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
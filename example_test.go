@@ -2,6 +2,7 @@ package rosed
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -259,6 +260,52 @@ func ExampleEditor_ApplyParagraphsOpts() {
 }
 
 // This example gets the length of several different strings.
+// This example rewrites the first sentence of each paragraph and leaves the
+// rest of the sentences as they are.
+func ExampleEditor_ApplySentences() {
+	text := "Added Wrap. It wraps text at 3.5 cm, e.g. for labels.\n\n"
+	text += "Fixed Justify. It no longer panics. Oops!"
+
+	ed := Edit(text)
+
+	sentenceOp := func(paraIdx, idx int, sentence string) []string {
+		if idx == 0 {
+			return []string{fmt.Sprintf("(%d) %s", paraIdx+1, strings.ToUpper(sentence))}
+		}
+		return []string{sentence}
+	}
+
+	ed = ed.ApplySentences(sentenceOp)
+
+	fmt.Println(ed.String())
+	// Output:
+	// (1) ADDED WRAP. It wraps text at 3.5 cm, e.g. for labels.
+	//
+	// (2) FIXED JUSTIFY. It no longer panics. Oops!
+}
+
+// This example keeps only the first sentence of each paragraph, where
+// paragraphs are separated by a custom ParagraphSeparator.
+func ExampleEditor_ApplySentencesOpts() {
+	opts := Options{ParagraphSeparator: "\n---\n"}
+	ed := Edit("First one. More details.\n---\nSecond one! Even more details.")
+
+	sentenceOp := func(paraIdx, idx int, sentence string) []string {
+		if idx > 0 {
+			return nil
+		}
+		return []string{sentence}
+	}
+
+	ed = ed.ApplySentencesOpts(sentenceOp, opts)
+
+	fmt.Println(ed.String())
+	// Output:
+	// First one.
+	// ---
+	// Second one!
+}
+
 func ExampleEditor_CharCount() {
 	emptyCount := Edit("").CharCount()
 	fmt.Println(emptyCount)
@@ -505,6 +552,25 @@ func ExampleEditor_Insert() {
 	// Output: Sburb world!
 }
 
+// This example shows how normalization can be used to make inserted text use
+// the same representation of its characters as the text it is inserted into.
+// Without normalization, the inserted "é" would be made of two runes.
+func ExampleEditor_InsertOpts() {
+	ed := Edit("fiancée")
+
+	opts := Options{
+		Normalization: NFC,
+	}
+
+	ed = ed.InsertOpts(0, "Ma chérie, ma fiance\u0301e: ", opts)
+
+	fmt.Println(ed.String())
+	fmt.Println(ed.String() == "Ma ch\u00e9rie, ma fianc\u00e9e: fianc\u00e9e")
+	// Output:
+	// Ma chérie, ma fiancée: fiancée
+	// true
+}
+
 // This example produces the table seen above.
 func ExampleEditor_InsertDefinitionsTable() {
 	ed := Edit("")
@@ -725,6 +791,134 @@ func ExampleEditor_InsertTableOpts_tableBordersAndHeaders() {
 	// +-------------+-------------+---------+----------+--------------+
 }
 
+// This example shows the use of the TableAlignments option to align the
+// columns of a table. Columns with Decimal alignment have their numbers lined up
+// on the decimal point.
+func ExampleEditor_InsertTableOpts_tableAlignments() {
+	data := [][]string{
+		{"Item", "Unit Cost", "Total", "Qty"},
+		{"Widget", "1.5", "4.50", "3"},
+		{"Gizmo", "10.25", "123", "12"},
+		{"Thingamajig", "100", "100.125", "1"},
+	}
+
+	opts := Options{
+		TableHeaders:    true,
+		TableHeaderCase: HeaderTitle,
+		TableAlignments: NewAlignments(Left, Decimal, Decimal, Right),
+	}
+
+	const width = 45
+	const position = 0
+
+	ed := Edit("").InsertTableOpts(position, data, width, opts)
+
+	fmt.Println(ed.String())
+	// Output:
+	// Item               Unit Cost       Total  Qty
+	// ---------------------------------------------
+	// Widget                  1.5        4.50     3
+	// Gizmo                  10.25     123       12
+	// Thingamajig           100        100.125    1
+}
+
+// This example shows the use of the TableStyle option to draw a table with
+// box-drawing characters.
+func ExampleEditor_InsertTableOpts_tableStyle() {
+	data := [][]string{
+		{"Name", "Class", "Aspect"},
+		{"John", "Heir", "Breath"},
+		{"Rose", "Seer", "Light"},
+	}
+
+	opts := Options{
+		TableHeaders: true,
+		TableBorders: true,
+		TableStyle:   TableStyleRounded,
+	}
+
+	const width = 30
+	const position = 0
+
+	ed := Edit("").InsertTableOpts(position, data, width, opts)
+
+	fmt.Println(ed.String())
+	// Output:
+	// ╭────────┬─────────┬─────────╮
+	// │  NAME  │  CLASS  │  ASPECT │
+	// ├────────┼─────────┼─────────┤
+	// │ John   │ Heir    │ Breath  │
+	// │ Rose   │ Seer    │ Light   │
+	// ╰────────┴─────────┴─────────╯
+}
+
+func ExampleEditor_InsertTableCells() {
+	data := [][]Cell{
+		{{Text: "Region"}, {Text: "Q1", ColSpan: 2}, {Text: "Q2", ColSpan: 2}},
+		{{Text: ""}, {Text: "Jan"}, {Text: "Feb"}, {Text: "Apr"}, {Text: "May"}},
+		{{Text: "North", RowSpan: 2}, {Text: "10"}, {Text: "20"}, {Text: "30"}, {Text: "40"}},
+		{{Text: "11"}, {Text: "21"}, {Text: "31"}, {Text: "41"}},
+		{{Text: "South"}, {Text: "12"}, {Text: "22"}, {Text: "32"}, {Text: "42"}},
+	}
+
+	opts := Options{
+		TableHeaders: true,
+		TableBorders: true,
+	}
+
+	const width = 40
+	const position = 0
+
+	ed := Edit("").WithOptions(opts).InsertTableCells(position, data, width)
+
+	fmt.Println(ed.String())
+	// Output:
+	// +----------+-------------+-------------+
+	// |  REGION  |      Q1     |      Q2     |
+	// +----------+------+------+------+------+
+	// |          | Jan  | Feb  | Apr  | May  |
+	// | North    | 10   | 20   | 30   | 40   |
+	// |          | 11   | 21   | 31   | 41   |
+	// | South    | 12   | 22   | 32   | 42   |
+	// +----------+------+------+------+------+
+}
+
+// This example shows the use of the TableFit option to make a table fit within
+// its width by wrapping the content of its widest column. TableNoWrapColumns
+// keeps the last column from being narrowed.
+func ExampleEditor_InsertTableOpts_tableFit() {
+	data := [][]string{
+		{"Name", "Description", "Code"},
+		{"Widget", "A small device used for holding other devices together", "W-1234"},
+		{"Gizmo", "Does something useful, probably", "G-88"},
+	}
+
+	opts := Options{
+		TableHeaders:       true,
+		TableBorders:       true,
+		TableFit:           true,
+		TableNoWrapColumns: NewColumnIndexes(2),
+	}
+
+	const width = 40
+	const position = 0
+
+	ed := Edit("").InsertTableOpts(position, data, width, opts)
+
+	fmt.Println(ed.String())
+	// Output:
+	// +---------+-------------------+--------+
+	// |   NAME  |    DESCRIPTION    |  CODE  |
+	// +---------+-------------------+--------+
+	// | Widget  | A small device    | W-1234 |
+	// |         | used for holding  |        |
+	// |         | other devices     |        |
+	// |         | together          |        |
+	// | Gizmo   | Does something    | G-88   |
+	// |         | useful, probably  |        |
+	// +---------+-------------------+--------+
+}
+
 // This example shows how to override the character set used to draw table
 // borders and horizontal rules within the table.
 func ExampleEditor_InsertTableOpts_tableCharSet() {
@@ -794,6 +988,244 @@ func ExampleEditor_InsertTableOpts_tableCharSet() {
 	// Lalonde    Rose
 }
 
+// This example creates a table in the form of a GitHub-flavored Markdown pipe
+// table, with the alignment of each column given by TableAlignments.
+func ExampleEditor_InsertTableOpts_tableMarkdown() {
+	data := [][]string{
+		{"Name", "Class", "Level"},
+		{"John Egbert", "Heir of Breath", "413"},
+		{"Rose Lalonde", "Seer of Light|Void", "612"},
+	}
+
+	opts := Options{
+		TableFormat:     TableFormatMarkdown,
+		TableAlignments: NewAlignments(Left, Center, Right),
+	}
+
+	const width = 0
+	const position = 0
+
+	ed := Edit("").InsertTableOpts(position, data, width, opts)
+
+	fmt.Println(ed.String())
+	// Output:
+	// | Name         |        Class        | Level |
+	// |:-------------|:-------------------:|------:|
+	// | John Egbert  |    Heir of Breath   |   413 |
+	// | Rose Lalonde | Seer of Light\|Void |   612 |
+}
+
+// This example creates the same table in several formats for use in
+// documentation.
+func ExampleEditor_InsertTableOpts_tableFormat() {
+	data := [][]string{
+		{"Name", "Class"},
+		{"John Egbert", "Heir of Breath"},
+		{"Rose Lalonde", "Seer of Light"},
+	}
+
+	opts := Options{
+		TableHeaders:    true,
+		TableHeaderCase: HeaderAsIs,
+	}
+
+	const width = 0
+	const position = 0
+
+	for _, format := range []TableFormat{TableFormatRSTGrid, TableFormatHTML, TableFormatLaTeX} {
+		ed := Edit("").InsertTableOpts(position, data, width, opts.WithTableFormat(format))
+		fmt.Println(ed.String())
+	}
+	// Output:
+	// +--------------+----------------+
+	// |     Name     |      Class     |
+	// +==============+================+
+	// | John Egbert  | Heir of Breath |
+	// +--------------+----------------+
+	// | Rose Lalonde | Seer of Light  |
+	// +--------------+----------------+
+	//
+	// <table>
+	//   <thead>
+	//     <tr>
+	//       <th>Name</th>
+	//       <th>Class</th>
+	//     </tr>
+	//   </thead>
+	//   <tbody>
+	//     <tr>
+	//       <td>John Egbert</td>
+	//       <td>Heir of Breath</td>
+	//     </tr>
+	//     <tr>
+	//       <td>Rose Lalonde</td>
+	//       <td>Seer of Light</td>
+	//     </tr>
+	//   </tbody>
+	// </table>
+	//
+	// \begin{tabular}{ll}
+	// Name & Class \\
+	// \hline
+	// John Egbert & Heir of Breath \\
+	// Rose Lalonde & Seer of Light \\
+	// \end{tabular}
+}
+
+func ExampleEditor_InsertTableOpts_tableColumnWidths() {
+	data := [][]string{
+		{"Name", "Class", "Notes"},
+		{"John Egbert", "Heir of Breath", "Likes magic tricks and bad movies"},
+		{"Rose Lalonde", "Seer of Light", "Writes wizard fiction"},
+	}
+
+	opts := Options{
+		TableHeaders: true,
+		TableBorders: true,
+	}.WithTableColumnWidths(
+		ColumnWidth{Fixed: 14},
+		ColumnWidth{Percent: 30},
+		ColumnWidth{Max: 20},
+	)
+
+	const width = 60
+	const position = 0
+
+	ed := Edit("").InsertTableOpts(position, data, width, opts)
+
+	fmt.Println(ed.String())
+	// Output:
+	// +----------------+-----------------+----------------------+
+	// |      NAME      |      CLASS      |         NOTES        |
+	// +----------------+-----------------+----------------------+
+	// | John Egbert    | Heir of Breath  | Likes magic tricks   |
+	// |                |                 | and bad movies       |
+	// | Rose Lalonde   | Seer of Light   | Writes wizard        |
+	// |                |                 | fiction              |
+	// +----------------+-----------------+----------------------+
+}
+
+func ExampleEditor_InsertTableOpts_tableExpand() {
+	data := [][]string{
+		{"Name", "Class", "Planet"},
+		{"John Egbert", "Heir of Breath", "Land of Wind and Shade"},
+		{"Rose Lalonde", "Seer of Light", "Land of Light and Rain"},
+	}
+
+	opts := Options{
+		TableHeaders: true,
+		TableBorders: true,
+		TableExpand:  ExpandAuto,
+	}
+
+	const width = 30
+	const position = 0
+
+	ed := Edit("").InsertTableOpts(position, data, width, opts)
+
+	fmt.Println(ed.String())
+	// Output:
+	// +-[ RECORD 1 ]---------------+
+	// | NAME   | John Egbert       |
+	// | CLASS  | Heir of Breath    |
+	// | PLANET | Land of Wind and  |
+	// |        | Shade             |
+	// +-[ RECORD 2 ]---------------+
+	// | NAME   | Rose Lalonde      |
+	// | CLASS  | Seer of Light     |
+	// | PLANET | Land of Light and |
+	// |        | Rain              |
+	// +--------+-------------------+
+}
+
+func ExampleEditor_InsertTableObj() {
+	table := Table{}.
+		SetHeader("Name", "Sessions").
+		AddRow("John Egbert", "12").
+		AddRow("Rose Lalonde", "9").
+		AddSeparator().
+		AddRow("Jade Harley", "7").
+		SetFooter("Total", "28")
+
+	const width = 0
+	const position = 0
+
+	ed := Edit("").WithOptions(Options{TableBorders: true, TableStyle: TableStyleLight})
+	ed = ed.InsertTableObj(position, table, width)
+
+	fmt.Println(ed.String())
+	// Output:
+	// ┌──────────────┬──────────┐
+	// │     NAME     │ SESSIONS │
+	// ├──────────────┼──────────┤
+	// │ John Egbert  │ 12       │
+	// │ Rose Lalonde │ 9        │
+	// ├──────────────┼──────────┤
+	// │ Jade Harley  │ 7        │
+	// ├──────────────┼──────────┤
+	// │ Total        │ 28       │
+	// └──────────────┴──────────┘
+}
+
+func ExampleEditor_InsertStructTable() {
+	type kid struct {
+		Name       string
+		Title      string  `rosed:"Class"`
+		Height     float64 `rosed:",align=decimal"`
+		Chumhandle string  `rosed:"-"`
+	}
+
+	kids := []kid{
+		{Name: "John Egbert", Title: "Heir of Breath", Height: 1.6, Chumhandle: "ectoBiologist"},
+		{Name: "Rose Lalonde", Title: "Seer of Light", Height: 1.55, Chumhandle: "tentacleTherapist"},
+	}
+
+	const width = 0
+	const position = 0
+
+	ed, err := Edit("").WithOptions(Options{TableBorders: true}).InsertStructTable(position, kids, width)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(ed.String())
+	// Output:
+	// +--------------+----------------+--------+
+	// |     NAME     |      CLASS     | HEIGHT |
+	// +--------------+----------------+--------+
+	// | John Egbert  | Heir of Breath |   1.6  |
+	// | Rose Lalonde | Seer of Light  |   1.55 |
+	// +--------------+----------------+--------+
+}
+
+func ExampleEditor_InsertTableFromCSV() {
+	csvData := "Name,Title,Species\n"
+	csvData += "John Egbert,\"Heir of Breath\",Human\n"
+	csvData += "Kanaya Maryam,\"Sylph of Space\",Troll\n"
+
+	opts := Options{
+		TableHeaders: true,
+		TableBorders: true,
+	}
+
+	const width = 50
+	const position = 0
+
+	ed, err := Edit("").WithOptions(opts).InsertTableFromCSV(position, strings.NewReader(csvData), width)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(ed.String())
+	// Output:
+	// +-----------------+------------------+-----------+
+	// |       NAME      |       TITLE      |  SPECIES  |
+	// +-----------------+------------------+-----------+
+	// | John Egbert     | Heir of Breath   | Human     |
+	// | Kanaya Maryam   | Sylph of Space   | Troll     |
+	// +-----------------+------------------+-----------+
+}
+
 // This example uses the rosed.End constant to specify the end of the text as
 // the editor is modified.
 func Example_end() {
@@ -809,6 +1241,66 @@ func Example_end() {
 	// And this is another
 }
 
+// This example creates three columns from three runs of text, with each
+// column taking up an even share of the width.
+func ExampleEditor_InsertColumns() {
+	texts := []string{
+		"Your name is KARKAT VANTAS.",
+		"You have a passion for REALLY TERRIBLE ROMANTIC COMEDIES.",
+		"You spend most of your time yelling at your FRIENDS in a trolling client.",
+	}
+
+	// insert it at the start of the editor
+	pos := 0
+
+	// minimum 3 spaces between each column at their closest point
+	minSpace := 3
+
+	// wrap the entire layout to 50 chars
+	width := 50
+
+	ed := Edit("").InsertColumns(pos, texts, nil, minSpace, width)
+
+	fmt.Println(ed.String())
+	// Output:
+	// Your name is      You have a        You spend most
+	// KARKAT VANTAS.    passion for       of your time
+	//                   REALLY TERRIBLE   yelling at
+	//                   ROMANTIC          your FRIENDS
+	//                   COMEDIES.         in a trolling
+	//                                     client.
+}
+
+// This example flows a single run of text across three columns, newspaper
+// style.
+func ExampleEditor_InsertFlowColumns() {
+	text := "A young man stands in his bedroom. It just so happens that "
+	text += "today, the 13th of April, 2009, is this young man's birthday. "
+	text += "Though it was thirteen years ago he was given life, it is "
+	text += "only today he will be given a name!"
+
+	// insert it at the start of the editor
+	pos := 0
+
+	// lay the text out in 3 columns
+	numCols := 3
+
+	// minimum 3 spaces between each column at their closest point
+	minSpace := 3
+
+	// wrap the entire layout to 66 chars
+	width := 66
+
+	ed := Edit("").InsertFlowColumns(pos, text, numCols, minSpace, width)
+
+	fmt.Println(ed.String())
+	// Output:
+	// A young man stands     April, 2009, is this   ago he was given
+	// in his bedroom. It     young man's            life, it is only
+	// just so happens that   birthday. Though it    today he will be
+	// today, the 13th of     was thirteen years     given a name!
+}
+
 // This example creates two columns from two runs of text.
 func ExampleEditor_InsertTwoColumns() {
 	leftText := "Karkalicious, definition: makes Terezi loco. "
@@ -1041,6 +1533,22 @@ func ExampleEditor_LinesTo() {
 	// Act 3
 }
 
+// This example shows how text containing the same word in two different
+// representations can be normalized so that the word can be searched for
+// consistently.
+func ExampleEditor_Normalize() {
+	ed := Edit("fianc\u00e9e and fiance\u0301e")
+
+	fmt.Println(strings.Count(ed.String(), "fianc\u00e9e"))
+
+	ed = ed.Normalize(NFC)
+
+	fmt.Println(strings.Count(ed.String(), "fianc\u00e9e"))
+	// Output:
+	// 1
+	// 2
+}
+
 // This example uses Overtype to replace a part of a greeting message. This
 // works so nicely in the example because the replacement is the exact same
 // length as the replaced text. If it were of a longer length, it would end up
@@ -1055,46 +1563,242 @@ func ExampleEditor_Overtype() {
 	// Output: How goes it, Miss Lalonde?
 }
 
-// This example uses String on a normal Editor to get its text.
-func ExampleEditor_String() {
-	ed := Edit("Some text")
+// This example shows how normalization with a compatibility form replaces
+// characters such as the fullwidth letters inserted below with their plain
+// equivalents.
+func ExampleEditor_OvertypeOpts() {
+	ed := Edit("How are you, Miss Lalonde?")
 
-	text := ed.String()
+	opts := Options{
+		Normalization: NFKC,
+	}
 
-	fmt.Println(text)
-	// Output: Some text
+	ed = ed.OvertypeOpts(4, "ｇｏｅｓ ｉｔ", opts)
+
+	fmt.Println(ed.String())
+	// Output: How goes it, Miss Lalonde?
 }
 
-// This example uses String on a sub-editor to avoid having to explicitly call
-// [Editor.Commit] or [Editor.CommitAll] after performing an operation on the
-// sub-editor.
-func ExampleEditor_String_subEditor() {
-	ed := Edit("Act 1\nAct 2\nAct BLAH\nAct 4\nAct 5")
-	subEd := ed.Lines(2, 3)
+// This example uses String on a normal Editor to get its text.
+// This example shows a comment being reflowed. The comment marker at the start
+// of each line is kept and is not counted as a word.
+func ExampleEditor_Reflow() {
+	ed := Edit("// Your name is VRISKA SERKET.\n// You are a master of EXTREME ROLEPLAYING.")
 
-	subEd = subEd.Delete(4, 8).Insert(4, "3")
+	ed = ed.Reflow(25)
 
-	fullText := subEd.String()
-	fmt.Println(fullText)
+	fmt.Println(ed.String())
 	// Output:
-	// Act 1
-	// Act 2
-	// Act 3
-	// Act 4
-	// Act 5
+	// // Your name is VRISKA
+	// // SERKET. You are a
+	// // master of EXTREME
+	// // ROLEPLAYING.
 }
 
-// This example sets the IndentStr property of the Options on the Editor.
-func ExampleEditor_WithOptions() {
-	ed := Edit("Vriska Serket")
+// This example shows a reflow of quoted email text that has more than one
+// paragraph. The empty lines between paragraphs are kept.
+func ExampleEditor_ReflowOpts() {
+	ed := Edit("> Your name is VRISKA SERKET.\n>\n> You are a master of EXTREME ROLEPLAYING.")
+	opts := Options{PreserveParagraphs: true}
 
-	ed = ed.WithOptions(ed.Options.WithIndentStr("-->"))
+	ed = ed.ReflowOpts(25, opts)
 
-	fmt.Println(ed.Options.IndentStr)
-	// Output: -->
-}
+	fmt.Println(ed.String())
+	// Output:
+	// > Your name is VRISKA
+	// > SERKET.
+	// >
+	// > You are a master of
+	// > EXTREME ROLEPLAYING.
+}
+
+func ExampleEditor_SentenceCount() {
+	emptyCount := Edit("").SentenceCount()
+	fmt.Println(emptyCount)
+
+	testCount := Edit("John paid $3.50, i.e. too much. Then he left!").SentenceCount()
+	fmt.Println(testCount)
+
+	// Output:
+	// 0
+	// 2
+}
+
+func ExampleEditor_Sentences() {
+	ed := Edit("Hello! It costs 3.50, e.g. a lot. Really? Yes.")
+	subEd := ed.Sentences(1, 3)
+
+	// Not doing Editor.String for the example because that would call Commit
+	// and get back the starting string.
+	fmt.Println(subEd.Text)
+	// Output: It costs 3.50, e.g. a lot. Really?
+}
+
+// This example gets a sub-Editor for the "Really? Yes." part of
+// "Hello! It costs a lot. Really? Yes.".
+func ExampleEditor_SentencesFrom() {
+	ed := Edit("Hello! It costs a lot. Really? Yes.")
+	subEd := ed.SentencesFrom(2)
+
+	// Not doing Editor.String for the example because that would call Commit
+	// and get back the starting string.
+	fmt.Println(subEd.Text)
+	// Output: Really? Yes.
+}
+
+// This example gets a sub-Editor for the "Hello!" part of
+// "Hello! It costs a lot. Really? Yes.".
+func ExampleEditor_SentencesTo() {
+	ed := Edit("Hello! It costs a lot. Really? Yes.")
+	subEd := ed.SentencesTo(1)
+
+	// Not doing Editor.String for the example because that would call Commit
+	// and get back the starting string.
+	fmt.Println(subEd.Text)
+	// Output: Hello!
+}
+
+func ExampleEditor_String() {
+	ed := Edit("Some text")
+
+	text := ed.String()
+
+	fmt.Println(text)
+	// Output: Some text
+}
+
+// This example uses String on a sub-editor to avoid having to explicitly call
+// [Editor.Commit] or [Editor.CommitAll] after performing an operation on the
+// sub-editor.
+func ExampleEditor_String_subEditor() {
+	ed := Edit("Act 1\nAct 2\nAct BLAH\nAct 4\nAct 5")
+	subEd := ed.Lines(2, 3)
+
+	subEd = subEd.Delete(4, 8).Insert(4, "3")
+
+	fullText := subEd.String()
+	fmt.Println(fullText)
+	// Output:
+	// Act 1
+	// Act 2
+	// Act 3
+	// Act 4
+	// Act 5
+}
+
+// This example sets the IndentStr property of the Options on the Editor.
+func ExampleEditor_WithOptions() {
+	ed := Edit("Vriska Serket")
+
+	ed = ed.WithOptions(ed.Options.WithIndentStr("-->"))
+
+	fmt.Println(ed.Options.IndentStr)
+	// Output: -->
+}
+
+func ExampleEditor_WordCount() {
+	emptyCount := Edit("").WordCount()
+	fmt.Println(emptyCount)
+
+	testCount := Edit("Hello, world! It's 3 PM.").WordCount()
+	fmt.Println(testCount)
+
+	// Output:
+	// 0
+	// 5
+}
+
+func ExampleEditor_Words() {
+	ed := Edit("Hello, world! How are you?")
+	subEd := ed.Words(1, 3)
+
+	// Not doing Editor.String for the example because that would call Commit
+	// and get back the starting string.
+	fmt.Println(subEd.Text)
+	// Output: world! How
+}
+
+// This example capitalizes the third word of the text and then replaces the
+// last two words by using Words to get sub-Editors and committing the results.
+func ExampleEditor_Words_commit() {
+	ed := Edit("Vriska's eight-sided dice are very lucky.")
+
+	third := ed.Words(2, 3)
+	third.Text = strings.ToUpper(third.Text)
+	ed = third.Commit()
+
+	lastTwo := ed.Words(-2, End)
+	lastTwo.Text = "always lucky"
+	ed = lastTwo.Commit()
+
+	fmt.Println(ed.String())
+	// Output: Vriska's eight-SIDED dice are always lucky.
+}
+
+// This example gets a sub-Editor for the "are you" part of
+// "Hello, world! How are you?".
+func ExampleEditor_WordsFrom() {
+	ed := Edit("Hello, world! How are you?")
+	subEd := ed.WordsFrom(3)
+
+	// Not doing Editor.String for the example because that would call Commit
+	// and get back the starting string.
+	fmt.Println(subEd.Text)
+	// Output: are you
+}
+
+// This example gets a sub-Editor for the "Hello, world" part of
+// "Hello, world! How are you?".
+func ExampleEditor_WordsTo() {
+	ed := Edit("Hello, world! How are you?")
+	subEd := ed.WordsTo(2)
+
+	// Not doing Editor.String for the example because that would call Commit
+	// and get back the starting string.
+	fmt.Println(subEd.Text)
+	// Output: Hello, world
+}
 
 // This example shows wrapping applied to a long string.
+// This example shows text being truncated in each of the truncation modes.
+func ExampleEditor_Truncate() {
+	ed := Edit("/home/vriska/games/fluthlu/campaign.txt")
+
+	fmt.Println(ed.Truncate(20, TruncateTail).String())
+	fmt.Println(ed.Truncate(20, TruncateHead).String())
+	fmt.Println(ed.Truncate(20, TruncateMiddle).String())
+	// Output:
+	// /home/vriska/games/…
+	// …luthlu/campaign.txt
+	// /home/vris…paign.txt
+}
+
+// This example uses options to set the ellipsis that is put in place of the
+// text that is removed.
+func ExampleEditor_TruncateOpts() {
+	ed := Edit("Your name is VRISKA SERKET.")
+	opts := Options{Ellipsis: "..."}
+
+	ed = ed.TruncateOpts(15, TruncateTail, opts)
+
+	fmt.Println(ed.String())
+	// Output:
+	// Your name is...
+}
+
+// This example shows each line of a listing being truncated on its own.
+func ExampleEditor_TruncateLines() {
+	ed := Edit("campaign.txt\nmind_control_notes.txt\nspiders.png\n")
+
+	ed = ed.TruncateLines(14, TruncateMiddle)
+
+	fmt.Println(ed.String())
+	// Output:
+	// campaign.txt
+	// mind_co…es.txt
+	// spiders.png
+}
+
 func ExampleEditor_Wrap() {
 	ed := Edit("Your name is VRISKA SERKET. You are a master of EXTREME ROLEPLAYING.")
 
@@ -1148,6 +1852,396 @@ func ExampleEditor_WrapOpts() {
 	// fascinated by end of the world scenarios.
 }
 
+// This example shows how ANSI escape sequences that color text can be handled
+// so that they are not counted towards the width of a line. The color is
+// closed at the end of each line it is split across and reopened at the start
+// of the next.
+func ExampleEditor_WrapOpts_ansiEscapes() {
+	text := "Your name is \x1b[34mJOHN EGBERT\x1b[0m."
+
+	opts := Options{
+		ANSIEscapes: true,
+	}
+
+	ed := Edit(text)
+
+	ed = ed.WrapOpts(17, opts)
+
+	for _, line := range strings.Split(ed.String(), "\n") {
+		fmt.Printf("%q\n", line)
+	}
+	// Output:
+	// "Your name is \x1b[34mJOHN\x1b[0m"
+	// "\x1b[34mEGBERT\x1b[0m."
+}
+
+// This example shows the difference that breaking lines with the Unicode Line
+// Breaking Algorithm makes. Lines can be broken after the hyphen in
+// "once-in-a-lifetime" and the slash in "Derse/Prospit", but never at the
+// no-break space between "100" and "meters".
+// This example shows how words can be hyphenated so that each line holds as
+// much of the text as possible.
+func ExampleEditor_WrapOpts_hyphenate() {
+	text := "Your name is ROSE LALONDE. You are a master of the dark arts and you "
+	text += "write extraordinarily long novels about wizards in your spare time."
+
+	opts := Options{
+		Hyphenate: true,
+	}
+
+	fmt.Println(Edit(text).Wrap(17).String())
+	fmt.Println()
+	fmt.Println(Edit(text).WrapOpts(17, opts).String())
+	// Output:
+	// Your name is ROSE
+	// LALONDE. You are
+	// a master of the
+	// dark arts and you
+	// write
+	// extraordinarily
+	// long novels about
+	// wizards in your
+	// spare time.
+	//
+	// Your name is ROSE
+	// LALONDE. You are
+	// a master of the
+	// dark arts and you
+	// write extraordi-
+	// narily long nov-
+	// els about wizards
+	// in your spare
+	// time.
+}
+
+// This example shows how text whose whitespace is significant, such as source
+// code, can be wrapped without its indentation and spacing being lost.
+func ExampleEditor_WrapOpts_preserveWhitespace() {
+	text := "func main() {\n"
+	text += "    fmt.Println(\"Your name is JADE HARLEY.\",  \"You have a passion for\")\n"
+	text += "}\n"
+
+	opts := Options{
+		PreserveWhitespace: true,
+		RepeatIndent:       true,
+	}
+
+	ed := Edit(text)
+
+	ed = ed.WrapOpts(40, opts)
+
+	fmt.Println(ed.String())
+	// Output:
+	// func main() {
+	//     fmt.Println("Your name is JADE
+	//     HARLEY.",  "You have a passion for")
+	// }
+}
+
+// This example shows the wrap of text that contains a list. Each item is
+// wrapped on its own, and the lines it is wrapped onto are indented to line up
+// with the text after its marker.
+func ExampleEditor_WrapOpts_recognizeLists() {
+	text := "Your name is JADE HARLEY. You have:\n"
+	text += "- A passion for RATHER OBSCURE MUSIC.\n"
+	text += "- A penchant for building ODD CONTRAPTIONS.\n"
+	text += "  1. Most are quite small.\n"
+	text += "  2. One is a robot that is very large.\n"
+
+	opts := Options{
+		RecognizeLists: true,
+	}
+
+	ed := Edit(text)
+
+	ed = ed.WrapOpts(30, opts)
+
+	fmt.Println(ed.String())
+	// Output:
+	// Your name is JADE HARLEY. You
+	// have:
+	// - A passion for RATHER OBSCURE
+	//   MUSIC.
+	// - A penchant for building ODD
+	//   CONTRAPTIONS.
+	//   1. Most are quite small.
+	//   2. One is a robot that is
+	//      very large.
+}
+
+// This example shows how soft hyphens can be used to give the points at which
+// a word may be hyphenated. They are only shown where a line is broken.
+func ExampleEditor_WrapOpts_softHyphens() {
+	text := "The Sburb beta is a game of in\u00ADter\u00ADdi\u00ADmen\u00ADsion\u00ADal scope."
+
+	opts := Options{
+		Hyphenate: true,
+	}
+
+	ed := Edit(text)
+
+	ed = ed.WrapOpts(18, opts)
+
+	fmt.Println(ed.String())
+	// Output:
+	// The Sburb beta is
+	// a game of interdi-
+	// mensional scope.
+}
+
+func ExampleEditor_WrapOpts_lineBreaking() {
+	text := "A once-in-a-lifetime view from Derse/Prospit, 100\u00a0meters up."
+
+	spaceOpts := Options{LineBreaking: LineBreakSpaces}
+	uax14Opts := Options{LineBreaking: LineBreakUAX14}
+
+	fmt.Println(Edit(text).WrapOpts(16, spaceOpts).String())
+	fmt.Println()
+	fmt.Println(Edit(text).WrapOpts(16, uax14Opts).String())
+	// Output:
+	// A
+	// once-in-a-lifet-
+	// ime view from
+	// Derse/Prospit,
+	// 100 meters up.
+	//
+	// A once-in-a-
+	// lifetime view
+	// from Derse/
+	// Prospit,
+	// 100 meters up.
+}
+
+// This example compares justifying text that was wrapped greedily with
+// justifying text that was wrapped using the Knuth-Plass algorithm.
+func ExampleEditor_WrapOpts_wrapAlgorithm() {
+	text := "Your name is ROSE LALONDE. You are a master of the dark arts and you "
+	text += "write long novels about wizards in your spare time."
+
+	greedyOpts := Options{WrapAlgorithm: WrapGreedy}
+	knuthPlassOpts := Options{WrapAlgorithm: WrapKnuthPlass}
+
+	fmt.Println(Edit(text).WrapOpts(20, greedyOpts).Justify(20).String())
+	fmt.Println()
+	fmt.Println(Edit(text).WrapOpts(20, knuthPlassOpts).Justify(20).String())
+	// Output:
+	// Your  name  is  ROSE
+	// LALONDE.  You  are a
+	// master  of  the dark
+	// arts  and  you write
+	// long   novels  about
+	// wizards    in   your
+	// spare time.
+	//
+	// Your  name  is  ROSE
+	// LALONDE.   You   are
+	// a  master   of   the
+	// dark  arts  and  you
+	// write   long  novels
+	// about   wizards   in
+	// your spare time.
+}
+
+func ExampleHyphenator_Hyphenate() {
+	var h Hyphenator
+
+	fmt.Println(h.Hyphenate("hyphenation"))
+	fmt.Println(h.Hyphenate("associate"))
+	fmt.Println(h.Hyphenate("rose"))
+	// Output:
+	// [hy phen a tion]
+	// [as so ciate]
+	// [rose]
+}
+
+func ExampleHyphenator_WithMinimums() {
+	var h Hyphenator
+
+	h = h.WithMinimums(3, 3)
+
+	fmt.Println(h.Hyphenate("hyphenation"))
+	// Output:
+	// [hyphen a tion]
+}
+
+func ExampleLoadHyphenator() {
+	tex := "% a tiny set of patterns for example purposes\n"
+	tex += "\\patterns{\n"
+	tex += "  l1l\n"
+	tex += "}\n"
+	tex += "\\hyphenation{\n"
+	tex += "  ea-gle\n"
+	tex += "}\n"
+
+	h, err := LoadHyphenator(strings.NewReader(tex))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(h.Hyphenate("balloon"))
+	fmt.Println(h.Hyphenate("eagle"))
+	// Output:
+	// [bal loon]
+	// [ea gle]
+}
+
+func ExampleNewHyphenator() {
+	h, err := NewHyphenator("l1l", "ea-gle")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(h.Hyphenate("balloon"))
+	fmt.Println(h.Hyphenate("eagle"))
+	// Output:
+	// [bal loon]
+	// [ea gle]
+}
+
+func ExampleCheckColumnWidths() {
+	opts := Options{TableBorders: true}
+
+	opts = opts.WithTableColumnWidths(ColumnWidth{Fixed: 12}, ColumnWidth{Percent: 40})
+	fmt.Println(CheckColumnWidths(3, 40, opts))
+
+	opts = opts.WithTableColumnWidths(ColumnWidth{Fixed: 12}, ColumnWidth{Min: 10, Max: 5})
+	fmt.Println(CheckColumnWidths(3, 40, opts))
+
+	opts = opts.WithTableColumnWidths(ColumnWidth{Fixed: 30}, ColumnWidth{Min: 10})
+	fmt.Println(CheckColumnWidths(3, 40, opts))
+	// Output:
+	// <nil>
+	// column 1: minimum width 10 is more than maximum width 5
+	// columns need a table at least 51 wide, but width is 40
+}
+
+func ExampleParseTable() {
+	text := "+--------------+----------------+\n"
+	text += "|     NAME     |      CLASS     |\n"
+	text += "+--------------+----------------+\n"
+	text += "| John Egbert  | Heir of Breath |\n"
+	text += "| Rose Lalonde | Seer of Light  |\n"
+	text += "+--------------+----------------+\n"
+
+	data, err := ParseTable(text, Options{})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, row := range data {
+		fmt.Printf("%q\n", row)
+	}
+	// Output:
+	// ["NAME" "CLASS"]
+	// ["John Egbert" "Heir of Breath"]
+	// ["Rose Lalonde" "Seer of Light"]
+}
+
+func ExampleWriteTableCSV() {
+	data := [][]string{
+		{"Name", "Title"},
+		{"John Egbert", "Heir of Breath"},
+		{"Rose Lalonde", "Seer of \"Light\", mostly"},
+	}
+
+	var sb strings.Builder
+	if err := WriteTableCSV(&sb, data); err != nil {
+		panic(err)
+	}
+
+	fmt.Print(sb.String())
+	// Output:
+	// Name,Title
+	// John Egbert,Heir of Breath
+	// Rose Lalonde,"Seer of ""Light"", mostly"
+}
+
+func ExampleTable_Render() {
+	table := Table{}.
+		SetHeader("Item", "Qty").
+		AddRow("Apples", "10").
+		AddRow("Pears", "3").
+		SetFooter("Total", "13")
+
+	fmt.Print(table.Render(0, Options{TableBorders: true}))
+	// Output:
+	// +--------+-----+
+	// |  ITEM  | QTY |
+	// +--------+-----+
+	// | Apples | 10  |
+	// | Pears  | 3   |
+	// +--------+-----+
+	// | Total  | 13  |
+	// +--------+-----+
+}
+
+func ExampleTable_SortBy() {
+	byNumber := func(a, b string) bool {
+		x, _ := strconv.Atoi(a)
+		y, _ := strconv.Atoi(b)
+		return x < y
+	}
+
+	table := Table{}.
+		SetHeader("Item", "Qty").
+		AddRow("Pears", "3").
+		AddRow("Apples", "10").
+		AddSeparator().
+		AddRow("Carrots", "7").
+		AddRow("Beets", "12").
+		SortBy(1, byNumber)
+
+	fmt.Print(table.Render(0, Options{TableBorders: true}))
+	// Output:
+	// +---------+-----+
+	// |   ITEM  | QTY |
+	// +---------+-----+
+	// | Pears   | 3   |
+	// | Apples  | 10  |
+	// +---------+-----+
+	// | Carrots | 7   |
+	// | Beets   | 12  |
+	// +---------+-----+
+}
+
+// codeBlockRenderer is a TableRenderer that draws tables as text in a Markdown
+// code block.
+type codeBlockRenderer struct{}
+
+func (codeBlockRenderer) RenderTable(layout TableLayout, opts Options) string {
+	table := TableFormatText.RenderTable(layout, opts)
+	return "```" + opts.LineSeparator + table + opts.LineSeparator + "```"
+}
+
+// This example shows a TableRenderer that uses RenderTable to draw a table as
+// text and then puts it in a Markdown code block.
+func ExampleTableFormat_RenderTable() {
+	data := [][]string{
+		{"Name", "Title"},
+		{"Rose", "Seer of Light"},
+		{"Dave", "Knight of Time"},
+	}
+
+	opts := Options{
+		TableHeaders:  true,
+		TableBorders:  true,
+		TableRenderer: codeBlockRenderer{},
+	}
+
+	ed := Edit("").InsertTableOpts(0, data, 0, opts)
+
+	fmt.Println(ed.String())
+	// Output:
+	// ```
+	// +------+----------------+
+	// | NAME |      TITLE     |
+	// +------+----------------+
+	// | Rose | Seer of Light  |
+	// | Dave | Knight of Time |
+	// +------+----------------+
+	// ```
+}
+
 func ExampleOptions_String() {
 	opts := Options{IndentStr: "-->"}
 
@@ -1155,11 +2249,22 @@ func ExampleOptions_String() {
 
 	fmt.Println(str)
 	// Output:
-	// Options{ParagraphSeparator: "", LineSeparator: "", IndentStr: "-->", NoTrailingLineSeparators: false, PreserveParagraphs: false, JustifyLastLine: false, TableBorders: false, TableHeaders: false, TableCharSet: ""}
+	// Options{ParagraphSeparator: "", LineSeparator: "", IndentStr: "-->", NoTrailingLineSeparators: false, PreserveParagraphs: false, JustifyLastLine: false, DisplayWidth: false, ANSIEscapes: false, LineBreaking: LineBreakSpaces, WrapAlgorithm: WrapGreedy, Hyphenate: false, Hyphenator: Hyphenator{Patterns: 4447, Exceptions: 1454, LeftMin: 2, RightMin: 3}, PreserveWhitespace: false, RepeatIndent: false, LinePrefix: "", RecognizeLists: false, Ellipsis: "", Normalization: NoNormalization, TableBorders: false, TableHeaders: false, TableCharSet: "", TableStyle: TableStyle{"", "", "", "", ""}, TableAlignments: [], TableHeaderCase: HeaderDefault, TableFit: false, TableMinWidths: [], TableNoWrapColumns: [], TableColumnWidths: [], TableValueFormatter: <nil>, TableExpand: ExpandOff, TableFormat: TableFormatText, TableRenderer: <nil>}
 }
 
 // This example shows how WithDefaults can be called to set all currently unset
 // properties to their default values while leaving the set values alone.
+func ExampleOptions_WithANSIEscapes() {
+	opts := Options{
+		ANSIEscapes: false,
+	}
+
+	opts = opts.WithANSIEscapes(true)
+
+	fmt.Println(opts.ANSIEscapes)
+	// Output: true
+}
+
 func ExampleOptions_WithDefaults() {
 	opts := Options{
 		LineSeparator:      "<br/>",
@@ -1185,6 +2290,55 @@ func ExampleOptions_WithDefaults() {
 	// TableCharSet: "#|-"
 }
 
+func ExampleOptions_WithDisplayWidth() {
+	opts := Options{
+		DisplayWidth: false,
+	}
+
+	opts = opts.WithDisplayWidth(true)
+
+	fmt.Println(opts.DisplayWidth)
+	// Output: true
+}
+
+func ExampleOptions_WithEllipsis() {
+	opts := Options{
+		Ellipsis: "\u2026",
+	}
+
+	opts = opts.WithEllipsis("...")
+
+	fmt.Println(opts.Ellipsis)
+	// Output: ...
+}
+
+func ExampleOptions_WithHyphenate() {
+	opts := Options{
+		Hyphenate: false,
+	}
+
+	opts = opts.WithHyphenate(true)
+
+	fmt.Println(opts.Hyphenate)
+	// Output: true
+}
+
+func ExampleOptions_WithHyphenator() {
+	h, err := NewHyphenator("l1l", "ea-gle")
+	if err != nil {
+		panic(err)
+	}
+
+	opts := Options{
+		Hyphenate: true,
+	}
+
+	opts = opts.WithHyphenator(h)
+
+	fmt.Println(opts.Hyphenator)
+	// Output: Hyphenator{Patterns: 1, Exceptions: 1, LeftMin: 2, RightMin: 3}
+}
+
 func ExampleOptions_WithIndentStr() {
 	opts := Options{
 		IndentStr: "",
@@ -1207,6 +2361,28 @@ func ExampleOptions_WithJustifyLastLine() {
 	// Output: true
 }
 
+func ExampleOptions_WithLineBreaking() {
+	opts := Options{
+		LineBreaking: LineBreakSpaces,
+	}
+
+	opts = opts.WithLineBreaking(LineBreakUAX14)
+
+	fmt.Println(opts.LineBreaking)
+	// Output: LineBreakUAX14
+}
+
+func ExampleOptions_WithLinePrefix() {
+	opts := Options{
+		LinePrefix: "# ",
+	}
+
+	opts = opts.WithLinePrefix("> ")
+
+	fmt.Printf("%q\n", opts.LinePrefix)
+	// Output: "> "
+}
+
 func ExampleOptions_WithLineSeparator() {
 	opts := Options{
 		LineSeparator: "\n",
@@ -1229,6 +2405,17 @@ func ExampleOptions_WithNoTrailingLineSeparators() {
 	// Output: true
 }
 
+func ExampleOptions_WithNormalization() {
+	opts := Options{
+		Normalization: NoNormalization,
+	}
+
+	opts = opts.WithNormalization(NFC)
+
+	fmt.Println(opts.Normalization)
+	// Output: NFC
+}
+
 func ExampleOptions_WithParagraphSeparator() {
 	opts := Options{
 		ParagraphSeparator: "\n\n",
@@ -1251,6 +2438,39 @@ func ExampleOptions_WithPreserveParagraphs() {
 	// Output: true
 }
 
+func ExampleOptions_WithPreserveWhitespace() {
+	opts := Options{
+		PreserveWhitespace: false,
+	}
+
+	opts = opts.WithPreserveWhitespace(true)
+
+	fmt.Println(opts.PreserveWhitespace)
+	// Output: true
+}
+
+func ExampleOptions_WithRecognizeLists() {
+	opts := Options{
+		RecognizeLists: false,
+	}
+
+	opts = opts.WithRecognizeLists(true)
+
+	fmt.Println(opts.RecognizeLists)
+	// Output: true
+}
+
+func ExampleOptions_WithRepeatIndent() {
+	opts := Options{
+		RepeatIndent: false,
+	}
+
+	opts = opts.WithRepeatIndent(true)
+
+	fmt.Println(opts.RepeatIndent)
+	// Output: true
+}
+
 func ExampleOptions_WithTableBorders() {
 	opts := Options{
 		TableBorders: false,
@@ -1283,3 +2503,137 @@ func ExampleOptions_WithTableCharSet() {
 	fmt.Println(opts.TableCharSet)
 	// Output: @IK
 }
+
+func ExampleOptions_WithTableAlignments() {
+	opts := Options{
+		TableAlignments: NewAlignments(Left),
+	}
+
+	opts = opts.WithTableAlignments(Right, Decimal)
+
+	fmt.Println(opts.TableAlignments)
+	// Output: [Right Decimal]
+}
+
+func ExampleOptions_WithTableHeaderCase() {
+	opts := Options{
+		TableHeaderCase: HeaderUpper,
+	}
+
+	opts = opts.WithTableHeaderCase(HeaderTitle)
+
+	fmt.Println(opts.TableHeaderCase)
+	// Output: HeaderTitle
+}
+
+func ExampleOptions_WithTableStyle() {
+	opts := Options{
+		TableStyle: TableStyleASCII,
+	}
+
+	opts = opts.WithTableStyle(TableStyleRounded)
+
+	fmt.Println(opts.TableStyle)
+	// Output: TableStyle{"╭─┬╮", "│", "├─┼┤", "", "╰─┴╯"}
+}
+
+func ExampleOptions_WithTableFit() {
+	opts := Options{
+		TableFit: false,
+	}
+
+	opts = opts.WithTableFit(true)
+
+	fmt.Println(opts.TableFit)
+	// Output: true
+}
+
+func ExampleOptions_WithTableMinWidths() {
+	opts := Options{
+		TableMinWidths: Widths{},
+	}
+
+	opts = opts.WithTableMinWidths(10, 0, 4)
+
+	fmt.Println(opts.TableMinWidths)
+	// Output: [10 0 4]
+}
+
+func ExampleOptions_WithTableNoWrapColumns() {
+	opts := Options{
+		TableNoWrapColumns: ColumnIndexes{},
+	}
+
+	opts = opts.WithTableNoWrapColumns(0, 2)
+
+	fmt.Println(opts.TableNoWrapColumns)
+	// Output: [0 2]
+}
+
+func ExampleOptions_WithTableColumnWidths() {
+	opts := Options{
+		TableColumnWidths: ColumnWidths{},
+	}
+
+	opts = opts.WithTableColumnWidths(ColumnWidth{Fixed: 12}, ColumnWidth{Max: 20})
+
+	fmt.Println(opts.TableColumnWidths)
+	// Output: [ColumnWidth{Fixed: 12, Min: 0, Max: 0, Percent: 0, Weight: 0} ColumnWidth{Fixed: 0, Min: 0, Max: 20, Percent: 0, Weight: 0}]
+}
+
+func ExampleOptions_WithTableValueFormatter() {
+	opts := Options{
+		TableValueFormatter: nil,
+	}
+
+	opts = opts.WithTableValueFormatter(func(header string, value interface{}) string {
+		return fmt.Sprintf("%s=%v", header, value)
+	})
+
+	fmt.Println((*opts.TableValueFormatter)("Count", 3))
+	// Output: Count=3
+}
+
+func ExampleOptions_WithTableExpand() {
+	opts := Options{
+		TableExpand: ExpandOff,
+	}
+
+	opts = opts.WithTableExpand(ExpandAuto)
+
+	fmt.Println(opts.TableExpand)
+	// Output: ExpandAuto
+}
+
+func ExampleOptions_WithTableFormat() {
+	opts := Options{
+		TableFormat: TableFormatText,
+	}
+
+	opts = opts.WithTableFormat(TableFormatHTML)
+
+	fmt.Println(opts.TableFormat)
+	// Output: TableFormatHTML
+}
+
+func ExampleOptions_WithTableRenderer() {
+	opts := Options{
+		TableRenderer: nil,
+	}
+
+	opts = opts.WithTableRenderer(TableFormatLaTeX)
+
+	fmt.Println(opts.TableRenderer)
+	// Output: TableFormatLaTeX
+}
+
+func ExampleOptions_WithWrapAlgorithm() {
+	opts := Options{
+		WrapAlgorithm: WrapGreedy,
+	}
+
+	opts = opts.WithWrapAlgorithm(WrapKnuthPlass)
+
+	fmt.Println(opts.WrapAlgorithm)
+	// Output: WrapKnuthPlass
+}
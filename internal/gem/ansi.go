@@ -0,0 +1,118 @@
+package gem
+
+// This file implements recognition of the ANSI escape sequences (as given in
+// ECMA-48) that are used by terminals for styling and hyperlinks. Only CSI and
+// OSC sequences are recognized, as they are the only ones that commonly appear
+// within text that is to be displayed.
+
+const (
+	escChar = '\x1b'
+	belChar = '\a'
+
+	// 8-bit equivalents of ESC [, ESC ], and ESC \.
+	csiChar = '\u009b'
+	oscChar = '\u009d'
+	stChar  = '\u009c'
+)
+
+// EscapeLen returns the number of runes that make up the ANSI escape sequence
+// at the start of r. If r does not begin with a complete CSI or OSC escape
+// sequence, 0 is returned.
+func EscapeLen(r []rune) int {
+	if len(r) < 1 {
+		return 0
+	}
+
+	var start int
+	var osc bool
+	switch {
+	case r[0] == csiChar:
+		start = 1
+	case r[0] == oscChar:
+		start, osc = 1, true
+	case r[0] == escChar && len(r) > 1 && r[1] == '[':
+		start = 2
+	case r[0] == escChar && len(r) > 1 && r[1] == ']':
+		start, osc = 2, true
+	default:
+		return 0
+	}
+
+	if osc {
+		// OSC is terminated by BEL or by ST.
+		for i := start; i < len(r); i++ {
+			switch {
+			case r[i] == belChar || r[i] == stChar:
+				return i + 1
+			case r[i] == escChar && i+1 < len(r) && r[i+1] == '\\':
+				return i + 2
+			}
+		}
+		return 0
+	}
+
+	// CSI is any number of parameter bytes, then any number of intermediate
+	// bytes, then a single final byte.
+	i := start
+	for i < len(r) && r[i] >= 0x30 && r[i] <= 0x3f {
+		i++
+	}
+	for i < len(r) && r[i] >= 0x20 && r[i] <= 0x2f {
+		i++
+	}
+	if i < len(r) && r[i] >= 0x40 && r[i] <= 0x7e {
+		return i + 1
+	}
+	return 0
+}
+
+// EscapeAt returns the number of grapheme clusters that make up the ANSI escape
+// sequence which starts at the grapheme cluster at index idx. If there is no
+// complete escape sequence at idx, 0 is returned.
+//
+// This function may trigger UAX29 analysis on the String if it hasn't yet
+// occured.
+func (str String) EscapeAt(idx int) int {
+	str = str.initialized()
+
+	if idx < 0 || idx >= str.Len() {
+		return 0
+	}
+
+	var runeStart int
+	if idx > 0 {
+		runeStart = (*str.gc)[idx-1]
+	}
+	escLen := EscapeLen(str.r[runeStart:])
+	if escLen == 0 {
+		return 0
+	}
+
+	// the sequence ends in the cluster that contains its last rune; any
+	// characters combined with that rune are kept with it.
+	runeEnd := runeStart + escLen
+	count := 0
+	for i := idx; i < len(*str.gc); i++ {
+		count++
+		if (*str.gc)[i] >= runeEnd {
+			break
+		}
+	}
+	return count
+}
+
+// StripEscapes returns a copy of str with all ANSI escape sequences recognized
+// by [EscapeLen] removed.
+func (str String) StripEscapes() String {
+	str = str.initialized()
+
+	stripped := make([]rune, 0, len(str.r))
+	for i := 0; i < len(str.r); i++ {
+		if escLen := EscapeLen(str.r[i:]); escLen > 0 {
+			i += escLen - 1
+			continue
+		}
+		stripped = append(stripped, str.r[i])
+	}
+	return String{r: stripped, gc: new([]int)}
+}
@@ -0,0 +1,41 @@
+package gem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_EscapeLen(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  []rune
+		expect int
+	}{
+		{"empty", []rune{}, 0},
+		{"plain text", []rune("rose"), 0},
+		{"lone ESC", []rune("\x1b"), 0},
+		{"SGR reset", []rune("\x1b[0m"), 4},
+		{"SGR with no params", []rune("\x1b[m"), 3},
+		{"SGR followed by text", []rune("\x1b[1;31mrose"), 7},
+		{"256-color SGR", []rune("\x1b[38;5;208m"), 11},
+		{"CSI with intermediate", []rune("\x1b[2 q"), 5},
+		{"8-bit CSI", []rune("\u009b31m"), 4},
+		{"unterminated CSI", []rune("\x1b[31"), 0},
+		{"OSC terminated by BEL", []rune("\x1b]0;a title\a"), 12},
+		{"OSC terminated by ST", []rune("\x1b]8;;http://example.com\x1b\\text"), 25},
+		{"8-bit OSC terminated by 8-bit ST", []rune("\u009d0;title\u009c"), 9},
+		{"unterminated OSC", []rune("\x1b]0;a title"), 0},
+		{"escape not at start", []rune("a\x1b[0m"), 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := EscapeLen(tc.input)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
@@ -0,0 +1,418 @@
+package gem
+
+import (
+	"sort"
+	"unicode"
+)
+
+// This file implements the Unicode Line Breaking Algorithm as given in UAX #14.
+// The default rules are implemented with no tailoring, except that the
+// resolution of ambiguous (AI) characters is always to AL.
+
+// lbClass is a value of the Line_Break property.
+type lbClass int
+
+// Values are taken from UAX #14 Table 1, "Line Breaking Classes".
+const (
+	lbXX lbClass = iota
+	lbBK
+	lbCR
+	lbLF
+	lbNL
+	lbCM
+	lbZWJ
+	lbSG
+	lbWJ
+	lbZW
+	lbGL
+	lbSP
+	lbB2
+	lbBA
+	lbBB
+	lbHY
+	lbCB
+	lbCL
+	lbCP
+	lbEX
+	lbIN
+	lbNS
+	lbOP
+	lbQU
+	lbIS
+	lbNU
+	lbPO
+	lbPR
+	lbSY
+	lbAI
+	lbAL
+	lbCJ
+	lbEB
+	lbEM
+	lbH2
+	lbH3
+	lbHL
+	lbID
+	lbJL
+	lbJV
+	lbJT
+	lbRI
+	lbSA
+
+	// lbNone is used to mark the absence of a character, such as before the
+	// start of text.
+	lbNone lbClass = -1
+)
+
+// lbRange is a range of code points that all have the same Line_Break value.
+type lbRange struct {
+	lo, hi rune
+	class  lbClass
+}
+
+// LineBreaks returns the positions in r at which a line may be broken
+// according to UAX #14. Each position is the index of the rune that would
+// begin the new line; the end of the text, len(r), is always included. A break
+// is never allowed at the start of the text, so 0 is never included.
+//
+// Positions where a break is required, such as after a line feed, are included
+// but are not distinguished from positions where a break is only allowed.
+func LineBreaks(r []rune) []int {
+	if len(r) < 1 {
+		return nil
+	}
+
+	classes := make([]lbClass, len(r))
+	for i := range r {
+		classes[i] = resolvedLineBreakClass(r[i])
+	}
+
+	var breaks []int
+
+	// prev is the class of the previous character after LB9 and LB10 have been
+	// applied, and prevRune is the base character it was taken from. prev2 is
+	// the class of the one before it.
+	prev := classes[0]
+	if prev == lbCM || prev == lbZWJ {
+		prev = lbAL
+	}
+	prev2 := lbNone
+	prevRune := r[0]
+
+	// beforeSP is the class of the last character that was not SP.
+	beforeSP := lbNone
+	if prev != lbSP {
+		beforeSP = prev
+	}
+
+	// riCount is the number of consecutive RI characters ending at prev.
+	riCount := 0
+	if prev == lbRI {
+		riCount = 1
+	}
+
+	for i := 1; i < len(r); i++ {
+		raw := classes[i]
+		rawPrev := classes[i-1]
+
+		// LB9 - Do not break a combining character sequence; treat it as if
+		// it has the line breaking class of the base character.
+		attaches := (raw == lbCM || raw == lbZWJ) && !lbIsOneOf(prev, lbBK, lbCR, lbLF, lbNL, lbSP, lbZW)
+
+		cur := raw
+		if (cur == lbCM || cur == lbZWJ) && !attaches {
+			// LB10 - Treat any remaining combining mark or ZWJ as AL.
+			cur = lbAL
+		}
+
+		if lineBreakBetween(prev, prev2, prevRune, rawPrev, beforeSP, riCount, raw, cur, r[i], attaches) {
+			breaks = append(breaks, i)
+		}
+
+		if attaches {
+			continue
+		}
+
+		prev2 = prev
+		prev = cur
+		prevRune = r[i]
+		if cur != lbSP {
+			beforeSP = cur
+		}
+		if cur == lbRI {
+			riCount++
+		} else {
+			riCount = 0
+		}
+	}
+
+	// LB3 - Always break at the end of text.
+	breaks = append(breaks, len(r))
+
+	return breaks
+}
+
+// lineBreakBetween returns whether a break is allowed before the character
+// with the raw class raw and the class cur after applying LB9 and LB10. The
+// rest of the arguments give the state of the text before it as tracked by
+// LineBreaks.
+func lineBreakBetween(prev, prev2 lbClass, prevRune rune, rawPrev, beforeSP lbClass, riCount int, raw, cur lbClass, r rune, attaches bool) bool {
+	// LB4 - Always break after hard line breaks.
+	if rawPrev == lbBK {
+		return true
+	}
+
+	// LB5 - Treat CR followed by LF, as well as CR, LF, and NL as hard line
+	// breaks.
+	if rawPrev == lbCR && raw == lbLF {
+		return false
+	}
+	if lbIsOneOf(rawPrev, lbCR, lbLF, lbNL) {
+		return true
+	}
+
+	// LB6 - Do not break before hard line breaks.
+	if lbIsOneOf(raw, lbBK, lbCR, lbLF, lbNL) {
+		return false
+	}
+
+	// LB7 - Do not break before spaces or zero width space.
+	if raw == lbSP || raw == lbZW {
+		return false
+	}
+
+	// LB8 - Break before any character following a zero-width space, even if
+	// one or more spaces intervene.
+	if beforeSP == lbZW {
+		return true
+	}
+
+	// LB8a - Do not break after a zero width joiner.
+	if rawPrev == lbZWJ {
+		return false
+	}
+
+	// LB9 - Do not break a combining character sequence.
+	if attaches {
+		return false
+	}
+
+	// LB11 - Do not break before or after Word joiner.
+	if cur == lbWJ || prev == lbWJ {
+		return false
+	}
+
+	// LB12 - Do not break after NBSP and related characters.
+	if prev == lbGL {
+		return false
+	}
+
+	// LB12a - Do not break before NBSP and related characters, except after
+	// spaces and hyphens.
+	if cur == lbGL && !lbIsOneOf(prev, lbSP, lbBA, lbHY) {
+		return false
+	}
+
+	// LB13 - Do not break before ']' or '!' or ';' or '/', even after spaces.
+	if lbIsOneOf(cur, lbCL, lbCP, lbEX, lbIS, lbSY) {
+		return false
+	}
+
+	// LB14 - Do not break after '[', even after spaces.
+	if beforeSP == lbOP {
+		return false
+	}
+
+	// LB15 - Do not break within '"[', even with intervening spaces.
+	if beforeSP == lbQU && cur == lbOP {
+		return false
+	}
+
+	// LB16 - Do not break between closing punctuation and a nonstarter, even
+	// with intervening spaces.
+	if (beforeSP == lbCL || beforeSP == lbCP) && cur == lbNS {
+		return false
+	}
+
+	// LB17 - Do not break within '——', even with intervening spaces.
+	if beforeSP == lbB2 && cur == lbB2 {
+		return false
+	}
+
+	// LB18 - Break after spaces.
+	if prev == lbSP {
+		return true
+	}
+
+	// LB19 - Do not break before or after quotation marks.
+	if cur == lbQU || prev == lbQU {
+		return false
+	}
+
+	// LB20 - Break before and after unresolved CB.
+	if cur == lbCB || prev == lbCB {
+		return true
+	}
+
+	// LB21 - Do not break before hyphen-minus, other hyphens, fixed-width
+	// spaces, small kana, and other non-starters, or after acute accents.
+	if lbIsOneOf(cur, lbBA, lbHY, lbNS) || prev == lbBB {
+		return false
+	}
+
+	// LB21a - Don't break after Hebrew + Hyphen.
+	if prev2 == lbHL && (prev == lbHY || prev == lbBA) {
+		return false
+	}
+
+	// LB21b - Don't break between Solidus and Hebrew letters.
+	if prev == lbSY && cur == lbHL {
+		return false
+	}
+
+	// LB22 - Do not break before ellipses.
+	if cur == lbIN {
+		return false
+	}
+
+	// LB23 - Do not break between digits and letters.
+	if (prev == lbAL || prev == lbHL) && cur == lbNU {
+		return false
+	}
+	if prev == lbNU && (cur == lbAL || cur == lbHL) {
+		return false
+	}
+
+	// LB23a - Do not break between numeric prefixes and ideographs, or between
+	// ideographs and numeric postfixes.
+	if prev == lbPR && lbIsOneOf(cur, lbID, lbEB, lbEM) {
+		return false
+	}
+	if lbIsOneOf(prev, lbID, lbEB, lbEM) && cur == lbPO {
+		return false
+	}
+
+	// LB24 - Do not break between numeric prefix/postfix and letters, or
+	// between letters and prefix/postfix.
+	if (prev == lbPR || prev == lbPO) && (cur == lbAL || cur == lbHL) {
+		return false
+	}
+	if (prev == lbAL || prev == lbHL) && (cur == lbPR || cur == lbPO) {
+		return false
+	}
+
+	// LB25 - Do not break between the following pairs of classes relevant to
+	// numbers.
+	if lbIsOneOf(prev, lbCL, lbCP, lbNU) && (cur == lbPO || cur == lbPR) {
+		return false
+	}
+	if (prev == lbPO || prev == lbPR) && cur == lbOP {
+		return false
+	}
+	if lbIsOneOf(prev, lbPO, lbPR, lbHY, lbIS, lbNU, lbSY) && cur == lbNU {
+		return false
+	}
+
+	// LB26 - Do not break a Korean syllable.
+	if prev == lbJL && lbIsOneOf(cur, lbJL, lbJV, lbH2, lbH3) {
+		return false
+	}
+	if (prev == lbJV || prev == lbH2) && (cur == lbJV || cur == lbJT) {
+		return false
+	}
+	if (prev == lbJT || prev == lbH3) && cur == lbJT {
+		return false
+	}
+
+	// LB27 - Treat a Korean Syllable Block the same as ID.
+	if lbIsOneOf(prev, lbJL, lbJV, lbJT, lbH2, lbH3) && cur == lbPO {
+		return false
+	}
+	if prev == lbPR && lbIsOneOf(cur, lbJL, lbJV, lbJT, lbH2, lbH3) {
+		return false
+	}
+
+	// LB28 - Do not break between alphabetics.
+	if (prev == lbAL || prev == lbHL) && (cur == lbAL || cur == lbHL) {
+		return false
+	}
+
+	// LB29 - Do not break between numeric punctuation and alphabetics.
+	if prev == lbIS && (cur == lbAL || cur == lbHL) {
+		return false
+	}
+
+	// LB30 - Do not break between letters, numbers, or ordinary symbols and
+	// non-East-Asian opening or closing punctuation.
+	if lbIsOneOf(prev, lbAL, lbHL, lbNU) && cur == lbOP && !isEastAsian(r) {
+		return false
+	}
+	if prev == lbCP && !isEastAsian(prevRune) && lbIsOneOf(cur, lbAL, lbHL, lbNU) {
+		return false
+	}
+
+	// LB30a - Break between two regional indicator symbols if and only if
+	// there are an even number of regional indicators preceding the position
+	// of the break.
+	if prev == lbRI && cur == lbRI && riCount%2 == 1 {
+		return false
+	}
+
+	// LB30b - Do not break between an emoji base (or potential emoji) and an
+	// emoji modifier.
+	if cur == lbEM && (prev == lbEB || (isExtPicto(prevRune) && isUnassigned(prevRune))) {
+		return false
+	}
+
+	// LB31 - Break everywhere else.
+	return true
+}
+
+// lineBreakClass returns the Line_Break property value of r.
+func lineBreakClass(r rune) lbClass {
+	idx := sort.Search(len(lineBreakRanges), func(i int) bool {
+		return lineBreakRanges[i].hi >= r
+	})
+	if idx < len(lineBreakRanges) && lineBreakRanges[idx].lo <= r {
+		return lineBreakRanges[idx].class
+	}
+	return lbXX
+}
+
+// resolvedLineBreakClass returns the Line_Break property value of r after
+// resolving the classes that LB1 says are to be mapped to other classes.
+func resolvedLineBreakClass(r rune) lbClass {
+	class := lineBreakClass(r)
+	switch class {
+	case lbAI, lbSG, lbXX:
+		return lbAL
+	case lbSA:
+		if unicode.In(r, unicode.Mn, unicode.Mc) {
+			return lbCM
+		}
+		return lbAL
+	case lbCJ:
+		return lbNS
+	default:
+		return class
+	}
+}
+
+// isEastAsian returns whether r has an East_Asian_Width of Fullwidth, Wide, or
+// Halfwidth.
+func isEastAsian(r rune) bool {
+	return isEawFullwidth(r) || isEawWide(r) || isEawHalfwidth(r)
+}
+
+// isUnassigned returns whether r has a General_Category of Cn.
+func isUnassigned(r rune) bool {
+	return !unicode.In(r, unicode.L, unicode.M, unicode.N, unicode.P, unicode.S, unicode.Z, unicode.Cc, unicode.Cf, unicode.Co, unicode.Cs)
+}
+
+func lbIsOneOf(class lbClass, classes ...lbClass) bool {
+	for _, c := range classes {
+		if class == c {
+			return true
+		}
+	}
+	return false
+}
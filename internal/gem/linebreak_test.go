@@ -0,0 +1,49 @@
+package gem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LineBreaks(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expect []string
+	}{
+		{"empty", "", nil},
+		{"single word", "rose", []string{"rose"}},
+		{"break after spaces", "Hello, world! How are you?", []string{"Hello, ", "world! ", "How ", "are ", "you?"}},
+		{"break after hyphens and slashes", "well-known and/or", []string{"well-", "known ", "and/", "or"}},
+		{"no-break space", "a\u00a0b c", []string{"a\u00a0b ", "c"}},
+		{"ideographs", "日本語のテキスト。", []string{"日", "本", "語", "の", "テ", "キ", "ス", "ト。"}},
+		{"numbers with prefix and punctuation", "$1,000.00 (approx)", []string{"$1,000.00 ", "(approx)"}},
+		{"quotes and brackets", "\"quoted (text)\" here", []string{"\"quoted ", "(text)\" ", "here"}},
+		{"combining mark is not separated", "e\u0301 x", []string{"e\u0301 ", "x"}},
+		{"mandatory break after line feed", "a\nb", []string{"a\n", "b"}},
+		{"CR LF is not split", "a\r\nb", []string{"a\r\n", "b"}},
+		{"word joiner", "a\u2060b c", []string{"a\u2060b ", "c"}},
+		{"zero width space", "ab\u200bcd", []string{"ab\u200b", "cd"}},
+		{"regional indicator pairs", "\U0001F1EF\U0001F1F5\U0001F1FA\U0001F1F8", []string{"\U0001F1EF\U0001F1F5", "\U0001F1FA\U0001F1F8"}},
+		{"emoji modifier", "\U0001F44D\U0001F3FD ok", []string{"\U0001F44D\U0001F3FD ", "ok"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			r := []rune(tc.input)
+			breaks := LineBreaks(r)
+
+			var actual []string
+			last := 0
+			for _, idx := range breaks {
+				actual = append(actual, string(r[last:idx]))
+				last = idx
+			}
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
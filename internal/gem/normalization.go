@@ -0,0 +1,137 @@
+package gem
+
+// This file implements the Unicode Normalization Algorithm as given in UAX #15
+// (Unicode Normalization Forms). NFD and NFKD are produced by Decompose, and
+// NFC and NFKC are produced by calling Compose on the output of Decompose.
+
+// constants for the algorithmic decomposition and composition of Hangul
+// syllables.
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// Decompose returns the full decomposition of the given runes with all
+// combining marks put into canonical order. If compat is false, only canonical
+// decomposition mappings are used and the result is in Normalization Form D
+// (NFD). If compat is true, compatibility decomposition mappings are used as
+// well and the result is in Normalization Form KD (NFKD).
+func Decompose(r []rune, compat bool) []rune {
+	decomposed := make([]rune, 0, len(r))
+	for i := range r {
+		decomposed = appendDecomposition(decomposed, r[i], compat)
+	}
+
+	// put each run of non-starters into canonical order. this is a stable
+	// insertion sort by combining class; runs are typically very short.
+	for i := 1; i < len(decomposed); i++ {
+		cc := combiningClasses[decomposed[i]]
+		if cc == 0 {
+			continue
+		}
+		for j := i; j > 0; j-- {
+			prevCC := combiningClasses[decomposed[j-1]]
+			if prevCC == 0 || prevCC <= cc {
+				break
+			}
+			decomposed[j-1], decomposed[j] = decomposed[j], decomposed[j-1]
+		}
+	}
+
+	return decomposed
+}
+
+// Compose performs canonical composition on the given runes, which must
+// already be fully decomposed (such as by a call to Decompose). If they were
+// decomposed canonically, the result is in Normalization Form C (NFC); if they
+// were decomposed with compatibility mappings, the result is in Normalization
+// Form KC (NFKC).
+func Compose(r []rune) []rune {
+	composed := make([]rune, 0, len(r))
+
+	starterIdx := -1
+
+	// lastCC is the combining class of the last character added after the
+	// starter, or -1 if there is none.
+	lastCC := -1
+
+	for i := range r {
+		cc := int(combiningClasses[r[i]])
+
+		// a character can only compose with the starter if no character
+		// between them blocks it.
+		if starterIdx >= 0 && (lastCC == -1 || (lastCC != 0 && lastCC < cc)) {
+			if primary, ok := composePair(composed[starterIdx], r[i]); ok {
+				composed[starterIdx] = primary
+				continue
+			}
+		}
+
+		if cc == 0 {
+			starterIdx = len(composed)
+			lastCC = -1
+		} else {
+			lastCC = cc
+		}
+		composed = append(composed, r[i])
+	}
+
+	return composed
+}
+
+// appendDecomposition appends the full decomposition of r to decomposed and
+// returns the result.
+func appendDecomposition(decomposed []rune, r rune, compat bool) []rune {
+	if hangulSBase <= r && r < hangulSBase+hangulSCount {
+		sIndex := r - hangulSBase
+		decomposed = append(decomposed, hangulLBase+sIndex/hangulNCount)
+		decomposed = append(decomposed, hangulVBase+(sIndex%hangulNCount)/hangulTCount)
+		if t := sIndex % hangulTCount; t != 0 {
+			decomposed = append(decomposed, hangulTBase+t)
+		}
+		return decomposed
+	}
+
+	mapping, ok := canonicalDecompositions[r]
+	if !ok && compat {
+		mapping, ok = compatibilityDecompositions[r]
+	}
+	if !ok {
+		return append(decomposed, r)
+	}
+
+	for i := range mapping {
+		decomposed = appendDecomposition(decomposed, mapping[i], compat)
+	}
+	return decomposed
+}
+
+// composePair returns the primary composite that first and second compose to,
+// if there is one.
+func composePair(first, second rune) (rune, bool) {
+	// Hangul LV syllable from leading and vowel jamo
+	if hangulLBase <= first && first < hangulLBase+hangulLCount {
+		if hangulVBase <= second && second < hangulVBase+hangulVCount {
+			lIndex := first - hangulLBase
+			vIndex := second - hangulVBase
+			return hangulSBase + (lIndex*hangulVCount+vIndex)*hangulTCount, true
+		}
+	}
+
+	// Hangul LVT syllable from LV syllable and trailing jamo
+	if hangulSBase <= first && first < hangulSBase+hangulSCount && (first-hangulSBase)%hangulTCount == 0 {
+		if hangulTBase < second && second < hangulTBase+hangulTCount {
+			return first + (second - hangulTBase), true
+		}
+	}
+
+	primary, ok := primaryComposites[[2]rune{first, second}]
+	return primary, ok
+}
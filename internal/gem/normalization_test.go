@@ -0,0 +1,70 @@
+package gem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Decompose(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		compat bool
+		expect string
+	}{
+		{"empty", "", false, ""},
+		{"ascii", "rose", false, "rose"},
+		{"precomposed char", "fianc\u00E9e", false, "fiance\u0301e"},
+		{"already decomposed char", "fiance\u0301e", false, "fiance\u0301e"},
+		{"multi-level decomposition", "\u1E69", false, "s\u0323\u0307"},
+		{"combining marks are reordered", "a\u0301\u0323", false, "a\u0323\u0301"},
+		{"singleton decomposition", "\u212B", false, "A\u030A"},
+		{"hangul LV syllable", "\uAC00", false, "\u1100\u1161"},
+		{"hangul LVT syllable", "\uD55C", false, "\u1112\u1161\u11AB"},
+		{"compatibility char, canonical", "\uFB01", false, "\uFB01"},
+		{"compatibility char, compat", "\uFB01", true, "fi"},
+		{"fullwidth char, compat", "\uFF21", true, "A"},
+		{"compatibility char with canonical parts, compat", "\u1E9B\u0323", true, "s\u0323\u0307"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := Decompose([]rune(tc.input), tc.compat)
+
+			assert.Equal(tc.expect, string(actual))
+		})
+	}
+}
+
+func Test_Compose(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expect string
+	}{
+		{"empty", "", ""},
+		{"ascii", "rose", "rose"},
+		{"decomposed char", "fiance\u0301e", "fianc\u00E9e"},
+		{"multiple marks", "s\u0323\u0307", "\u1E69"},
+		{"blocked mark is not composed", "a\u0301\u0301", "\u00E1\u0301"},
+		{"unblocked mark past another is composed", "a\u0323\u0302", "\u1EAD"},
+		{"composition exclusion is not composed", "\u0915\u093C", "\u0915\u093C"},
+		{"leading non-starter", "\u0301e", "\u0301e"},
+		{"hangul LV syllable", "\u1100\u1161", "\uAC00"},
+		{"hangul LVT syllable", "\u1112\u1161\u11AB", "\uD55C"},
+		{"hangul LV syllable and trailing jamo", "\uD558\u11AB", "\uD55C"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := Compose([]rune(tc.input))
+
+			assert.Equal(tc.expect, string(actual))
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package gem
+
+import "sort"
+
+// This file implements the sentence boundary rules of the Unicode Text
+// Segmentation algorithm as given in UAX #29. The default rules are implemented
+// with no tailoring.
+
+// sbClass is a value of the Sentence_Break property.
+type sbClass int
+
+// Values are taken from UAX #29 Table 4, "Sentence_Break Property Values".
+const (
+	sbOther sbClass = iota
+	sbCR
+	sbLF
+	sbExtend
+	sbSep
+	sbFormat
+	sbSp
+	sbLower
+	sbUpper
+	sbOLetter
+	sbNumeric
+	sbATerm
+	sbSContinue
+	sbSTerm
+	sbClose
+
+	// sbNone is used to mark the absence of a character, such as before the
+	// start of text.
+	sbNone sbClass = -1
+)
+
+// sbRange is a range of code points that all have the same Sentence_Break
+// value.
+type sbRange struct {
+	lo, hi rune
+	class  sbClass
+}
+
+// SentenceBreaks returns the positions of the sentence boundaries in r
+// according to UAX #29. Each position is the index of the rune that begins the
+// next sentence; the end of the text, len(r), is always included. The start of
+// the text is always a boundary as well but is not included, so 0 is never
+// included.
+//
+// Each segment between two boundaries includes any spaces and paragraph
+// separators that follow the sentence in it. Use [SentenceIndexes] to get the
+// sentences without them.
+func SentenceBreaks(r []rune) []int {
+	if len(r) < 1 {
+		return nil
+	}
+
+	classes := make([]sbClass, len(r))
+	for i := range r {
+		classes[i] = sentenceBreakClass(r[i])
+	}
+
+	var breaks []int
+
+	// prev is the class of the previous character after SB5 has been applied
+	// and prev2 is the class of the one before it.
+	prev := classes[0]
+	prev2 := sbNone
+
+	// term is the class of the ATerm or STerm that begins the
+	// SATerm Close* Sp* sequence that the text before the current position
+	// ends in, or sbNone if it does not end in one. afterSp is whether any Sp
+	// has been seen in that sequence.
+	term := sbNone
+	afterSp := false
+	if prev == sbATerm || prev == sbSTerm {
+		term = prev
+	}
+
+	for i := 1; i < len(r); i++ {
+		cur := classes[i]
+		rawPrev := classes[i-1]
+
+		// SB5 - Ignore Format and Extend characters, except after sot, Sep,
+		// CR, and LF; they are treated as if they were the character that
+		// they follow.
+		ignored := (cur == sbExtend || cur == sbFormat) && !sbIsParaSep(rawPrev)
+
+		if sentenceBreakBetween(prev, prev2, rawPrev, term, afterSp, cur, classes[i:], ignored) {
+			breaks = append(breaks, i)
+		}
+
+		if ignored {
+			continue
+		}
+
+		switch {
+		case cur == sbATerm || cur == sbSTerm:
+			term = cur
+			afterSp = false
+		case term != sbNone && cur == sbClose && !afterSp:
+			// still in the Close* part of the sequence
+		case term != sbNone && cur == sbSp:
+			afterSp = true
+		default:
+			term = sbNone
+			afterSp = false
+		}
+
+		prev2 = prev
+		prev = cur
+	}
+
+	// SB2 - Break at the end of text.
+	breaks = append(breaks, len(r))
+
+	return breaks
+}
+
+// SentenceIndexes returns the rune start and end indexes within r of each
+// sentence in it. Sentences are the segments between two of the boundaries
+// given by [SentenceBreaks] with all leading and trailing whitespace removed.
+// Segments made up of only whitespace are not included.
+//
+// The end indexes will be exclusive; e.g. the runes of "Hi. Bye." would
+// produce [][]int{{0, 3}, {4, 8}}.
+func SentenceIndexes(r []rune) [][]int {
+	var indexes [][]int
+	start := 0
+	for _, end := range SentenceBreaks(r) {
+		sentStart, sentEnd := start, end
+		for sentStart < sentEnd && sbIsSpace(sentenceBreakClass(r[sentStart])) {
+			sentStart++
+		}
+		for sentEnd > sentStart && sbIsSpace(sentenceBreakClass(r[sentEnd-1])) {
+			sentEnd--
+		}
+		if sentStart < sentEnd {
+			indexes = append(indexes, []int{sentStart, sentEnd})
+		}
+		start = end
+	}
+	return indexes
+}
+
+// sentenceBreakBetween returns whether there is a sentence boundary before the
+// character with class cur. following gives the classes of cur and every
+// character after it, and the rest of the arguments give the state of the text
+// before it as tracked by SentenceBreaks.
+func sentenceBreakBetween(prev, prev2, rawPrev, term sbClass, afterSp bool, cur sbClass, following []sbClass, ignored bool) bool {
+	// SB3 - Do not break within CRLF.
+	if rawPrev == sbCR && cur == sbLF {
+		return false
+	}
+
+	// SB4 - Break after paragraph separators.
+	if sbIsParaSep(rawPrev) {
+		return true
+	}
+
+	// SB5 - Ignore Format and Extend characters.
+	if ignored {
+		return false
+	}
+
+	// SB6 - Do not break after a full stop that is followed by a digit, such
+	// as in "3.4".
+	if prev == sbATerm && cur == sbNumeric {
+		return false
+	}
+
+	// SB7 - Do not break after a full stop between an upper or lowercase
+	// letter and an uppercase letter, such as in "U.S.A.".
+	if (prev2 == sbUpper || prev2 == sbLower) && prev == sbATerm && cur == sbUpper {
+		return false
+	}
+
+	// SB8 - Do not break after a full stop if the next letter after it is
+	// lowercase, even if there is other punctuation in between.
+	if term == sbATerm {
+		for _, c := range following {
+			if c == sbLower {
+				return false
+			}
+			if sbIsOneOf(c, sbOLetter, sbUpper, sbSep, sbCR, sbLF, sbSTerm, sbATerm) {
+				break
+			}
+		}
+	}
+
+	// SB8a - Do not break before characters that continue a sentence, or
+	// before more sentence terminators.
+	if term != sbNone && sbIsOneOf(cur, sbSContinue, sbSTerm, sbATerm) {
+		return false
+	}
+
+	// SB9 - Do not break between sentence terminators and closing punctuation,
+	// spaces, or paragraph separators that follow them.
+	if term != sbNone && !afterSp && (cur == sbClose || cur == sbSp || sbIsParaSep(cur)) {
+		return false
+	}
+
+	// SB10 - Do not break before spaces or paragraph separators that follow a
+	// sentence terminator.
+	if term != sbNone && (cur == sbSp || sbIsParaSep(cur)) {
+		return false
+	}
+
+	// SB11 - Break after sentence terminators, but include closing punctuation,
+	// trailing spaces, and any paragraph separator.
+	if term != sbNone {
+		return true
+	}
+
+	// SB998 - Otherwise, do not break.
+	return false
+}
+
+// sentenceBreakClass returns the Sentence_Break property value of r.
+func sentenceBreakClass(r rune) sbClass {
+	idx := sort.Search(len(sentenceBreakRanges), func(i int) bool {
+		return sentenceBreakRanges[i].hi >= r
+	})
+	if idx < len(sentenceBreakRanges) && sentenceBreakRanges[idx].lo <= r {
+		return sentenceBreakRanges[idx].class
+	}
+	return sbOther
+}
+
+// sbIsParaSep returns whether class is in the ParaSep group of UAX #29.
+func sbIsParaSep(class sbClass) bool {
+	return class == sbSep || class == sbCR || class == sbLF
+}
+
+// sbIsSpace returns whether class is a space or a paragraph separator.
+func sbIsSpace(class sbClass) bool {
+	return class == sbSp || sbIsParaSep(class)
+}
+
+func sbIsOneOf(class sbClass, classes ...sbClass) bool {
+	for _, c := range classes {
+		if class == c {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package gem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SentenceBreaks(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expect []string
+	}{
+		{"empty", "", nil},
+		{"single sentence", "This is a sentence.", []string{"This is a sentence."}},
+		{"multiple sentences", "Hello there. How are you? Fine!", []string{"Hello there. ", "How are you? ", "Fine!"}},
+		{"abbreviation before lowercase", "See e.g. the docs. Then go.", []string{"See e.g. the docs. ", "Then go."}},
+		{"decimal number", "It costs 3.50 today. Cheap.", []string{"It costs 3.50 today. ", "Cheap."}},
+		{"initialism", "The U.S.A. is big.", []string{"The U.S.A. is big."}},
+		{"closing punctuation is kept", "He said \"Stop.\" Then left.", []string{"He said \"Stop.\" ", "Then left."}},
+		{"repeated terminators", "Really?! Yes.", []string{"Really?! ", "Yes."}},
+		{"break after paragraph separator", "No terminator\nNext line", []string{"No terminator\n", "Next line"}},
+		{"CR LF is not split", "One.\r\nTwo.", []string{"One.\r\n", "Two."}},
+		{"ideographic full stop", "日本語。テキスト。", []string{"日本語。", "テキスト。"}},
+		{"extend after terminator", "One.\u0301 Two.", []string{"One.\u0301 ", "Two."}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			r := []rune(tc.input)
+			breaks := SentenceBreaks(r)
+
+			var actual []string
+			last := 0
+			for _, idx := range breaks {
+				actual = append(actual, string(r[last:idx]))
+				last = idx
+			}
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_SentenceIndexes(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expect [][]int
+	}{
+		{"empty", "", nil},
+		{"single sentence", "Hi.", [][]int{{0, 3}}},
+		{"trailing space is excluded", "Hi. Bye. ", [][]int{{0, 3}, {4, 8}}},
+		{"leading space is excluded", "  Hi.", [][]int{{2, 5}}},
+		{"only whitespace", " \n\n ", nil},
+		{"blank line between", "Hi.\n\nBye.", [][]int{{0, 3}, {5, 9}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := SentenceIndexes([]rune(tc.input))
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
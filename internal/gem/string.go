@@ -414,6 +414,22 @@ func (str String) Sub(start, end int) String {
 	return clone
 }
 
+// Width returns the number of terminal cells that the String occupies when
+// displayed in a fixed-width context. This is the sum of [ClusterWidth] for
+// every grapheme cluster in the String.
+//
+// This function may trigger UAX29 analysis on the String if it hasn't yet
+// occured.
+func (str String) Width() int {
+	str = str.initialized()
+
+	width := 0
+	for i := 0; i < str.Len(); i++ {
+		width += ClusterWidth(str.CharAt(i))
+	}
+	return width
+}
+
 // New takes the given string and converts it into a graphemes.String object for
 // use with grapheme-aware functions. UAX-29 analysis is performed on a lazy
 // basis; the contents of s are not scanned for grapheme clusters until an
@@ -574,3 +574,80 @@ func Test_String_LastIndexFunc(t *testing.T) {
 		})
 	}
 }
+
+func Test_String_Width(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  String
+		expect int
+	}{
+		{"empty string", Zero, 0},
+		{"narrow chars", New("rose"), 4},
+		{"wide chars", New("日本語"), 6},
+		{"mixed widths", New("Roseさん"), 8},
+		{"decomposed char", New("fiance\u0301e"), 7},
+		{"zwj emoji sequence with several modifiers", New("\U0001F926\U0001F3FC\u200D\u2642\uFE0F"), 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.Width()
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_String_EscapeAt(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  String
+		idx    int
+		expect int
+	}{
+		{"empty string", Zero, 0, 0},
+		{"out of bounds", New("rose"), 8, 0},
+		{"no escape", New("rose"), 0, 0},
+		{"escape at start", New("\x1b[31mrose"), 0, 5},
+		{"escape mid-string", New("a\x1b[1mb"), 1, 4},
+		{"index inside escape", New("\x1b[31mrose"), 1, 0},
+		{"OSC with spaces", New("\x1b]0;a title\arose"), 0, 12},
+		{"combining mark after escape is kept with it", New("\x1b[1m\u0301a"), 0, 4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.EscapeAt(tc.idx)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_String_StripEscapes(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  String
+		expect string
+	}{
+		{"empty string", Zero, ""},
+		{"no escapes", New("rose"), "rose"},
+		{"SGR sequences", New("\x1b[1;31mrose\x1b[0m lalonde"), "rose lalonde"},
+		{"hyperlink", New("\x1b]8;;http://example.com\x1b\\link\x1b]8;;\x1b\\"), "link"},
+		{"incomplete escape is kept", New("rose\x1b[31"), "rose\x1b[31"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.StripEscapes()
+
+			assert.Equal(tc.expect, actual.String())
+		})
+	}
+}
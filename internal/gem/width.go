@@ -0,0 +1,62 @@
+package gem
+
+import "unicode"
+
+// This file implements display width measurement of grapheme clusters based
+// on UAX #11 (East Asian Width) and the emoji presentation rules given in
+// UTS #51.
+
+const (
+	// emojiPresentationSelector is VARIATION SELECTOR-16, which requests that
+	// the preceding character be displayed as an emoji.
+	emojiPresentationSelector = 0xFE0F
+)
+
+// ClusterWidth returns the number of terminal cells that the given grapheme
+// cluster occupies when displayed in a fixed-width context such as a terminal.
+// The result will be 0, 1, or 2.
+//
+// Wide and Fullwidth characters (as given by their East_Asian_Width property)
+// and emoji presented as emoji occupy two cells. Control characters, format
+// characters, and combining marks that do not have a base character to combine
+// with occupy no cells. Everything else, including characters with an
+// East_Asian_Width of Ambiguous, occupies one cell.
+func ClusterWidth(gc []rune) int {
+	if len(gc) == 0 {
+		return 0
+	}
+
+	base := gc[0]
+
+	if isCbControl(base) || isCbCR(base) || isCbLF(base) || isCbZWJ(base) {
+		return 0
+	}
+	if unicode.In(base, unicode.Mn, unicode.Me, unicode.Cf) {
+		return 0
+	}
+
+	// a pair of regional indicators is a flag, which is always shown as an
+	// emoji.
+	if isCbRegionalIndicator(base) {
+		if len(gc) > 1 && isCbRegionalIndicator(gc[1]) {
+			return 2
+		}
+		return 1
+	}
+
+	if isEawWide(base) || isEawFullwidth(base) {
+		return 2
+	}
+
+	// characters that default to text presentation are shown as emoji if they
+	// are followed by VS16.
+	if isExtPicto(base) {
+		for _, r := range gc[1:] {
+			if r == emojiPresentationSelector {
+				return 2
+			}
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,48 @@
+package gem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ClusterWidth(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  []rune
+		expect int
+	}{
+		{"empty cluster", []rune{}, 0},
+		{"ascii letter", []rune("a"), 1},
+		{"precomposed latin", []rune("\u00E9"), 1},
+		{"decomposed latin", []rune("e\u0301"), 1},
+		{"ambiguous width", []rune("±"), 1},
+		{"CJK ideograph", []rune("漢"), 2},
+		{"hiragana", []rune("の"), 2},
+		{"hangul syllable", []rune("한"), 2},
+		{"hangul jamo sequence", []rune("\u1112\u1161\u11AB"), 2},
+		{"fullwidth latin", []rune("Ａ"), 2},
+		{"halfwidth katakana", []rune("ｱ"), 1},
+		{"emoji presentation", []rune("🌹"), 2},
+		{"emoji ZWJ sequence", []rune("\U0001F468\u200D\U0001F469\u200D\U0001F467"), 2},
+		{"emoji with skin tone modifier", []rune("\U0001F44D\U0001F3FD"), 2},
+		{"text presentation emoji", []rune("\u2764"), 1},
+		{"text presentation emoji with VS16", []rune("\u2764\uFE0F"), 2},
+		{"flag", []rune("\U0001F1EF\U0001F1F5"), 2},
+		{"lone regional indicator", []rune("\U0001F1EF"), 1},
+		{"control char", []rune("\t"), 0},
+		{"line feed", []rune("\n"), 0},
+		{"zero width space", []rune("\u200B"), 0},
+		{"lone combining mark", []rune("\u0301"), 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := ClusterWidth(tc.input)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
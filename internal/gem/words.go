@@ -0,0 +1,277 @@
+package gem
+
+import (
+	"sort"
+	"unicode"
+)
+
+// This file implements the word boundary rules of the Unicode Text
+// Segmentation algorithm as given in UAX #29. The default rules are implemented
+// with no tailoring.
+
+// wbClass is a value of the Word_Break property.
+type wbClass int
+
+// Values are taken from UAX #29 Table 3, "Word_Break Property Values".
+const (
+	wbOther wbClass = iota
+	wbCR
+	wbLF
+	wbNewline
+	wbExtend
+	wbZWJ
+	wbRegionalIndicator
+	wbFormat
+	wbKatakana
+	wbHebrewLetter
+	wbALetter
+	wbSingleQuote
+	wbDoubleQuote
+	wbMidNumLet
+	wbMidLetter
+	wbMidNum
+	wbNumeric
+	wbExtendNumLet
+	wbWSegSpace
+
+	// wbNone is used to mark the absence of a character, such as before the
+	// start of text.
+	wbNone wbClass = -1
+)
+
+// wbRange is a range of code points that all have the same Word_Break value.
+type wbRange struct {
+	lo, hi rune
+	class  wbClass
+}
+
+// WordBreaks returns the positions of the word boundaries in r according to
+// UAX #29. Each position is the index of the rune that begins the next segment;
+// the end of the text, len(r), is always included. The start of the text is
+// always a boundary as well but is not included, so 0 is never included.
+//
+// Not every segment between two boundaries is a word; spaces and punctuation
+// are given segments of their own. Use [WordIndexes] to get only the segments
+// that are words.
+func WordBreaks(r []rune) []int {
+	if len(r) < 1 {
+		return nil
+	}
+
+	classes := make([]wbClass, len(r))
+	for i := range r {
+		classes[i] = wordBreakClass(r[i])
+	}
+
+	var breaks []int
+
+	// prev is the class of the previous character after WB4 has been applied
+	// and prev2 is the class of the one before it.
+	prev := classes[0]
+	prev2 := wbNone
+
+	// riCount is the number of consecutive RI characters ending at prev.
+	riCount := 0
+	if prev == wbRegionalIndicator {
+		riCount = 1
+	}
+
+	for i := 1; i < len(r); i++ {
+		cur := classes[i]
+		rawPrev := classes[i-1]
+
+		// WB4 - Ignore Format and Extend characters, except after sot, CR, LF,
+		// and Newline; they are treated as if they were the character that
+		// they follow.
+		ignored := wbIsOneOf(cur, wbExtend, wbFormat, wbZWJ) && !wbIsOneOf(rawPrev, wbCR, wbLF, wbNewline)
+
+		// the rules that look past the current character need the class of
+		// the next one that is not ignored.
+		next := wbNone
+		for j := i + 1; j < len(r); j++ {
+			if !wbIsOneOf(classes[j], wbExtend, wbFormat, wbZWJ) {
+				next = classes[j]
+				break
+			}
+		}
+
+		if wordBreakBetween(prev, prev2, rawPrev, riCount, cur, next, r[i], ignored) {
+			breaks = append(breaks, i)
+		}
+
+		if ignored {
+			continue
+		}
+
+		prev2 = prev
+		prev = cur
+		if cur == wbRegionalIndicator {
+			riCount++
+		} else {
+			riCount = 0
+		}
+	}
+
+	// WB2 - Break at the end of text.
+	breaks = append(breaks, len(r))
+
+	return breaks
+}
+
+// WordIndexes returns the rune start and end indexes within r of each word in
+// it. A word is a segment between two of the boundaries given by [WordBreaks]
+// that contains at least one letter or number; segments made up of only
+// spaces, punctuation, or symbols are not included.
+//
+// The end indexes will be exclusive; e.g. the runes of "a dog" would produce
+// [][]int{{0, 1}, {2, 5}}.
+func WordIndexes(r []rune) [][]int {
+	var indexes [][]int
+	start := 0
+	for _, end := range WordBreaks(r) {
+		for i := start; i < end; i++ {
+			if unicode.IsLetter(r[i]) || unicode.IsNumber(r[i]) {
+				indexes = append(indexes, []int{start, end})
+				break
+			}
+		}
+		start = end
+	}
+	return indexes
+}
+
+// wordBreakBetween returns whether there is a word boundary before the
+// character with class cur. next is the class of the first character after it
+// that is not ignored by WB4, and the rest of the arguments give the state of
+// the text before it as tracked by WordBreaks.
+func wordBreakBetween(prev, prev2, rawPrev wbClass, riCount int, cur, next wbClass, r rune, ignored bool) bool {
+	// WB3 - Do not break within CRLF.
+	if rawPrev == wbCR && cur == wbLF {
+		return false
+	}
+
+	// WB3a - Otherwise, break after Newlines.
+	if wbIsOneOf(rawPrev, wbCR, wbLF, wbNewline) {
+		return true
+	}
+
+	// WB3b - Otherwise, break before Newlines.
+	if wbIsOneOf(cur, wbCR, wbLF, wbNewline) {
+		return true
+	}
+
+	// WB3c - Do not break within emoji zwj sequences.
+	if rawPrev == wbZWJ && isExtPicto(r) {
+		return false
+	}
+
+	// WB3d - Keep horizontal whitespace together.
+	if rawPrev == wbWSegSpace && cur == wbWSegSpace {
+		return false
+	}
+
+	// WB4 - Ignore Format and Extend characters.
+	if ignored {
+		return false
+	}
+
+	// WB5 - Do not break between most letters.
+	if wbIsAHLetter(prev) && wbIsAHLetter(cur) {
+		return false
+	}
+
+	// WB6 and WB7 - Do not break letters across certain punctuation.
+	if wbIsAHLetter(prev) && wbIsMidLetterOrQ(cur) && wbIsAHLetter(next) {
+		return false
+	}
+	if wbIsAHLetter(prev2) && wbIsMidLetterOrQ(prev) && wbIsAHLetter(cur) {
+		return false
+	}
+
+	// WB7a, WB7b, and WB7c - Do not break within Hebrew letters and quotation
+	// marks.
+	if prev == wbHebrewLetter && cur == wbSingleQuote {
+		return false
+	}
+	if prev == wbHebrewLetter && cur == wbDoubleQuote && next == wbHebrewLetter {
+		return false
+	}
+	if prev2 == wbHebrewLetter && prev == wbDoubleQuote && cur == wbHebrewLetter {
+		return false
+	}
+
+	// WB8, WB9, and WB10 - Do not break within sequences of digits, or digits
+	// adjacent to letters.
+	if (prev == wbNumeric || wbIsAHLetter(prev)) && (cur == wbNumeric || wbIsAHLetter(cur)) {
+		return false
+	}
+
+	// WB11 and WB12 - Do not break within sequences, such as "3.2" or
+	// "3,456.789".
+	if prev2 == wbNumeric && wbIsMidNumOrQ(prev) && cur == wbNumeric {
+		return false
+	}
+	if prev == wbNumeric && wbIsMidNumOrQ(cur) && next == wbNumeric {
+		return false
+	}
+
+	// WB13 - Do not break between Katakana.
+	if prev == wbKatakana && cur == wbKatakana {
+		return false
+	}
+
+	// WB13a and WB13b - Do not break from extenders.
+	if (wbIsAHLetter(prev) || wbIsOneOf(prev, wbNumeric, wbKatakana, wbExtendNumLet)) && cur == wbExtendNumLet {
+		return false
+	}
+	if prev == wbExtendNumLet && (wbIsAHLetter(cur) || wbIsOneOf(cur, wbNumeric, wbKatakana)) {
+		return false
+	}
+
+	// WB15 and WB16 - Do not break within emoji flag sequences. That is, do
+	// not break between regional indicator (RI) symbols if there is an odd
+	// number of RI characters before the break point.
+	if prev == wbRegionalIndicator && cur == wbRegionalIndicator && riCount%2 == 1 {
+		return false
+	}
+
+	// WB999 - Otherwise, break everywhere (including around ideographs).
+	return true
+}
+
+// wordBreakClass returns the Word_Break property value of r.
+func wordBreakClass(r rune) wbClass {
+	idx := sort.Search(len(wordBreakRanges), func(i int) bool {
+		return wordBreakRanges[i].hi >= r
+	})
+	if idx < len(wordBreakRanges) && wordBreakRanges[idx].lo <= r {
+		return wordBreakRanges[idx].class
+	}
+	return wbOther
+}
+
+// wbIsAHLetter returns whether class is in the AHLetter group of UAX #29.
+func wbIsAHLetter(class wbClass) bool {
+	return class == wbALetter || class == wbHebrewLetter
+}
+
+// wbIsMidLetterOrQ returns whether class is MidLetter or in the MidNumLetQ
+// group of UAX #29.
+func wbIsMidLetterOrQ(class wbClass) bool {
+	return wbIsOneOf(class, wbMidLetter, wbMidNumLet, wbSingleQuote)
+}
+
+// wbIsMidNumOrQ returns whether class is MidNum or in the MidNumLetQ group of
+// UAX #29.
+func wbIsMidNumOrQ(class wbClass) bool {
+	return wbIsOneOf(class, wbMidNum, wbMidNumLet, wbSingleQuote)
+}
+
+func wbIsOneOf(class wbClass, classes ...wbClass) bool {
+	for _, c := range classes {
+		if class == c {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package gem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WordBreaks(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expect []string
+	}{
+		{"empty", "", nil},
+		{"single word", "rose", []string{"rose"}},
+		{"words and punctuation", "Hello, world!", []string{"Hello", ",", " ", "world", "!"}},
+		{"apostrophe within word", "can't stop", []string{"can't", " ", "stop"}},
+		{"trailing apostrophe", "dogs' toys", []string{"dogs", "'", " ", "toys"}},
+		{"decimal numbers", "3.14 and 3,456.789", []string{"3.14", " ", "and", " ", "3,456.789"}},
+		{"abbreviation", "e.g. this", []string{"e.g", ".", " ", "this"}},
+		{"letters and digits", "abc123 4b", []string{"abc123", " ", "4b"}},
+		{"underscore joins", "foo_bar baz", []string{"foo_bar", " ", "baz"}},
+		{"hyphen splits", "well-known", []string{"well", "-", "known"}},
+		{"whitespace kept together", "a   b", []string{"a", "   ", "b"}},
+		{"combining mark is not separated", "e\u0301te", []string{"e\u0301te"}},
+		{"CR LF is not split", "a\r\nb", []string{"a", "\r\n", "b"}},
+		{"katakana", "カタカナ", []string{"カタカナ"}},
+		{"ideographs", "日本語", []string{"日", "本", "語"}},
+		{"hebrew with quote", "צה\"ל", []string{"צה\"ל"}},
+		{"regional indicator pairs", "\U0001F1EF\U0001F1F5\U0001F1FA\U0001F1F8", []string{"\U0001F1EF\U0001F1F5", "\U0001F1FA\U0001F1F8"}},
+		{"emoji zwj sequence", "\U0001F469\u200d\U0001F467!", []string{"\U0001F469\u200d\U0001F467", "!"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			r := []rune(tc.input)
+			breaks := WordBreaks(r)
+
+			var actual []string
+			last := 0
+			for _, idx := range breaks {
+				actual = append(actual, string(r[last:idx]))
+				last = idx
+			}
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_WordIndexes(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expect [][]int
+	}{
+		{"empty", "", nil},
+		{"single word", "rose", [][]int{{0, 4}}},
+		{"words and punctuation", "a dog, 2 cats!", [][]int{{0, 1}, {2, 5}, {7, 8}, {9, 13}}},
+		{"only punctuation", "... !?", nil},
+		{"leading and trailing space", "  hi  ", [][]int{{2, 4}}},
+		{"ideographs", "日本", [][]int{{0, 1}, {1, 2}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := WordIndexes([]rune(tc.input))
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
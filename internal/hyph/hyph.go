@@ -0,0 +1,252 @@
+// Package hyph finds the points at which words can be hyphenated using the
+// pattern-based algorithm that Frank Liang developed for TeX.
+//
+// A set of patterns gives, for sequences of letters, a value for each position
+// between the letters. To hyphenate a word, every pattern that matches part of
+// the word is applied to it, and each position in the word is given the
+// highest value any of those patterns give it. The word can be hyphenated at
+// every position whose value is odd.
+package hyph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	// DefaultLeftMin is the default minimum number of letters that must come
+	// before a hyphen in a word.
+	DefaultLeftMin = 2
+
+	// DefaultRightMin is the default minimum number of letters that must come
+	// after a hyphen in a word.
+	DefaultRightMin = 3
+)
+
+var (
+	english     *Hyphenator
+	englishOnce sync.Once
+)
+
+// Hyphenator finds the points at which words can be hyphenated. It must be
+// created with New or Parse.
+type Hyphenator struct {
+	// patterns maps the letters of each pattern to the values at each
+	// position in it. There is one more value than there are letters.
+	patterns map[string][]int
+
+	// exceptions maps the letters of each word that is not hyphenated by
+	// patterns to the positions it is hyphenated at.
+	exceptions map[string][]int
+
+	// maxLen is the number of letters in the longest pattern.
+	maxLen int
+
+	// LeftMin is the minimum number of letters that must come before a
+	// hyphen.
+	LeftMin int
+
+	// RightMin is the minimum number of letters that must come after a
+	// hyphen.
+	RightMin int
+}
+
+// English returns a Hyphenator that uses the built-in American English
+// patterns. The same Hyphenator is returned from every call.
+func English() *Hyphenator {
+	englishOnce.Do(func() {
+		h, err := New(englishPatterns, englishExceptions)
+		if err != nil {
+			panic(fmt.Sprintf("built-in English hyphenation patterns are invalid: %v", err))
+		}
+		english = h
+	})
+	return english
+}
+
+// New creates a Hyphenator from a list of patterns and a list of exceptions,
+// each separated by whitespace. Patterns are given in TeX's format, such as
+// "a1b" or ".ex5". Exceptions are words with a "-" at each point where they
+// can be hyphenated, such as "as-so-ciate", and they are hyphenated only at
+// those points regardless of the patterns. Anything after a "%" on a line is
+// a comment and is ignored.
+func New(patterns, exceptions string) (*Hyphenator, error) {
+	h := &Hyphenator{
+		patterns:   make(map[string][]int),
+		exceptions: make(map[string][]int),
+		LeftMin:    DefaultLeftMin,
+		RightMin:   DefaultRightMin,
+	}
+
+	for _, pat := range fields(patterns) {
+		if err := h.addPattern(pat); err != nil {
+			return nil, err
+		}
+	}
+	for _, word := range fields(exceptions) {
+		if err := h.addException(word); err != nil {
+			return nil, err
+		}
+	}
+
+	return h, nil
+}
+
+// Parse creates a Hyphenator from the contents of a TeX hyphenation file. The
+// patterns are read from the \patterns{...} group in it and the exceptions are
+// read from the \hyphenation{...} group, if there is one. Everything else in
+// the file is ignored.
+func Parse(tex string) (*Hyphenator, error) {
+	tex = stripComments(tex)
+
+	patterns, ok := group(tex, `\patterns`)
+	if !ok {
+		return nil, fmt.Errorf("no \\patterns group found")
+	}
+	exceptions, _ := group(tex, `\hyphenation`)
+
+	return New(patterns, exceptions)
+}
+
+// Len returns the number of patterns and the number of exceptions in h.
+func (h *Hyphenator) Len() (patterns, exceptions int) {
+	return len(h.patterns), len(h.exceptions)
+}
+
+// Points returns the positions in word at which it can be hyphenated. Each
+// position is the index of the rune that would begin the text after the
+// hyphen. Case is ignored, and positions that would leave fewer than LeftMin
+// runes before the hyphen or fewer than RightMin runes after it are not
+// included.
+func (h *Hyphenator) Points(word []rune) []int {
+	lower := make([]rune, len(word))
+	for i := range word {
+		lower[i] = unicode.ToLower(word[i])
+	}
+
+	var points []int
+	if exc, ok := h.exceptions[string(lower)]; ok {
+		for _, p := range exc {
+			if h.allowed(p, len(word)) {
+				points = append(points, p)
+			}
+		}
+		return points
+	}
+
+	// values[i] is the value of the position just before text[i], where text
+	// is the word with a "." to mark each end of it.
+	text := []rune("." + string(lower) + ".")
+	values := make([]int, len(text)+1)
+	for start := range text {
+		for end := start + 1; end <= len(text) && end-start <= h.maxLen; end++ {
+			pat, ok := h.patterns[string(text[start:end])]
+			if !ok {
+				continue
+			}
+			for i, v := range pat {
+				if v > values[start+i] {
+					values[start+i] = v
+				}
+			}
+		}
+	}
+
+	for p := 1; p < len(word); p++ {
+		// the position before word[p] is the position before text[p+1].
+		if values[p+1]%2 == 1 && h.allowed(p, len(word)) {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+// allowed returns whether a hyphen can go at position p in a word of length n
+// given the minimums of h.
+func (h *Hyphenator) allowed(p, n int) bool {
+	return p >= h.LeftMin && n-p >= h.RightMin && p > 0 && p < n
+}
+
+// addPattern parses a single pattern and adds it to h.
+func (h *Hyphenator) addPattern(pat string) error {
+	var letters []rune
+	values := []int{0}
+	for _, r := range pat {
+		if r >= '0' && r <= '9' {
+			if values[len(values)-1] != 0 {
+				return fmt.Errorf("invalid pattern %q: two values in a row", pat)
+			}
+			values[len(values)-1] = int(r - '0')
+			continue
+		}
+		letters = append(letters, unicode.ToLower(r))
+		values = append(values, 0)
+	}
+	if len(letters) < 1 {
+		return fmt.Errorf("invalid pattern %q: no letters", pat)
+	}
+
+	key := string(letters)
+	if _, ok := h.patterns[key]; ok {
+		return fmt.Errorf("invalid pattern %q: duplicate of an earlier pattern", pat)
+	}
+	h.patterns[key] = values
+	if len(letters) > h.maxLen {
+		h.maxLen = len(letters)
+	}
+	return nil
+}
+
+// addException parses a single hyphenated word and adds it to h.
+func (h *Hyphenator) addException(word string) error {
+	var letters []rune
+	var points []int
+	for _, r := range word {
+		if r == '-' {
+			points = append(points, len(letters))
+			continue
+		}
+		letters = append(letters, unicode.ToLower(r))
+	}
+	if len(letters) < 1 {
+		return fmt.Errorf("invalid exception %q: no letters", word)
+	}
+
+	sort.Ints(points)
+	h.exceptions[string(letters)] = points
+	return nil
+}
+
+// fields splits s into its whitespace-separated entries, ignoring comments.
+func fields(s string) []string {
+	return strings.Fields(stripComments(s))
+}
+
+// stripComments removes everything from each "%" to the end of its line.
+func stripComments(s string) string {
+	lines := strings.Split(s, "\n")
+	for i := range lines {
+		if idx := strings.Index(lines[i], "%"); idx >= 0 {
+			lines[i] = lines[i][:idx]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// group returns the contents of the braces that follow the first instance of
+// the given command in tex.
+func group(tex, command string) (string, bool) {
+	idx := strings.Index(tex, command+"{")
+	if idx < 0 {
+		return "", false
+	}
+	contents := tex[idx+len(command)+1:]
+	end := strings.Index(contents, "}")
+	if end < 0 {
+		return "", false
+	}
+	return contents[:end], true
+}
@@ -0,0 +1,179 @@
+package hyph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_English_Points(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expect string
+	}{
+		{"empty", "", ""},
+		{"short word", "the", "the"},
+		{"hyphenated by patterns", "hyphenation", "hy-phen-a-tion"},
+		{"many points", "incomprehensibilities", "in-com-pre-hen-si-bil-i-ties"},
+		{"exception", "associate", "as-so-ciate"},
+		{"case is ignored", "TyPography", "Ty-Pog-ra-phy"},
+		{"right minimum", "table", "ta-ble"},
+		{"no points", "project", "project"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := hyphenated(English(), tc.input)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_Hyphenator_Points_minimums(t *testing.T) {
+	testCases := []struct {
+		name     string
+		leftMin  int
+		rightMin int
+		input    string
+		expect   string
+	}{
+		{"defaults", DefaultLeftMin, DefaultRightMin, "hyphenation", "hy-phen-a-tion"},
+		{"larger left minimum", 3, 3, "hyphenation", "hyphen-a-tion"},
+		{"smaller right minimum", 2, 2, "hyphenation", "hy-phen-a-tion"},
+		{"larger right minimum", 2, 5, "hyphenation", "hy-phen-ation"},
+		{"minimums apply to exceptions", 3, 3, "associate", "asso-ciate"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			h := *English()
+			h.LeftMin = tc.leftMin
+			h.RightMin = tc.rightMin
+
+			actual := hyphenated(&h, tc.input)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_New(t *testing.T) {
+	testCases := []struct {
+		name       string
+		patterns   string
+		exceptions string
+		input      string
+		expect     string
+		expectErr  bool
+	}{
+		{"empty", "", "", "rose", "rose", false},
+		{"single pattern", "o1s", "", "roses", "ro-ses", false},
+		{"higher value wins", "o1s o2se", "", "roses", "roses", false},
+		{"word boundary at start", ".r1", "", "rosier", "r-osier", false},
+		{"word boundary at end", "1er.", "", "rosier", "rosi-er", false},
+		{"comments are ignored", "% a comment\no1s % o2s\n", "", "roses", "ro-ses", false},
+		{"exception", "", "rose-s", "roses", "rose-s", false},
+		{"exception overrides patterns", "o1s", "ros-es", "roses", "ros-es", false},
+		{"pattern only matches within exception words", "o1s", "ros-es", "rosy", "ro-sy", false},
+		{"pattern without letters", "12", "", "", "", true},
+		{"two values in a row", "a12b", "", "", "", true},
+		{"duplicate pattern", "a1b a2b", "", "", "", true},
+		{"exception without letters", "", "--", "", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			h, err := New(tc.patterns, tc.exceptions)
+			if tc.expectErr {
+				assert.Error(err)
+				return
+			}
+			if !assert.NoError(err) {
+				return
+			}
+			h.LeftMin = 1
+			h.RightMin = 1
+
+			actual := hyphenated(h, tc.input)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_Parse(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		word      string
+		expect    string
+		expectErr bool
+	}{
+		{
+			name:   "patterns only",
+			input:  "\\patterns{\no1s\n}\n",
+			word:   "roses",
+			expect: "ro-ses",
+		},
+		{
+			name:   "patterns and exceptions",
+			input:  "% roses\n\\message{Roses}\n\\patterns{o1s}\n\\hyphenation{ros-es % plural\n}\n",
+			word:   "roses",
+			expect: "ros-es",
+		},
+		{
+			name:      "no patterns",
+			input:     "\\hyphenation{ros-es}",
+			expectErr: true,
+		},
+		{
+			name:      "unclosed patterns",
+			input:     "\\patterns{o1s",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			h, err := Parse(tc.input)
+			if tc.expectErr {
+				assert.Error(err)
+				return
+			}
+			if !assert.NoError(err) {
+				return
+			}
+			h.LeftMin = 1
+			h.RightMin = 1
+
+			actual := hyphenated(h, tc.word)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+// hyphenated returns word with a "-" inserted at each point that h gives.
+func hyphenated(h *Hyphenator, word string) string {
+	r := []rune(word)
+	var sb strings.Builder
+	last := 0
+	for _, p := range h.Points(r) {
+		sb.WriteString(string(r[last:p]))
+		sb.WriteRune('-')
+		last = p
+	}
+	sb.WriteString(string(r[last:]))
+	return sb.String()
+}
@@ -0,0 +1,325 @@
+package manip
+
+// This file contains the routines for wrapping text by choosing the set of line
+// breaks that is best for a paragraph as a whole, as opposed to filling each
+// line as much as possible before moving on to the next.
+
+import (
+	"github.com/dekarrin/rosed/internal/gem"
+	"github.com/dekarrin/rosed/internal/tb"
+)
+
+// WrapAlgorithm is the way that Wrap chooses where to break lines.
+type WrapAlgorithm int
+
+const (
+	// Greedy fills each line with as many words as will fit before moving on
+	// to the next line.
+	Greedy WrapAlgorithm = iota
+
+	// MinimumRaggedness chooses the line breaks that minimize the sum of the
+	// squares of the space left at the end of every line except the last.
+	MinimumRaggedness
+
+	// KnuthPlass chooses the line breaks that minimize the total demerits of
+	// the paragraph as given by the Knuth-Plass line breaking algorithm, where
+	// the badness of a line is based on how much its spaces must be stretched
+	// to justify it.
+	KnuthPlass
+)
+
+const (
+	// linePenalty is added to the badness of every line in KnuthPlass to
+	// favor using fewer lines.
+	linePenalty = 10
+
+	// maxBadness is the most badness that a line with spaces in it is given,
+	// however far they must be stretched to fill its width.
+	maxBadness = 10000
+
+	// infiniteBadness is the badness of a line other than the last that does
+	// not fill its width and has no spaces that can be stretched to fill it.
+	// It is far more than maxBadness so that a word is only stranded on a line
+	// by itself when there is no other way to break the paragraph.
+	infiniteBadness = 1000000
+
+	// adjacentDemerits is added to the demerits of a line in KnuthPlass whose
+	// fitness class is not adjacent to that of the line before it.
+	adjacentDemerits = 10000
+
+	// numFitnessClasses is the number of fitness classes that a line can be
+	// in. As lines are never shrunk, only the decent, loose, and very loose
+	// classes are used.
+	numFitnessClasses = 3
+)
+
+// wrapItem is a unit of text that is never broken by an optimal fit.
+type wrapItem struct {
+	// text is the item with any space that follows it.
+	text gem.String
+
+	// trimmed is the item without any space that follows it, for use when it
+	// is the last item on a line.
+	trimmed gem.String
+
+	width        int
+	trimmedWidth int
+
+	// spaces is the number of spaces that follow the item that can be
+	// stretched to justify the line.
+	spaces int
+
+	// forceBreak is whether the line must be broken after the item.
+	forceBreak bool
+
+	// hyphen is whether the item is a part of a word that is followed by the
+	// rest of the word, in which case trimmed has a hyphen at the end of it.
+	hyphen bool
+}
+
+// fitBreak is an entry in the table used to find the best line breaks.
+type fitBreak struct {
+	demerits float64
+	prev     int
+	prevFit  int
+	valid    bool
+}
+
+// wrapOptimal wraps text using the WrapAlgorithm in opts, appending each
+// resulting line to lines. text must already have had its whitespace collapsed.
+func wrapOptimal(lines *tb.Block, text gem.String, width int, opts Options) {
+	items := opts.wrapItems(text, width)
+	if len(items) < 1 {
+		return
+	}
+
+	// best[j][f] is the best way to lay out items[:j] such that the last line
+	// has fitness class f.
+	best := make([][numFitnessClasses]fitBreak, len(items)+1)
+	best[0][0] = fitBreak{valid: true}
+
+	for end := 1; end <= len(items); end++ {
+		lineWidth := -items[end-1].width + items[end-1].trimmedWidth
+		spaces := 0
+		for start := end - 1; start >= 0; start-- {
+			lineWidth += items[start].width
+			if start < end-1 {
+				spaces += items[start].spaces
+				if items[start].forceBreak {
+					break
+				}
+			}
+			if lineWidth > width && start < end-1 {
+				break
+			}
+
+			isLast := end == len(items)
+			demerits, fitness := opts.lineDemerits(width-lineWidth, spaces, isLast || items[end-1].forceBreak, items[end-1].hyphen)
+
+			for prevFit := 0; prevFit < numFitnessClasses; prevFit++ {
+				prev := best[start][prevFit]
+				if !prev.valid {
+					continue
+				}
+				total := prev.demerits + demerits
+				if opts.WrapAlgorithm == KnuthPlass && start > 0 && abs(fitness-prevFit) > 1 {
+					total += adjacentDemerits
+				}
+				cur := &best[end][fitness]
+				if !cur.valid || total < cur.demerits {
+					*cur = fitBreak{demerits: total, prev: start, prevFit: prevFit, valid: true}
+				}
+			}
+
+			if lineWidth > width {
+				// an item that is too wide by itself can only be on a line of
+				// its own.
+				break
+			}
+		}
+	}
+
+	// find the best final layout and walk back through it to get the breaks
+	endFit := -1
+	for f := 0; f < numFitnessClasses; f++ {
+		if best[len(items)][f].valid && (endFit == -1 || best[len(items)][f].demerits < best[len(items)][endFit].demerits) {
+			endFit = f
+		}
+	}
+
+	var breaks []int
+	for end, f := len(items), endFit; end > 0; {
+		breaks = append(breaks, end)
+		end, f = best[end][f].prev, best[end][f].prevFit
+	}
+
+	start := 0
+	for i := len(breaks) - 1; i >= 0; i-- {
+		end := breaks[i]
+		line := gem.Zero
+		for j := start; j < end-1; j++ {
+			line = line.Add(items[j].text)
+		}
+		lines.Append(line.Add(items[end-1].trimmed))
+		start = end
+	}
+}
+
+// lineDemerits returns the demerits of a line that has slack columns of unused
+// space and the given number of stretchable spaces, along with the fitness
+// class of the line. The slack of the last line in a paragraph is not counted.
+// A line that has slack but no spaces to stretch is given infiniteBadness, and
+// a line that ends in a hyphen is given additional demerits.
+func (opts Options) lineDemerits(slack, spaces int, isLast, hyphen bool) (float64, int) {
+	if isLast || slack < 0 {
+		slack = 0
+	}
+
+	var hyphenDemerits float64
+	if hyphen {
+		hyphenDemerits = hyphenPenalty
+	}
+
+	if opts.WrapAlgorithm != KnuthPlass {
+		return float64(slack*slack) + hyphenDemerits, 0
+	}
+
+	var ratio, badness float64
+	if slack > 0 {
+		if spaces > 0 {
+			ratio = float64(slack) / float64(spaces)
+			badness = 100 * ratio * ratio * ratio
+			if badness > maxBadness {
+				badness = maxBadness
+			}
+		} else {
+			badness = infiniteBadness
+		}
+	}
+
+	fitness := 0
+	if ratio > 1 || badness == infiniteBadness {
+		fitness = 2
+	} else if ratio > 0.5 {
+		fitness = 1
+	}
+
+	d := linePenalty + badness
+	return d*d + hyphenDemerits*hyphenDemerits, fitness
+}
+
+// wrapItems splits text into the items that are laid out by wrapOptimal. If
+// opts has a Hyphenator, each word is split into an item for each of the parts
+// between its hyphenation points. Any item that is too wide to fit within width
+// is split into pieces that each fill a line, with a "-" at the end of each one
+// but the last, the same way that a greedy wrap splits it.
+func (opts Options) wrapItems(text gem.String, width int) []wrapItem {
+	var items []wrapItem
+	if opts.UnicodeLineBreaks {
+		for _, seg := range opts.hyphenSegments(text) {
+			seg, hyphens := opts.hyphenate(seg)
+			trimmed := opts.trimTrailingSpace(seg)
+			items = opts.appendHyphenatedItems(items, seg, trimmed, hyphens, width)
+		}
+		return items
+	}
+
+	var pending gem.String
+	for _, w := range opts.splitWords(text) {
+		word := pending.Add(gem.New(w))
+		pending = gem.Zero
+		if word.IsEmpty() {
+			continue
+		}
+		if opts.ANSIEscapes && opts.Width(word) == 0 {
+			// a word made only of escape sequences is kept with the
+			// following word so it does not take up a space of its own.
+			pending = word
+			continue
+		}
+		word, hyphens := opts.hyphenate(word)
+		items = opts.appendHyphenatedItems(items, word.Add(gem.New(" ")), word, hyphens, width)
+	}
+
+	if !pending.IsEmpty() {
+		// trailing escape sequences go at the end of the text without a space
+		// before them.
+		if len(items) > 0 {
+			last := &items[len(items)-1]
+			last.trimmed = last.trimmed.Add(pending)
+			last.text = last.trimmed
+			last.width = last.trimmedWidth
+			last.spaces = 0
+		} else {
+			items = append(items, wrapItem{text: pending, trimmed: pending})
+		}
+	}
+
+	return items
+}
+
+// appendHyphenatedItems adds the items for a word with the given text to items,
+// with an item for each part of it between the given hyphenation points. A
+// hyphenation point is skipped if the part before it would not fit within
+// width along with its hyphen.
+func (opts Options) appendHyphenatedItems(items []wrapItem, text, trimmed gem.String, hyphens []int, width int) []wrapItem {
+	start := 0
+	for _, p := range hyphens {
+		part := trimmed.Sub(start, p)
+		withHyphen := part.Add(gem.New("-"))
+		if opts.Width(withHyphen) > width {
+			continue
+		}
+		items = append(items, wrapItem{
+			text:         part,
+			trimmed:      withHyphen,
+			width:        opts.Width(part),
+			trimmedWidth: opts.Width(withHyphen),
+			hyphen:       true,
+		})
+		start = p
+	}
+	return opts.appendWrapItem(items, text.Sub(start, text.Len()), trimmed.Sub(start, trimmed.Len()), width)
+}
+
+// appendWrapItem adds the item with the given text to items, splitting it if it
+// is wider than width.
+func (opts Options) appendWrapItem(items []wrapItem, text, trimmed gem.String, width int) []wrapItem {
+	for opts.Width(trimmed) > width {
+		splitIdx := opts.fit(trimmed, width-1)
+		if splitIdx < 1 {
+			splitIdx = 1
+		}
+		piece := trimmed.Sub(0, splitIdx).Add(gem.New("-"))
+		if len(items) > 0 {
+			items[len(items)-1].forceBreak = true
+		}
+		items = append(items, wrapItem{
+			text:         piece,
+			trimmed:      piece,
+			width:        opts.Width(piece),
+			trimmedWidth: opts.Width(piece),
+			forceBreak:   true,
+		})
+
+		rest := trimmed.Sub(splitIdx, trimmed.Len())
+		text = rest.Add(text.Sub(trimmed.Len(), text.Len()))
+		trimmed = rest
+	}
+
+	item := wrapItem{
+		text:         text,
+		trimmed:      trimmed,
+		width:        opts.Width(text),
+		trimmedWidth: opts.Width(trimmed),
+	}
+	item.spaces = item.width - item.trimmedWidth
+	return append(items, item)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
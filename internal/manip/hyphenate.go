@@ -0,0 +1,136 @@
+package manip
+
+// This file contains the routines for finding the points at which words can be
+// hyphenated when they are wrapped.
+
+import (
+	"unicode"
+
+	"github.com/dekarrin/rosed/internal/gem"
+)
+
+const (
+	// softHyphen is SOFT HYPHEN, which marks a point where a word may be
+	// hyphenated without being shown unless it is.
+	softHyphen = '\u00AD'
+
+	// hyphenPenalty is added to the demerits of a line that ends in a hyphen
+	// by an optimal fit. KnuthPlass uses its square.
+	hyphenPenalty = 50
+)
+
+// hyphenate finds the points at which word can be hyphenated. It returns word
+// with any soft hyphens removed along with the index of each grapheme cluster
+// in the result that can begin the text after a hyphen, in ascending order.
+//
+// If word contains any soft hyphens, they are the only points given.
+// Otherwise, each run of letters in word is hyphenated with the Hyphenator in
+// opts. Escape sequences do not interrupt a run of letters. If opts does not
+// have a Hyphenator, word is returned unchanged with no points.
+func (opts Options) hyphenate(word gem.String) (gem.String, []int) {
+	if opts.Hyphenator == nil {
+		return word, nil
+	}
+
+	if word.IndexFunc(isSoftHyphen) >= 0 {
+		var points []int
+		result := gem.Zero
+		for i := 0; i < word.Len(); i++ {
+			if !isSoftHyphen(word.CharAt(i)) {
+				result = result.Add(word.Sub(i, i+1))
+				continue
+			}
+			// soft hyphens at either end of the word or next to each other
+			// do not give a new point.
+			if result.Len() > 0 && (len(points) < 1 || points[len(points)-1] < result.Len()) {
+				points = append(points, result.Len())
+			}
+		}
+		if len(points) > 0 && points[len(points)-1] >= result.Len() {
+			points = points[:len(points)-1]
+		}
+		return result, points
+	}
+
+	var points []int
+	var run []rune
+	var runIdx []int
+	endRun := func() {
+		for _, p := range opts.Hyphenator.Points(run) {
+			points = append(points, runIdx[p])
+		}
+		run = nil
+		runIdx = nil
+	}
+	for i := 0; i < word.Len(); i++ {
+		if escLen := opts.escapeAt(word, i); escLen > 0 {
+			i += escLen - 1
+			continue
+		}
+		ch := word.CharAt(i)
+		if !unicode.IsLetter(ch[0]) {
+			endRun()
+			continue
+		}
+		run = append(run, ch[0])
+		runIdx = append(runIdx, i)
+	}
+	endRun()
+
+	return word, points
+}
+
+// lastHyphenFit returns the last of the given hyphenation points in word such
+// that the text before it along with a hyphen fits within width. If there is no
+// such point, 0 is returned.
+func (opts Options) lastHyphenFit(word gem.String, points []int, width int) int {
+	for i := len(points) - 1; i >= 0; i-- {
+		if opts.Width(word.Sub(0, points[i]))+1 <= width {
+			return points[i]
+		}
+	}
+	return 0
+}
+
+// shiftPoints returns the hyphenation points that remain after the first n
+// grapheme clusters of a word are removed from it.
+func shiftPoints(points []int, n int) []int {
+	var shifted []int
+	for _, p := range points {
+		if p > n {
+			shifted = append(shifted, p-n)
+		}
+	}
+	return shifted
+}
+
+// hyphenSegments returns the segments of text as given by breakSegments. If
+// hyphenation is enabled, a segment that ends in a soft hyphen is joined with
+// the one after it so that a break there is made by hyphenation.
+func (opts Options) hyphenSegments(text gem.String) []gem.String {
+	segments := opts.breakSegments(text)
+	if opts.Hyphenator == nil {
+		return segments
+	}
+
+	var joined []gem.String
+	pending := gem.Zero
+	for _, seg := range segments {
+		seg = pending.Add(seg)
+		pending = gem.Zero
+		if isSoftHyphen(seg.CharAt(seg.Len() - 1)) {
+			pending = seg
+			continue
+		}
+		joined = append(joined, seg)
+	}
+	if !pending.IsEmpty() {
+		joined = append(joined, pending)
+	}
+	return joined
+}
+
+// isSoftHyphen returns whether the grapheme cluster ch is a soft hyphen.
+func isSoftHyphen(ch []rune) bool {
+	return len(ch) == 1 && ch[0] == softHyphen
+}
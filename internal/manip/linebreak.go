@@ -0,0 +1,158 @@
+package manip
+
+// This file contains the routines for wrapping text at the line break
+// opportunities given by the Unicode Line Breaking Algorithm (UAX #14) as
+// opposed to only at spaces.
+
+import (
+	"github.com/dekarrin/rosed/internal/gem"
+	"github.com/dekarrin/rosed/internal/tb"
+)
+
+// isNoBreakSpace returns whether r is a whitespace character that must not be
+// broken at or collapsed when using UAX #14 line breaking.
+func isNoBreakSpace(r rune) bool {
+	return r == '\u00A0' || r == '\u2007' || r == '\u202F'
+}
+
+// wrapAtBreaks wraps text at its line break opportunities, appending each
+// resulting line to lines. text must already have had its whitespace collapsed.
+func wrapAtBreaks(lines *tb.Block, text gem.String, width int, opts Options) {
+	var curLine gem.String
+	for _, seg := range opts.hyphenSegments(text) {
+		seg, hyphens := opts.hyphenate(seg)
+		segWidth := opts.Width(opts.trimTrailingSpace(seg))
+
+		if !curLine.IsEmpty() && opts.Width(curLine)+segWidth > width {
+			if splitIdx := opts.lastHyphenFit(seg, hyphens, width-opts.Width(curLine)); splitIdx > 0 {
+				curLine = curLine.Add(seg.Sub(0, splitIdx))
+				seg = seg.Sub(splitIdx, seg.Len())
+				hyphens = shiftPoints(hyphens, splitIdx)
+				segWidth = opts.Width(opts.trimTrailingSpace(seg))
+				lines.Append(curLine.Add(gem.New("-")))
+			} else {
+				lines.Append(opts.trimTrailingSpace(curLine))
+			}
+			curLine = gem.Zero
+		}
+
+		// a segment that will not fit on a line by itself has no opportunity
+		// to break within it, so it is hyphenated if possible or else
+		// forcibly split.
+		for curLine.IsEmpty() && segWidth > width {
+			splitIdx := opts.lastHyphenFit(seg, hyphens, width)
+			if splitIdx < 1 {
+				splitIdx = opts.fit(seg, width-1)
+			}
+			if splitIdx < 1 {
+				splitIdx = 1
+			}
+			lines.Append(seg.Sub(0, splitIdx).Add(gem.New("-")))
+			seg = seg.Sub(splitIdx, seg.Len())
+			hyphens = shiftPoints(hyphens, splitIdx)
+			segWidth = opts.Width(opts.trimTrailingSpace(seg))
+		}
+
+		curLine = curLine.Add(seg)
+	}
+
+	curLine = opts.trimTrailingSpace(curLine)
+	if !curLine.IsEmpty() {
+		lines.Append(curLine)
+	}
+}
+
+// breakSegments splits text at every line break opportunity in it. Each
+// returned segment includes any spaces that follow it. If escape sequences are
+// being recognized, they are ignored when finding break opportunities, and any
+// that come directly before a break opportunity are kept with the text that
+// comes after it.
+func (opts Options) breakSegments(text gem.String) []gem.String {
+	// collect the runes that are not part of escape sequences along with the
+	// grapheme cluster each one came from.
+	var visible []rune
+	var visibleCluster []int
+
+	// segStart gives the index of the cluster that a segment starting with
+	// the cluster at each index would begin at.
+	segStart := make(map[int]int)
+
+	pendingStart := -1
+	for i := 0; i < text.Len(); i++ {
+		if escLen := opts.escapeAt(text, i); escLen > 0 {
+			if pendingStart == -1 {
+				pendingStart = i
+			}
+			i += escLen - 1
+			continue
+		}
+
+		segStart[i] = i
+		if pendingStart != -1 {
+			segStart[i] = pendingStart
+			pendingStart = -1
+		}
+
+		for _, r := range text.CharAt(i) {
+			visible = append(visible, r)
+			visibleCluster = append(visibleCluster, i)
+		}
+	}
+
+	var segments []gem.String
+	start := 0
+	for _, b := range gem.LineBreaks(visible) {
+		if b >= len(visible) {
+			break
+		}
+
+		// only break at the boundaries of grapheme clusters
+		if visibleCluster[b-1] == visibleCluster[b] {
+			continue
+		}
+
+		end := segStart[visibleCluster[b]]
+		if end > start {
+			segments = append(segments, text.Sub(start, end))
+			start = end
+		}
+	}
+	if start < text.Len() {
+		segments = append(segments, text.Sub(start, text.Len()))
+	}
+
+	return segments
+}
+
+// trimTrailingSpace removes all space characters from the end of text. If
+// escape sequences are being recognized, any that are among the trailing
+// spaces are kept.
+func (opts Options) trimTrailingSpace(text gem.String) gem.String {
+	trimmed := gem.Zero
+	pending := gem.Zero
+	pendingEscapes := gem.Zero
+	for i := 0; i < text.Len(); i++ {
+		if escLen := opts.escapeAt(text, i); escLen > 0 {
+			seq := text.Sub(i, i+escLen)
+			i += escLen - 1
+			if pending.IsEmpty() {
+				trimmed = trimmed.Add(seq)
+			} else {
+				pending = pending.Add(seq)
+				pendingEscapes = pendingEscapes.Add(seq)
+			}
+			continue
+		}
+
+		ch := text.Sub(i, i+1)
+		if ch.Equal(" ") {
+			pending = pending.Add(ch)
+			continue
+		}
+
+		trimmed = trimmed.Add(pending).Add(ch)
+		pending = gem.Zero
+		pendingEscapes = gem.Zero
+	}
+	return trimmed.Add(pendingEscapes)
+}
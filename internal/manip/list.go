@@ -0,0 +1,166 @@
+package manip
+
+// This file contains the routines for wrapping text that contains bulleted and
+// numbered lists so that each list item keeps its marker and is wrapped on its
+// own.
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/dekarrin/rosed/internal/gem"
+	"github.com/dekarrin/rosed/internal/tb"
+)
+
+// bulletMarkers are the characters that begin an item of a bulleted list.
+const bulletMarkers = "-*+•"
+
+// maxOrdinalDigits is the most digits that the number which begins an item of
+// a numbered list can have.
+const maxOrdinalDigits = 9
+
+// listItem is a part of text that is wrapped on its own by wrapLists.
+type listItem struct {
+	// lead is the leading whitespace, marker, and whitespace after the marker
+	// that begin the item. It is empty for text that comes before the first
+	// item.
+	lead gem.String
+
+	// lines are the lines of text in the item with the lead removed.
+	lines []string
+
+	// blank is whether the item is a blank line that separates the items
+	// around it, as in a loose list.
+	blank bool
+}
+
+// wrapLists wraps text that may contain list items, appending each resulting
+// line to lines. A line that begins with a list marker, after any leading
+// whitespace, starts a new item, and every other line is a part of the item
+// before it. Each item is wrapped on its own as by Wrap, with its lead on its
+// first line and its other lines indented to line up with the text after the
+// lead.
+//
+// Blank lines between items are kept so that loose lists stay loose. Text
+// after a blank line is wrapped on its own, and if it is indented it is
+// indented to line up with the text of the item above it.
+func wrapLists(lines *tb.Block, text gem.String, width int, lineSep gem.String, opts Options) {
+	itemOpts := opts
+	itemOpts.RecognizeLists = false
+
+	var srcLines []string
+	if lineSep.IsEmpty() {
+		srcLines = []string{text.String()}
+	} else {
+		srcLines = strings.Split(text.String(), lineSep.String())
+	}
+
+	items := []listItem{{}}
+	hang := 0
+	for _, line := range srcLines {
+		gLine := gem.New(line)
+		if leadEnd := listMarkerEnd(gLine); leadEnd > 0 {
+			items = append(items, listItem{
+				lead:  gLine.Sub(0, leadEnd),
+				lines: []string{gLine.Sub(leadEnd, gLine.Len()).String()},
+			})
+			hang = opts.Width(gLine.Sub(0, leadEnd))
+			continue
+		}
+		if isWhitespace(gLine) {
+			items = append(items, listItem{blank: true})
+			continue
+		}
+		cur := &items[len(items)-1]
+		if cur.blank {
+			lead := gem.Zero
+			if CountLeadingWhitespace(gLine) > 0 {
+				lead = gem.RepeatStr(" ", hang)
+			}
+			items = append(items, listItem{lead: lead, lines: []string{line}})
+			continue
+		}
+		cur.lines = append(cur.lines, line)
+	}
+
+	// blank lines only separate items, so any at the start or end are dropped
+	first, last := 0, len(items)-1
+	for first < len(items) && (items[first].blank || len(items[first].lines) < 1) {
+		first++
+	}
+	for last >= first && items[last].blank {
+		last--
+	}
+
+	for _, item := range items[first : last+1] {
+		if item.blank {
+			lines.Append(gem.Zero)
+			continue
+		}
+		body := gem.New(strings.Join(item.lines, lineSep.String()))
+		if item.lead.IsEmpty() && strings.TrimSpace(body.String()) == "" {
+			continue
+		}
+
+		hang := opts.Width(item.lead)
+		itemLines := Wrap(body, width-hang, lineSep, itemOpts)
+		for i, l := range itemLines.Lines {
+			if i == 0 {
+				lines.Append(item.lead.Add(l))
+			} else {
+				lines.Append(gem.RepeatStr(" ", hang).Add(l))
+			}
+		}
+	}
+
+	if lines.Len() < 1 {
+		lines.Append(gem.Zero)
+	}
+}
+
+// listMarkerEnd returns the index of the first grapheme cluster after the list
+// marker that begins line, along with the whitespace before and after it. A
+// list marker is one of the bullets "-", "*", "+", or "•", or a number followed
+// by "." or ")", and it must be followed by whitespace. If line does not begin
+// with a list marker, 0 is returned.
+func listMarkerEnd(line gem.String) int {
+	i := CountLeadingWhitespace(line)
+	if i >= line.Len() {
+		return 0
+	}
+
+	ch := line.CharAt(i)
+	if len(ch) == 1 && strings.ContainsRune(bulletMarkers, ch[0]) {
+		i++
+	} else {
+		digits := 0
+		for i < line.Len() && isASCIIDigit(line.CharAt(i)) {
+			i++
+			digits++
+		}
+		if digits < 1 || digits > maxOrdinalDigits || i >= line.Len() {
+			return 0
+		}
+		if ch := line.CharAt(i); len(ch) != 1 || (ch[0] != '.' && ch[0] != ')') {
+			return 0
+		}
+		i++
+	}
+
+	spaces := 0
+	for i < line.Len() && unicode.IsSpace(line.CharAt(i)[0]) {
+		i++
+		spaces++
+	}
+	if spaces < 1 || i >= line.Len() {
+		// a marker with nothing after it is not the start of an item.
+		return 0
+	}
+	return i
+}
+
+// isASCIIDigit returns whether the grapheme cluster ch is one of the digits 0
+// through 9.
+func isASCIIDigit(ch []rune) bool {
+	return len(ch) == 1 && ch[0] >= '0' && ch[0] <= '9'
+}
@@ -12,22 +12,288 @@ import (
 	"unicode"
 
 	"github.com/dekarrin/rosed/internal/gem"
+	"github.com/dekarrin/rosed/internal/hyph"
 	"github.com/dekarrin/rosed/internal/tb"
 )
 
+const (
+	// sgrReset is the SGR escape sequence that turns off all styling.
+	sgrReset = "\x1b[0m"
+)
+
 var (
 	spaceCollapser = regexp.MustCompile(" +")
 )
 
+// Options control how the functions in manip measure text. The zero-value is
+// an Options that measures text by counting its grapheme clusters.
+type Options struct {
+	// DisplayWidth is whether text is measured by the number of terminal cells
+	// it occupies as opposed to by the number of grapheme clusters in it.
+	DisplayWidth bool
+
+	// ANSIEscapes is whether ANSI escape sequences in text are treated as
+	// zero-width units that are never split apart. If set, SGR styling that is
+	// active at the point where a line is broken is closed at the end of that
+	// line and reopened at the start of the next.
+	ANSIEscapes bool
+
+	// UnicodeLineBreaks is whether wrapping breaks lines at the line break
+	// opportunities given by UAX #14 instead of only at spaces. If set, no-break
+	// spaces are also not collapsed with other whitespace.
+	UnicodeLineBreaks bool
+
+	// WrapAlgorithm is the way that wrapping chooses where to break lines.
+	WrapAlgorithm WrapAlgorithm
+
+	// Hyphenator is used to find the points at which words can be hyphenated
+	// when wrapping. If set, soft hyphens in text are also used as hyphenation
+	// points and are removed from wrapped text. If nil, words are only split
+	// when they are too long to fit on a line by themselves.
+	Hyphenator *hyph.Hyphenator
+
+	// PreserveWhitespace is whether wrapping keeps the existing line breaks
+	// and runs of whitespace in text instead of collapsing them. If set, each
+	// existing line is wrapped on its own and lines are only broken at
+	// whitespace.
+	PreserveWhitespace bool
+
+	// RepeatIndent is whether the leading whitespace of a line is repeated at
+	// the start of each line that it is wrapped onto when PreserveWhitespace
+	// is set.
+	RepeatIndent bool
+
+	// RecognizeLists is whether wrapping recognizes the items of bulleted and
+	// numbered lists in text. If set, each item is wrapped on its own with a
+	// hanging indent that lines up its wrapped lines with the text after its
+	// marker.
+	RecognizeLists bool
+
+	// TableAlignments is the alignment of the content of each column of a
+	// table, in order. Columns past the end of it are aligned to the left.
+	TableAlignments []ColumnAlignment
+
+	// TableHeaderCase is the way that the letters in the header row of a table
+	// are cased.
+	TableHeaderCase HeaderCase
+
+	// TableFit is whether a table that is wider than the width it is made for
+	// is narrowed to fit by wrapping the content of its widest columns.
+	TableFit bool
+
+	// TableMinWidths is the least content width of each column of a table, in
+	// order, that fitting a table narrows it to. Columns past the end of it,
+	// and columns given a width less than minFitColumnWidth, are narrowed to
+	// no less than minFitColumnWidth.
+	TableMinWidths []int
+
+	// TableNoWrapColumns are the indexes of the columns of a table that are
+	// never narrowed when fitting it.
+	TableNoWrapColumns []int
+
+	// TableColumnWidths is the width constraint on each column of a table, in
+	// order. Columns past the end of it have no constraint.
+	TableColumnWidths []ColumnWidth
+
+	// TableExpand is when a table is drawn in expanded mode, with each of its
+	// rows as a record that has a line for each column.
+	TableExpand ExpandMode
+
+	// TableRenderer draws a table once it has been laid out. If nil, tables
+	// are drawn as text by TextRenderer.
+	TableRenderer TableRenderer
+}
+
+// isCollapsible returns whether r is whitespace that is to be collapsed by
+// CollapseSpace.
+func (opts Options) isCollapsible(r rune) bool {
+	if opts.UnicodeLineBreaks && isNoBreakSpace(r) {
+		return false
+	}
+	return unicode.IsSpace(r)
+}
+
+// Width returns the width of text as measured using the options.
+func (opts Options) Width(text gem.String) int {
+	if opts.ANSIEscapes {
+		text = text.StripEscapes()
+	}
+	if opts.DisplayWidth {
+		return text.Width()
+	}
+	return text.Len()
+}
+
+// charWidth returns the width of a single grapheme cluster as measured using
+// the options.
+func (opts Options) charWidth(gc []rune) int {
+	if opts.DisplayWidth {
+		return gem.ClusterWidth(gc)
+	}
+	return 1
+}
+
+// fit returns the number of grapheme clusters from the start of text that will
+// fit within the given width as measured using the options.
+func (opts Options) fit(text gem.String, width int) int {
+	used := 0
+	for i := 0; i < text.Len(); i++ {
+		if escLen := opts.escapeAt(text, i); escLen > 0 {
+			i += escLen - 1
+			continue
+		}
+		used += opts.charWidth(text.CharAt(i))
+		if used > width {
+			return i
+		}
+	}
+	return text.Len()
+}
+
+// escapeAt returns the number of grapheme clusters in the escape sequence that
+// starts at index idx of text, or 0 if there is none or escape sequences are
+// not being recognized.
+func (opts Options) escapeAt(text gem.String, idx int) int {
+	if !opts.ANSIEscapes {
+		return 0
+	}
+	return text.EscapeAt(idx)
+}
+
+// closeStyles adds an SGR reset to the end of text if it leaves any SGR styling
+// active. If escape sequences are not being recognized, text is returned
+// unchanged.
+func (opts Options) closeStyles(text gem.String) gem.String {
+	if !opts.ANSIEscapes || len(activeStyles(nil, text)) < 1 {
+		return text
+	}
+	return text.Add(gem.New(sgrReset))
+}
+
+// toUpper converts text to upper case. Escape sequences are not modified.
+func (opts Options) toUpper(text gem.String) gem.String {
+	if !opts.ANSIEscapes {
+		return gem.New(strings.ToUpper(text.String()))
+	}
+
+	var sb strings.Builder
+	for i := 0; i < text.Len(); i++ {
+		if escLen := text.EscapeAt(i); escLen > 0 {
+			sb.WriteString(text.Sub(i, i+escLen).String())
+			i += escLen - 1
+			continue
+		}
+		sb.WriteString(strings.ToUpper(string(text.CharAt(i))))
+	}
+	return gem.New(sb.String())
+}
+
+// splitWords splits text on every space character. Spaces within escape
+// sequences are not split on.
+func (opts Options) splitWords(text gem.String) []string {
+	if !opts.ANSIEscapes {
+		return strings.Split(text.String(), " ")
+	}
+
+	var words []string
+	var sb strings.Builder
+	for i := 0; i < text.Len(); i++ {
+		if escLen := text.EscapeAt(i); escLen > 0 {
+			sb.WriteString(text.Sub(i, i+escLen).String())
+			i += escLen - 1
+			continue
+		}
+		ch := text.CharAt(i)
+		if ch[0] == ' ' {
+			words = append(words, sb.String())
+			sb.Reset()
+			continue
+		}
+		sb.WriteString(string(ch))
+	}
+	return append(words, sb.String())
+}
+
+// activeStyles returns the SGR escape sequences that are in effect after text,
+// given that the SGR escape sequences in active were already in effect at its
+// start. A sequence that resets styling clears all prior ones.
+func activeStyles(active []gem.String, text gem.String) []gem.String {
+	for i := 0; i < text.Len(); i++ {
+		escLen := text.EscapeAt(i)
+		if escLen < 1 {
+			continue
+		}
+		seq := text.Sub(i, i+escLen)
+		i += escLen - 1
+
+		params, ok := sgrParams(seq.String())
+		if !ok {
+			continue
+		}
+		if params == "" || params == "0" {
+			active = nil
+			continue
+		}
+		if strings.HasPrefix(params, "0;") {
+			active = nil
+		}
+		active = append(active[:len(active):len(active)], seq)
+	}
+	return active
+}
+
+// sgrParams returns the parameters of seq if it is an SGR escape sequence.
+func sgrParams(seq string) (params string, ok bool) {
+	if !strings.HasSuffix(seq, "m") {
+		return "", false
+	}
+	if strings.HasPrefix(seq, "\x1b[") {
+		params = seq[len("\x1b[") : len(seq)-1]
+	} else if strings.HasPrefix(seq, "\u009b") {
+		params = seq[len("\u009b") : len(seq)-1]
+	} else {
+		return "", false
+	}
+	for _, r := range params {
+		if (r < '0' || r > '9') && r != ';' && r != ':' {
+			return "", false
+		}
+	}
+	return params, true
+}
+
+// carryStyles closes the SGR styling that is active at the end of each line of
+// bl except for the last one and reopens it at the start of the next line.
+func carryStyles(bl *tb.Block) {
+	var active []gem.String
+	for i := range bl.Lines {
+		line := bl.Lines[i]
+		for j := len(active) - 1; j >= 0; j-- {
+			line = active[j].Add(line)
+		}
+
+		active = activeStyles(active, bl.Lines[i])
+		if len(active) > 0 && i+1 < len(bl.Lines) {
+			line = line.Add(gem.New(sgrReset))
+		}
+
+		bl.Lines[i] = line
+	}
+}
+
 // CollapseSpace takes all runs of space in a gem String and collapses them into
-// a single space. The lineSep is considered whitespace if non-empty.
-func CollapseSpace(text gem.String, lineSep gem.String) gem.String {
+// a single space. The lineSep is considered whitespace if non-empty. If opts
+// has ANSIEscapes set, escape sequences are left as they are.
+func CollapseSpace(text gem.String, lineSep gem.String, opts Options) gem.String {
 	// handle the separator but do not use the empty string.
 	if !lineSep.IsEmpty() {
 		text = gem.New(strings.ReplaceAll(text.String(), lineSep.String(), " "))
 	}
+	if opts.ANSIEscapes {
+		return collapseSpaceSkippingEscapes(text, opts)
+	}
 	for i := 0; i < text.Len(); i++ {
-		if unicode.IsSpace(text.CharAt(i)[0]) {
+		if opts.isCollapsible(text.CharAt(i)[0]) {
 			text = text.SetCharAt(i, []rune{' '}) // set it to actual space char
 		}
 	}
@@ -35,6 +301,31 @@ func CollapseSpace(text gem.String, lineSep gem.String) gem.String {
 	return gem.New(collapsed)
 }
 
+// collapseSpaceSkippingEscapes is CollapseSpace for text that may contain
+// escape sequences which must not be modified.
+func collapseSpaceSkippingEscapes(text gem.String, opts Options) gem.String {
+	var sb strings.Builder
+	inSpace := false
+	for i := 0; i < text.Len(); i++ {
+		if escLen := text.EscapeAt(i); escLen > 0 {
+			sb.WriteString(text.Sub(i, i+escLen).String())
+			i += escLen - 1
+			continue
+		}
+		ch := text.CharAt(i)
+		if opts.isCollapsible(ch[0]) {
+			if !inSpace {
+				sb.WriteRune(' ')
+			}
+			inSpace = true
+			continue
+		}
+		inSpace = false
+		sb.WriteString(string(ch))
+	}
+	return gem.New(sb.String())
+}
+
 // CombineColumnBlocks takes two separate columns and combines them into a
 // single block of text. The right column will be left-aligned such that it will
 // be separated by minSpaceBetween space characters at minimum from the left
@@ -53,46 +344,68 @@ func CollapseSpace(text gem.String, lineSep gem.String) gem.String {
 // Additionally, if the left column has more lines than the right column, note
 // that the last few lines will have the center spacing inserted still. So will
 // end where the right column would start if there was more of it.
-func CombineColumnBlocks(left, right tb.Block, minSpaceBetween int) tb.Block {
-	if left.Len() == 0 && right.Len() == 0 {
-		return tb.Block{}
+func CombineColumnBlocks(left, right tb.Block, minSpaceBetween int, opts Options) tb.Block {
+	return CombineColumns([]tb.Block{left, right}, nil, minSpaceBetween, opts)
+}
+
+// CombineColumns takes any number of separate columns and combines them into a
+// single block of text, with the columns placed side by side in the order they
+// are given. Each column other than the last is padded with space characters
+// to the width given for it in widths, or to the width of its widest line if
+// that is wider or no width is given for it, and is then followed by
+// spaceBetween space characters.
+//
+// cols are blocks where each Line is an already-wrapped line. They do not need
+// to have the same number of lines; the returned block will have a number of
+// lines equal to the greatest number of lines among them. Where a column has no
+// more lines, it is filled with space characters so that the columns after it
+// still start where they would if it had more lines.
+//
+// The returned block will not have line terminator behavior set on it; callers
+// will need to handle line terminators themselves.
+func CombineColumns(cols []tb.Block, widths []int, spaceBetween int, opts Options) tb.Block {
+	numLines := 0
+	for _, col := range cols {
+		if col.Len() > numLines {
+			numLines = col.Len()
+		}
 	}
-	numLines := left.Len()
-	if numLines < right.Len() {
-		numLines = right.Len()
+	if numLines == 0 {
+		return tb.Block{}
 	}
 
-	// to find how far the right column should be shifted, we need to find the
-	// maximum width of the left column
-	var leftColMaxWidth int
-
-	for i := 0; i < left.Len(); i++ {
-		lineLen := left.CharCount(i)
-		if lineLen > leftColMaxWidth {
-			leftColMaxWidth = lineLen
+	// each column starts after the full width of the ones before it, so find
+	// how wide each one is.
+	colWidths := make([]int, len(cols))
+	for c, col := range cols {
+		if c < len(widths) {
+			colWidths[c] = widths[c]
+		}
+		for i := 0; i < col.Len(); i++ {
+			lineLen := opts.Width(col.Line(i))
+			if lineLen > colWidths[c] {
+				colWidths[c] = lineLen
+			}
 		}
 	}
 
-	totalCharsOnLeft := leftColMaxWidth + minSpaceBetween
-
 	combined := tb.Block{}
 	for i := 0; i < numLines; i++ {
-		// first get lines from each column
-		var leftLine gem.String
-		var leftLineCharCount int
-		var rightLine gem.String
-		if i < left.Len() {
-			leftLine = left.Line(i)
-			leftLineCharCount = left.CharCount(i)
-		}
-		if i < right.Len() {
-			rightLine = right.Line(i)
-		}
+		var sb strings.Builder
+		for c, col := range cols {
+			var line gem.String
+			if i < col.Len() {
+				line = opts.closeStyles(col.Line(i))
+			}
+			sb.WriteString(line.String())
 
-		charsToAddToLeft := totalCharsOnLeft - leftLineCharCount
-		midSpacer := strings.Repeat(" ", charsToAddToLeft)
+			if c < len(cols)-1 {
+				padding := colWidths[c] - opts.Width(line) + spaceBetween
+				sb.WriteString(strings.Repeat(" ", padding))
+			}
+		}
 
-		combined.Append(gem.New(fmt.Sprintf("%s%s%s", leftLine, midSpacer, rightLine)))
+		combined.Append(gem.New(sb.String()))
 	}
 
 	return combined
@@ -104,15 +417,15 @@ func CombineColumnBlocks(left, right tb.Block, minSpaceBetween int) tb.Block {
 // If there are no spaces in the given string, it is returned centered.
 // If it is longer than the desired width after collapsing spaces in it, the
 // collapsed-space string is returned without further modification.
-func JustifyLine(text gem.String, width int) gem.String {
+func JustifyLine(text gem.String, width int, opts Options) gem.String {
 	// collapseSpace in a line so that it can be properly laid out
-	text = CollapseSpace(text, gem.New("\n")) // doing \n which would be whitespace-collapsed anyways
+	text = CollapseSpace(text, gem.New("\n"), opts) // doing \n which would be whitespace-collapsed anyways
 
-	if text.Len() >= width {
+	if opts.Width(text) >= width {
 		return text
 	}
 
-	splitWords := strings.Split(text.String(), " ")
+	splitWords := opts.splitWords(text)
 	numGaps := len(splitWords) - 1
 	if numGaps < 1 {
 		return text
@@ -125,7 +438,7 @@ func JustifyLine(text gem.String, width int) gem.String {
 		}
 	}
 
-	spacesToAdd := width - text.Len()
+	spacesToAdd := width - opts.Width(text)
 	spaceIdx := 0
 	fromRight := false
 	oddSubtractor := 1
@@ -152,48 +465,122 @@ func JustifyLine(text gem.String, width int) gem.String {
 // Wrap does a wrap without considering any additional lengths. Automatically
 // normalizes all runs of space characters to a single space.
 //
+// Lines are broken only at spaces unless opts has UnicodeLineBreaks set, in
+// which case they are broken at the line break opportunities given by UAX #14.
+// Either way, a word that is too long to fit on a line by itself is split with
+// a hyphen. If opts has a Hyphenator, words are also hyphenated wherever it
+// allows a line to hold more of the text, and words that are too long are
+// split at a hyphenation point if one fits.
+//
+// If opts has PreserveWhitespace set, whitespace is not normalized. Instead,
+// each line of text is wrapped on its own, only at whitespace, and all
+// whitespace other than that which a line is broken at is kept.
+//
+// Otherwise, if opts has RecognizeLists set, each line of text that begins
+// with a bullet or number marks the start of a list item, and each item is
+// wrapped on its own with its wrapped lines indented to line up with the text
+// after the marker.
+//
 // The returned value is a Block of all resulting lines. Trailing mode will not
 // be set on the Block.
-func Wrap(text gem.String, width int, lineSep gem.String) tb.Block {
+func Wrap(text gem.String, width int, lineSep gem.String, opts Options) tb.Block {
 	if width < 2 {
 		width = 2
 	}
 
 	lines := tb.Block{LineSeparator: lineSep}
 
+	if opts.PreserveWhitespace {
+		wrapPreserving(&lines, text, width, lineSep, opts)
+		if opts.ANSIEscapes {
+			carryStyles(&lines)
+		}
+		return lines
+	}
+
+	if opts.RecognizeLists {
+		wrapLists(&lines, text, width, lineSep, opts)
+		if opts.ANSIEscapes {
+			carryStyles(&lines)
+		}
+		return lines
+	}
+
 	// normalize string to convert all whitespace to single space char.
-	text = CollapseSpace(text, lineSep)
+	text = CollapseSpace(text, lineSep, opts)
 	if text.String() == "" {
 		lines.Append(gem.Zero)
 		return lines
 	}
 
+	if opts.WrapAlgorithm != Greedy {
+		wrapOptimal(&lines, text, width, opts)
+		if opts.ANSIEscapes {
+			carryStyles(&lines)
+		}
+		return lines
+	}
+
+	if opts.UnicodeLineBreaks {
+		wrapAtBreaks(&lines, text, width, opts)
+		if opts.ANSIEscapes {
+			carryStyles(&lines)
+		}
+		return lines
+	}
+
 	toConsume := text
 	var curWord, curLine gem.String
 	for i := 0; i < toConsume.Len(); i++ {
+		if escLen := opts.escapeAt(toConsume, i); escLen > 0 {
+			curWord = curWord.Add(toConsume.Sub(i, i+escLen))
+			i += escLen - 1
+			continue
+		}
 		ch := toConsume.CharAt(i)
 		if ch[0] == ' ' {
-			curLine = appendWordToWrappedLine(&lines, curWord, curLine, width)
+			if opts.ANSIEscapes && !curWord.IsEmpty() && opts.Width(curWord) == 0 {
+				// a word made only of escape sequences is kept with the
+				// following word so it does not take up a space of its own.
+				continue
+			}
+			curLine = appendWordToWrappedLine(&lines, curWord, curLine, width, opts)
 			curWord = gem.Zero
 		} else {
 			curWord = curWord.Add(gem.New(string(ch)))
 		}
 	}
 
+	if opts.ANSIEscapes && !curWord.IsEmpty() && opts.Width(curWord) == 0 {
+		// trailing escape sequences go at the end of the text without a
+		// space before them.
+		if curLine.IsEmpty() && lines.Len() > 0 {
+			last := lines.Len() - 1
+			lines.Lines[last] = lines.Lines[last].Add(curWord)
+		} else {
+			curLine = curLine.Add(curWord)
+		}
+		curWord = gem.Zero
+	}
+
 	if !curWord.IsEmpty() {
-		curLine = appendWordToWrappedLine(&lines, curWord, curLine, width)
+		curLine = appendWordToWrappedLine(&lines, curWord, curLine, width, opts)
 	}
 
 	if !curLine.IsEmpty() {
 		lines.Append(curLine)
 	}
 
+	if opts.ANSIEscapes {
+		carryStyles(&lines)
+	}
+
 	return lines
 }
 
 // AlignLineLeft performs a left-align. Space is added to the right to make the
 // line fill the width.
-func AlignLineLeft(text gem.String, width int) gem.String {
+func AlignLineLeft(text gem.String, width int, opts Options) gem.String {
 	// find first instance of non-space grapheme at start.
 	startSpaces := CountLeadingWhitespace(text)
 
@@ -207,7 +594,7 @@ func AlignLineLeft(text gem.String, width int) gem.String {
 
 	// and add filler space, including the extra space needed for full width
 	spaceLen := 0
-	extraNeeded := width - endingText.Len()
+	extraNeeded := width - opts.Width(endingText)
 	if extraNeeded > 0 {
 		spaceLen = extraNeeded
 	}
@@ -219,7 +606,7 @@ func AlignLineLeft(text gem.String, width int) gem.String {
 
 // AlignLineRight performs a right-align. Space is added to the left to make the
 // line fill the width.
-func AlignLineRight(text gem.String, width int) gem.String {
+func AlignLineRight(text gem.String, width int, opts Options) gem.String {
 	// find first instance of non-space grapheme at end.
 	endSpaces := CountTrailingWhitespace(text)
 
@@ -236,7 +623,7 @@ func AlignLineRight(text gem.String, width int) gem.String {
 
 	// and add filler space, including the extra space needed for full width
 	spaceLen := 0
-	extraNeeded := width - startingText.Len()
+	extraNeeded := width - opts.Width(startingText)
 	if extraNeeded > 0 {
 		spaceLen = extraNeeded
 	}
@@ -248,7 +635,7 @@ func AlignLineRight(text gem.String, width int) gem.String {
 
 // AlignLineLeft performs a center of the text. Space is added to both sides to
 // make the line fill the width.
-func AlignLineCenter(text gem.String, width int) gem.String {
+func AlignLineCenter(text gem.String, width int, opts Options) gem.String {
 	// find first instance of non-space grapheme at start.
 	startSpaces := CountLeadingWhitespace(text)
 	endSpaces := CountTrailingWhitespace(text)
@@ -261,7 +648,7 @@ func AlignLineCenter(text gem.String, width int) gem.String {
 		midText = text.Sub(startSpaces, text.Len())
 	}
 
-	spaceNeeded := width - midText.Len()
+	spaceNeeded := width - opts.Width(midText)
 
 	if spaceNeeded <= 0 {
 		// string is already at the length or too long to do further centering
@@ -305,20 +692,21 @@ func CountTrailingWhitespace(text gem.String) int {
 // would make the width of curLine too long, in which case curLine is put into
 // the lines, curLine is reset, curWord is added to the curLine. Lines will be
 // modified to add the appended line if curLine is full.
-func appendWordToWrappedLine(lines *tb.Block, curWord gem.String, curLine gem.String, width int) (newCurLine gem.String) {
+func appendWordToWrappedLine(lines *tb.Block, curWord gem.String, curLine gem.String, width int, opts Options) (newCurLine gem.String) {
 	// any width less than 2 is not possible and will result in an infinite loop,
 	// as at least one character is required for next in word, and one character for
 	// line continuation.
 	if width < 2 {
 		panic(fmt.Sprintf("invalid width in call to appendWordToLine: %v", width))
 	}
-	//originalWord := string(curWord)
+	curWord, hyphens := opts.hyphenate(curWord)
 	for curWord.Len() > 0 {
-		addedChars := curWord.Len()
+		curLineWidth := opts.Width(curLine)
+		addedChars := opts.Width(curWord)
 		if curLine.Len() != 0 {
 			addedChars++ // for the space
 		}
-		if curLine.Len()+addedChars == width {
+		if curLineWidth+addedChars == width {
 			if curLine.Len() != 0 {
 				curLine = curLine.Add(gem.New(" "))
 			}
@@ -327,11 +715,31 @@ func appendWordToWrappedLine(lines *tb.Block, curWord gem.String, curLine gem.St
 			lines.Append(curLine)
 			curLine = gem.Zero
 			curWord = gem.Zero
-		} else if curLine.Len()+addedChars > width {
-			if curLine.Len() == 0 {
-				curLine = curLine.Add(curWord.Sub(0, width-1))
+		} else if curLineWidth+addedChars > width {
+			spaceWidth := 0
+			if curLine.Len() != 0 {
+				spaceWidth = 1
+			}
+			if splitIdx := opts.lastHyphenFit(curWord, hyphens, width-curLineWidth-spaceWidth); splitIdx > 0 {
+				// as much of the word as will fit is put on the line
+				if curLine.Len() != 0 {
+					curLine = curLine.Add(gem.New(" "))
+				}
+				curLine = curLine.Add(curWord.Sub(0, splitIdx))
+				curLine = curLine.Add(gem.New("-"))
+				curWord = curWord.Sub(splitIdx, curWord.Len())
+				hyphens = shiftPoints(hyphens, splitIdx)
+			} else if curLine.Len() == 0 {
+				splitIdx := opts.fit(curWord, width-1)
+				if splitIdx < 1 {
+					// the first char is by itself too wide for the line, but
+					// it must be placed somewhere to make progress.
+					splitIdx = 1
+				}
+				curLine = curLine.Add(curWord.Sub(0, splitIdx))
 				curLine = curLine.Add(gem.New("-"))
-				curWord = curWord.Sub(width-1, curWord.Len())
+				curWord = curWord.Sub(splitIdx, curWord.Len())
+				hyphens = shiftPoints(hyphens, splitIdx)
 			}
 			lines.Append(curLine)
 			curLine = gem.Zero
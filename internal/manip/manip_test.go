@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/dekarrin/rosed/internal/gem"
+	"github.com/dekarrin/rosed/internal/hyph"
 	"github.com/dekarrin/rosed/internal/tb"
 
 	"github.com/stretchr/testify/assert"
@@ -14,30 +15,36 @@ func Test_CollapseSpace(t *testing.T) {
 		name   string
 		input  gem.String
 		sep    gem.String
+		opts   Options
 		expect gem.String
 	}{
-		{"empty input", gem.Zero, gem.Zero, gem.Zero},
-		{"no space to collapse", gem.New("no_spaces"), gem.Zero, gem.New("no_spaces")},
-		{"one space mid-text is not collapsed (odd runecount)", gem.New("testA testB"), gem.Zero, gem.New("testA testB")},
-		{"one space mid-text is not collapsed (even runecount)", gem.New("test testB"), gem.Zero, gem.New("test testB")},
-		{"one space at start is not collapsed (odd runecount)", gem.New(" test"), gem.Zero, gem.New(" test")},
-		{"one space at start is not collapsed (even runecount)", gem.New(" testA"), gem.Zero, gem.New(" testA")},
-		{"one space at end is not collapsed (odd runecount)", gem.New("test "), gem.Zero, gem.New("test ")},
-		{"one space at end is not collapsed (even runecount)", gem.New("testA "), gem.Zero, gem.New("testA ")},
-		{"one space everywhere is not collapsed (odd runecount)", gem.New(" testA testB "), gem.Zero, gem.New(" testA testB ")},
-		{"one space everywhere is not collapsed (even runecount)", gem.New(" test testB "), gem.Zero, gem.New(" test testB ")},
-		{"non-spacechar whitespace is converted to space (odd runecount)", gem.New("testA\u0085testB"), gem.Zero, gem.New("testA testB")},
-		{"non-spacechar whitespace is converted to space (even runecount)", gem.New("test\u0085testB"), gem.Zero, gem.New("test testB")},
-		{"ws run is collapsed (spacechar)", gem.New("       testA  testB  "), gem.Zero, gem.New(" testA testB ")},
-		{"ws run is collapsed (mixed ws)", gem.New("\u205f\u202ftestA\u200a  \t\n testB\t"), gem.Zero, gem.New(" testA testB ")},
-		{"non-ws separator", gem.New("testA\n  testB <SEP>\u205f  testC"), gem.New("<SEP>"), gem.New("testA testB testC")},
-		{"ws separator", gem.New("testA\n  testB <SEP>\n\n\u205f  testC"), gem.New("\n\n"), gem.New("testA testB <SEP> testC")},
+		{"empty input", gem.Zero, gem.Zero, Options{}, gem.Zero},
+		{"no space to collapse", gem.New("no_spaces"), gem.Zero, Options{}, gem.New("no_spaces")},
+		{"one space mid-text is not collapsed (odd runecount)", gem.New("testA testB"), gem.Zero, Options{}, gem.New("testA testB")},
+		{"one space mid-text is not collapsed (even runecount)", gem.New("test testB"), gem.Zero, Options{}, gem.New("test testB")},
+		{"one space at start is not collapsed (odd runecount)", gem.New(" test"), gem.Zero, Options{}, gem.New(" test")},
+		{"one space at start is not collapsed (even runecount)", gem.New(" testA"), gem.Zero, Options{}, gem.New(" testA")},
+		{"one space at end is not collapsed (odd runecount)", gem.New("test "), gem.Zero, Options{}, gem.New("test ")},
+		{"one space at end is not collapsed (even runecount)", gem.New("testA "), gem.Zero, Options{}, gem.New("testA ")},
+		{"one space everywhere is not collapsed (odd runecount)", gem.New(" testA testB "), gem.Zero, Options{}, gem.New(" testA testB ")},
+		{"one space everywhere is not collapsed (even runecount)", gem.New(" test testB "), gem.Zero, Options{}, gem.New(" test testB ")},
+		{"non-spacechar whitespace is converted to space (odd runecount)", gem.New("testA\u0085testB"), gem.Zero, Options{}, gem.New("testA testB")},
+		{"non-spacechar whitespace is converted to space (even runecount)", gem.New("test\u0085testB"), gem.Zero, Options{}, gem.New("test testB")},
+		{"ws run is collapsed (spacechar)", gem.New("       testA  testB  "), gem.Zero, Options{}, gem.New(" testA testB ")},
+		{"ws run is collapsed (mixed ws)", gem.New("\u205f\u202ftestA\u200a  \t\n testB\t"), gem.Zero, Options{}, gem.New(" testA testB ")},
+		{"non-ws separator", gem.New("testA\n  testB <SEP>\u205f  testC"), gem.New("<SEP>"), Options{}, gem.New("testA testB testC")},
+		{"ws separator", gem.New("testA\n  testB <SEP>\n\n\u205f  testC"), gem.New("\n\n"), Options{}, gem.New("testA testB <SEP> testC")},
+		{"no-break space is collapsed without UnicodeLineBreaks", gem.New("100\u00a0 dollars"), gem.Zero, Options{}, gem.New("100 dollars")},
+		{"no-break space is kept with UnicodeLineBreaks", gem.New("100\u00a0 dollars  now"), gem.Zero, Options{UnicodeLineBreaks: true}, gem.New("100\u00a0 dollars now")},
+		{"escape sequences are not collapsed without ANSIEscapes", gem.New("a\x1b]0;a  title\a  b"), gem.Zero, Options{}, gem.New("a\x1b]0;a title\a b")},
+		{"escape sequences are kept with ANSIEscapes", gem.New("a\x1b]0;a  title\a  b"), gem.Zero, Options{ANSIEscapes: true}, gem.New("a\x1b]0;a  title\a b")},
+		{"ws run is collapsed with ANSIEscapes", gem.New("\x1b[1m  testA\t\n testB\x1b[0m  "), gem.New("\n"), Options{ANSIEscapes: true}, gem.New("\x1b[1m testA testB\x1b[0m ")},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
-			actual := CollapseSpace(tc.input, tc.sep)
+			actual := CollapseSpace(tc.input, tc.sep, tc.opts)
 			assert.True(tc.expect.Equal(actual))
 		})
 	}
@@ -49,6 +56,7 @@ func Test_Wrap(t *testing.T) {
 		input    gem.String
 		width    int
 		sep      gem.String
+		opts     Options
 		expected tb.Block
 	}{
 		{
@@ -171,11 +179,647 @@ func Test_Wrap(t *testing.T) {
 				LineSeparator: gem.New("\n"),
 			},
 		},
+		{
+			name:  "wide chars counted as one char each by default",
+			input: gem.New("日本語の テキスト"),
+			width: 6,
+			sep:   gem.New("\n"),
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("日本語の"),
+					gem.New("テキスト"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "wide chars with display width",
+			input: gem.New("日本語の テキスト"),
+			width: 6,
+			sep:   gem.New("\n"),
+			opts:  Options{DisplayWidth: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("日本-"),
+					gem.New("語の"),
+					gem.New("テキ-"),
+					gem.New("スト"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "emoji with display width",
+			input: gem.New("go 🌹 go 👨‍👩‍👧 go"),
+			width: 7,
+			sep:   gem.New("\n"),
+			opts:  Options{DisplayWidth: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("go 🌹"),
+					gem.New("go 👨‍👩‍👧"),
+					gem.New("go"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "escape sequences are counted without ANSIEscapes",
+			input: gem.New("\x1b[31mred\x1b[0m text"),
+			width: 10,
+			sep:   gem.New("\n"),
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("\x1b[31mred\x1b-"),
+					gem.New("[0m text"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "escape sequences are zero-width with ANSIEscapes",
+			input: gem.New("\x1b[31mred\x1b[0m text"),
+			width: 10,
+			sep:   gem.New("\n"),
+			opts:  Options{ANSIEscapes: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("\x1b[31mred\x1b[0m text"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "style is carried across lines with ANSIEscapes",
+			input: gem.New("\x1b[1mbold \x1b[31mred text\x1b[0m plain text"),
+			width: 8,
+			sep:   gem.New("\n"),
+			opts:  Options{ANSIEscapes: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("\x1b[1mbold \x1b[31mred\x1b[0m"),
+					gem.New("\x1b[1m\x1b[31mtext\x1b[0m"),
+					gem.New("plain"),
+					gem.New("text"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "escape sequences are never split with ANSIEscapes",
+			input: gem.New("a\x1b]8;;http://example.com\x1b\\link\x1b]8;;\x1b\\b"),
+			width: 4,
+			sep:   gem.New("\n"),
+			opts:  Options{ANSIEscapes: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("a\x1b]8;;http://example.com\x1b\\li-"),
+					gem.New("nk\x1b]8;;\x1b\\b"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "reset at the start of a word with ANSIEscapes",
+			input: gem.New("\x1b[32mgreen \x1b[0m plain"),
+			width: 6,
+			sep:   gem.New("\n"),
+			opts:  Options{ANSIEscapes: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("\x1b[32mgreen\x1b[0m"),
+					gem.New("\x1b[32m\x1b[0mplain"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "hyphens are not break points without unicode line breaks",
+			input: gem.New("a well-known fact"),
+			width: 8,
+			sep:   gem.New("\n"),
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("a"),
+					gem.New("well-kn-"),
+					gem.New("own fact"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "break after hyphens with unicode line breaks",
+			input: gem.New("a well-known fact"),
+			width: 8,
+			sep:   gem.New("\n"),
+			opts:  Options{UnicodeLineBreaks: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("a well-"),
+					gem.New("known"),
+					gem.New("fact"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "no-break space is kept with unicode line breaks",
+			input: gem.New("it costs 100\u00a0dollars"),
+			width: 12,
+			sep:   gem.New("\n"),
+			opts:  Options{UnicodeLineBreaks: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("it costs"),
+					gem.New("100\u00a0dollars"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "break between ideographs with unicode line breaks",
+			input: gem.New("\u65e5\u672c\u8a9e\u306e\u30c6\u30ad\u30b9\u30c8\u3002"),
+			width: 6,
+			sep:   gem.New("\n"),
+			opts:  Options{UnicodeLineBreaks: true, DisplayWidth: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("\u65e5\u672c\u8a9e"),
+					gem.New("\u306e\u30c6\u30ad"),
+					gem.New("\u30b9\u30c8\u3002"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "too-long word is split with unicode line breaks",
+			input: gem.New("a supercalifragilistic word"),
+			width: 10,
+			sep:   gem.New("\n"),
+			opts:  Options{UnicodeLineBreaks: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("a"),
+					gem.New("supercali-"),
+					gem.New("fragilist-"),
+					gem.New("ic word"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "escape sequences are kept with following text with unicode line breaks",
+			input: gem.New("plain \x1b[1mbold\x1b[0m text"),
+			width: 6,
+			sep:   gem.New("\n"),
+			opts:  Options{UnicodeLineBreaks: true, ANSIEscapes: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("plain"),
+					gem.New("\x1b[1mbold\x1b[0m"),
+					gem.New("text"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "minimum raggedness evens out line lengths",
+			input: gem.New("aaa bb cc ddddd"),
+			width: 6,
+			sep:   gem.New("\n"),
+			opts:  Options{WrapAlgorithm: MinimumRaggedness},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("aaa"),
+					gem.New("bb cc"),
+					gem.New("ddddd"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "minimum raggedness does not count last line",
+			input: gem.New("aa bb cc d"),
+			width: 5,
+			sep:   gem.New("\n"),
+			opts:  Options{WrapAlgorithm: MinimumRaggedness},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("aa bb"),
+					gem.New("cc d"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "knuth-plass minimizes stretch of spaces",
+			input: gem.New("Your name is ROSE LALONDE. You are a master of the dark arts and you write long novels about wizards in your spare time"),
+			width: 16,
+			sep:   gem.New("\n"),
+			opts:  Options{WrapAlgorithm: KnuthPlass},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("Your name is"),
+					gem.New("ROSE LALONDE."),
+					gem.New("You are a"),
+					gem.New("master of the"),
+					gem.New("dark arts and"),
+					gem.New("you write long"),
+					gem.New("novels about"),
+					gem.New("wizards in your"),
+					gem.New("spare time"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "knuth-plass splits too-long word",
+			input: gem.New("a supercalifragilistic word"),
+			width: 10,
+			sep:   gem.New("\n"),
+			opts:  Options{WrapAlgorithm: KnuthPlass},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("a"),
+					gem.New("supercali-"),
+					gem.New("fragilist-"),
+					gem.New("ic word"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "knuth-plass does not strand a word on a line by itself",
+			input: gem.New("The quick brown fox jumps over the lazy dog and demonstrates hyphenation of extraordinarily long words."),
+			width: 24,
+			sep:   gem.New("\n"),
+			opts:  Options{WrapAlgorithm: KnuthPlass},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("The quick brown fox"),
+					gem.New("jumps over the lazy"),
+					gem.New("dog and"),
+					gem.New("demonstrates hyphenation"),
+					gem.New("of extraordinarily long"),
+					gem.New("words."),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "minimum raggedness with unicode line breaks",
+			input: gem.New("well-known and/or co-operative"),
+			width: 11,
+			sep:   gem.New("\n"),
+			opts:  Options{WrapAlgorithm: MinimumRaggedness, UnicodeLineBreaks: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("well-known"),
+					gem.New("and/or co-"),
+					gem.New("operative"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "knuth-plass keeps escape sequences with words",
+			input: gem.New("plain \x1b[1mbold text\x1b[0m here and there"),
+			width: 10,
+			sep:   gem.New("\n"),
+			opts:  Options{WrapAlgorithm: KnuthPlass, ANSIEscapes: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("plain \x1b[1mbold\x1b[0m"),
+					gem.New("\x1b[1mtext\x1b[0m here"),
+					gem.New("and there"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "hyphenation fills lines",
+			input: gem.New("The typographical conventions"),
+			width: 16,
+			sep:   gem.New("\n"),
+			opts:  Options{Hyphenator: hyph.English()},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("The typographi-"),
+					gem.New("cal conventions"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "hyphenation splits long words at hyphenation points",
+			input: gem.New("incomprehensibilities"),
+			width: 5,
+			sep:   gem.New("\n"),
+			opts:  Options{Hyphenator: hyph.English()},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("in-"),
+					gem.New("com-"),
+					gem.New("pre-"),
+					gem.New("hen-"),
+					gem.New("si-"),
+					gem.New("bili-"),
+					gem.New("ties"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "hyphenation uses only soft hyphens in words that have them",
+			input: gem.New("a soft\u00ADhy\u00ADphen\u00ADated word"),
+			width: 10,
+			sep:   gem.New("\n"),
+			opts:  Options{Hyphenator: hyph.English()},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("a softhy-"),
+					gem.New("phenated"),
+					gem.New("word"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "soft hyphens are kept without hyphenation",
+			input: gem.New("soft\u00ADware"),
+			width: 80,
+			sep:   gem.New("\n"),
+			opts:  Options{},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("soft\u00ADware"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "hyphenation with unicode line breaks",
+			input: gem.New("a soft\u00ADware thing"),
+			width: 7,
+			sep:   gem.New("\n"),
+			opts:  Options{Hyphenator: hyph.English(), UnicodeLineBreaks: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("a soft-"),
+					gem.New("ware"),
+					gem.New("thing"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "hyphenation skips escape sequences",
+			input: gem.New("a \x1b[1mhyphenation\x1b[0m"),
+			width: 8,
+			sep:   gem.New("\n"),
+			opts:  Options{Hyphenator: hyph.English(), ANSIEscapes: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("a \x1b[1mhy-\x1b[0m"),
+					gem.New("\x1b[1mphena-\x1b[0m"),
+					gem.New("\x1b[1mtion\x1b[0m"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "knuth-plass with hyphenation",
+			input: gem.New("The typographical conventions of hyphenation are incomprehensibilities to most."),
+			width: 16,
+			sep:   gem.New("\n"),
+			opts:  Options{WrapAlgorithm: KnuthPlass, Hyphenator: hyph.English()},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("The typographi-"),
+					gem.New("cal conventions"),
+					gem.New("of hyphenation"),
+					gem.New("are incompre-"),
+					gem.New("hensibilities to"),
+					gem.New("most."),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "minimum raggedness with hyphenation",
+			input: gem.New("The typographical conventions of hyphenation"),
+			width: 16,
+			sep:   gem.New("\n"),
+			opts:  Options{WrapAlgorithm: MinimumRaggedness, Hyphenator: hyph.English()},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("The typographi-"),
+					gem.New("cal conventions"),
+					gem.New("of hyphenation"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "preserve whitespace keeps short lines as they are",
+			input: gem.New("if x {\n\treturn  y\n}\n"),
+			width: 20,
+			sep:   gem.New("\n"),
+			opts:  Options{PreserveWhitespace: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("if x {"),
+					gem.New("\treturn  y"),
+					gem.New("}"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "preserve whitespace breaks long lines at whitespace",
+			input: gem.New("  a  bb   ccc dddd  "),
+			width: 8,
+			sep:   gem.New("\n"),
+			opts:  Options{PreserveWhitespace: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("  a  bb"),
+					gem.New("ccc dddd  "),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "preserve whitespace keeps blank lines",
+			input: gem.New("aaa bbb\n\nccc ddd"),
+			width: 4,
+			sep:   gem.New("\n"),
+			opts:  Options{PreserveWhitespace: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("aaa"),
+					gem.New("bbb"),
+					gem.New(""),
+					gem.New("ccc"),
+					gem.New("ddd"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "preserve whitespace with repeated indent",
+			input: gem.New("    aaa bbb  ccc"),
+			width: 11,
+			sep:   gem.New("\n"),
+			opts:  Options{PreserveWhitespace: true, RepeatIndent: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("    aaa bbb"),
+					gem.New("    ccc"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "preserve whitespace splits long words",
+			input: gem.New("  abcdefghij"),
+			width: 6,
+			sep:   gem.New("\n"),
+			opts:  Options{PreserveWhitespace: true, RepeatIndent: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("  abc-"),
+					gem.New("  def-"),
+					gem.New("  ghij"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "preserve whitespace does not repeat an indent that is too wide",
+			input: gem.New("    a b"),
+			width: 5,
+			sep:   gem.New("\n"),
+			opts:  Options{PreserveWhitespace: true, RepeatIndent: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("    a"),
+					gem.New("b"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "lists wrap each item with a hanging indent",
+			input: gem.New("Notes:\n- a b c d\n- e"),
+			width: 6,
+			sep:   gem.New("\n"),
+			opts:  Options{RecognizeLists: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("Notes:"),
+					gem.New("- a b"),
+					gem.New("  c d"),
+					gem.New("- e"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "lists join continuation lines with their item",
+			input: gem.New("1. a\n   b c\n2) d"),
+			width: 10,
+			sep:   gem.New("\n"),
+			opts:  Options{RecognizeLists: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("1. a b c"),
+					gem.New("2) d"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "lists keep the indent of nested items",
+			input: gem.New("* a b c\n  + d e f"),
+			width: 7,
+			sep:   gem.New("\n"),
+			opts:  Options{RecognizeLists: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("* a b c"),
+					gem.New("  + d e"),
+					gem.New("    f"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "lists keep blank lines between items",
+			input: gem.New("- a b c\n\n- d\n\n"),
+			width: 6,
+			sep:   gem.New("\n"),
+			opts:  Options{RecognizeLists: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("- a b"),
+					gem.New("  c"),
+					gem.New(""),
+					gem.New("- d"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "lists indent paragraphs after a blank line in an item",
+			input: gem.New("1. a b\n\n   c d e\n\nf g h"),
+			width: 7,
+			sep:   gem.New("\n"),
+			opts:  Options{RecognizeLists: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("1. a b"),
+					gem.New(""),
+					gem.New("   c d"),
+					gem.New("   e"),
+					gem.New(""),
+					gem.New("f g h"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "lists carry styles across items",
+			input: gem.New("- \x1b[1ma b\n- c\x1b[0m"),
+			width: 10,
+			sep:   gem.New("\n"),
+			opts:  Options{RecognizeLists: true, ANSIEscapes: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("- \x1b[1ma b\x1b[0m"),
+					gem.New("\x1b[1m- c\x1b[0m"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name:  "lists do not treat markers without a following space as items",
+			input: gem.New("-a\n1.5 b"),
+			width: 10,
+			sep:   gem.New("\n"),
+			opts:  Options{RecognizeLists: true},
+			expected: tb.Block{
+				Lines: []gem.String{
+					gem.New("-a 1.5 b"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
-			actual := Wrap(tc.input, tc.width, tc.sep)
+			actual := Wrap(tc.input, tc.width, tc.sep, tc.opts)
 			assert.True(tc.expected.Equal(actual))
 		})
 	}
@@ -186,22 +830,25 @@ func Test_JustifyLine(t *testing.T) {
 		name   string
 		input  gem.String
 		width  int
+		opts   Options
 		expect gem.String
 	}{
-		{"empty line", gem.New(""), 10, gem.New("")},
-		{"no spaces", gem.New("bluh"), 10, gem.New("bluh")},
-		{"2 words", gem.New("word1 word2"), 20, gem.New("word1          word2")},
-		{"3 words", gem.New("word1 word2 word3"), 20, gem.New("word1   word2  word3")},
-		{"3 words with runs of spaces", gem.New("word1        word2  word3"), 20, gem.New("word1   word2  word3")},
-		{"line longer than width", gem.New("hello"), 3, gem.New("hello")},
-		{"bad width", gem.New("hello"), -1, gem.New("hello")},
+		{"empty line", gem.New(""), 10, Options{}, gem.New("")},
+		{"no spaces", gem.New("bluh"), 10, Options{}, gem.New("bluh")},
+		{"2 words", gem.New("word1 word2"), 20, Options{}, gem.New("word1          word2")},
+		{"3 words", gem.New("word1 word2 word3"), 20, Options{}, gem.New("word1   word2  word3")},
+		{"3 words with runs of spaces", gem.New("word1        word2  word3"), 20, Options{}, gem.New("word1   word2  word3")},
+		{"line longer than width", gem.New("hello"), 3, Options{}, gem.New("hello")},
+		{"bad width", gem.New("hello"), -1, Options{}, gem.New("hello")},
+		{"escapes are not counted with ANSIEscapes", gem.New("\x1b[1mword1\x1b[0m word2"), 14, Options{ANSIEscapes: true}, gem.New("\x1b[1mword1\x1b[0m    word2")},
+		{"spaces within escapes are not used as gaps", gem.New("\x1b]0;a b\aword1 word2"), 14, Options{ANSIEscapes: true}, gem.New("\x1b]0;a b\aword1    word2")},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
 
-			actual := JustifyLine(tc.input, tc.width)
+			actual := JustifyLine(tc.input, tc.width, tc.opts)
 
 			assert.True(tc.expect.Equal(actual))
 		})
@@ -323,7 +970,88 @@ func Test_CombineColumnBlocks(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
 
-			actual := CombineColumnBlocks(tc.left, tc.right, tc.minSpace)
+			actual := CombineColumnBlocks(tc.left, tc.right, tc.minSpace, Options{})
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_CombineColumns(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cols     []tb.Block
+		widths   []int
+		minSpace int
+		expect   tb.Block
+	}{
+		{
+			name:   "no columns",
+			expect: tb.Block{},
+		},
+		{
+			name: "one column",
+			cols: []tb.Block{
+				{Lines: []gem.String{gem.New("Karkat"), gem.New("Vantas")}},
+			},
+			minSpace: 2,
+			expect: tb.Block{
+				Lines: []gem.String{gem.New("Karkat"), gem.New("Vantas")},
+			},
+		},
+		{
+			name: "three columns",
+			cols: []tb.Block{
+				{Lines: []gem.String{gem.New("Karkat"), gem.New("Vantas")}},
+				{Lines: []gem.String{gem.New("Terezi"), gem.New("Pyrope"), gem.New("Libra")}},
+				{Lines: []gem.String{gem.New("Sollux")}},
+			},
+			minSpace: 2,
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("Karkat  Terezi  Sollux"),
+					gem.New("Vantas  Pyrope  "),
+					gem.New("        Libra   "),
+				},
+			},
+		},
+		{
+			name: "given widths",
+			cols: []tb.Block{
+				{Lines: []gem.String{gem.New("Karkat"), gem.New("Vantas")}},
+				{Lines: []gem.String{gem.New("Terezi")}},
+			},
+			widths:   []int{8},
+			minSpace: 1,
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("Karkat   Terezi"),
+					gem.New("Vantas   "),
+				},
+			},
+		},
+		{
+			name: "line wider than given width",
+			cols: []tb.Block{
+				{Lines: []gem.String{gem.New("Karkat"), gem.New("Vantas")}},
+				{Lines: []gem.String{gem.New("Terezi")}},
+			},
+			widths:   []int{2, 2},
+			minSpace: 1,
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("Karkat Terezi"),
+					gem.New("Vantas "),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := CombineColumns(tc.cols, tc.widths, tc.minSpace, Options{})
 
 			assert.Equal(tc.expect, actual)
 		})
@@ -352,7 +1080,7 @@ func Test_AlignLineLeft(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
 
-			actual := AlignLineLeft(tc.input, tc.width)
+			actual := AlignLineLeft(tc.input, tc.width, Options{})
 
 			assert.True(tc.expect.Equal(actual))
 			assert.Equal(tc.expect.String(), actual.String())
@@ -382,7 +1110,7 @@ func Test_AlignLineRight(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
 
-			actual := AlignLineRight(tc.input, tc.width)
+			actual := AlignLineRight(tc.input, tc.width, Options{})
 
 			assert.True(tc.expect.Equal(actual))
 			assert.Equal(tc.expect.String(), actual.String())
@@ -413,7 +1141,7 @@ func Test_AlignLineCenter(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
 
-			actual := AlignLineCenter(tc.input, tc.width)
+			actual := AlignLineCenter(tc.input, tc.width, Options{})
 
 			assert.True(tc.expect.Equal(actual))
 			assert.Equal(tc.expect.String(), actual.String())
@@ -421,6 +1149,100 @@ func Test_AlignLineCenter(t *testing.T) {
 	}
 }
 
+func Test_TruncateTail(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    gem.String
+		width    int
+		ellipsis gem.String
+		opts     Options
+		expect   gem.String
+	}{
+		{"empty string", gem.Zero, 5, gem.New("\u2026"), Options{}, gem.Zero},
+		{"already fits", gem.New("rose"), 4, gem.New("\u2026"), Options{}, gem.New("rose")},
+		{"too wide", gem.New("rose lalonde"), 8, gem.New("\u2026"), Options{}, gem.New("rose la\u2026")},
+		{"multi-char ellipsis", gem.New("rose lalonde"), 8, gem.New("..."), Options{}, gem.New("rose ...")},
+		{"ellipsis too wide", gem.New("rose lalonde"), 2, gem.New("..."), Options{}, gem.New("ro")},
+		{"width 0", gem.New("rose"), 0, gem.New("\u2026"), Options{}, gem.Zero},
+		{"grapheme clusters are not split", gem.New("ros\u00e9 e\u0301e"), 6, gem.New("\u2026"), Options{}, gem.New("ros\u00e9 \u2026")},
+		{"ZWJ sequence is not split", gem.New("a\U0001F469\u200D\U0001F467bc"), 3, gem.New("\u2026"), Options{}, gem.New("a\U0001F469\u200D\U0001F467\u2026")},
+		{"display width", gem.New("\u30ed\u30fc\u30ba"), 4, gem.New("\u2026"), Options{DisplayWidth: true}, gem.New("\u30ed\u2026")},
+		{"styles are closed", gem.New("\x1b[31mrose lalonde\x1b[0m"), 5, gem.New("\u2026"), Options{ANSIEscapes: true}, gem.New("\x1b[31mrose\x1b[0m\u2026")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := TruncateTail(tc.input, tc.width, tc.ellipsis, tc.opts)
+
+			assert.Equal(tc.expect.String(), actual.String())
+		})
+	}
+}
+
+func Test_TruncateHead(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    gem.String
+		width    int
+		ellipsis gem.String
+		opts     Options
+		expect   gem.String
+	}{
+		{"empty string", gem.Zero, 5, gem.New("\u2026"), Options{}, gem.Zero},
+		{"already fits", gem.New("rose"), 4, gem.New("\u2026"), Options{}, gem.New("rose")},
+		{"too wide", gem.New("rose lalonde"), 8, gem.New("\u2026"), Options{}, gem.New("\u2026lalonde")},
+		{"multi-char ellipsis", gem.New("rose lalonde"), 8, gem.New("..."), Options{}, gem.New("...londe")},
+		{"ellipsis too wide", gem.New("rose lalonde"), 2, gem.New("..."), Options{}, gem.New("de")},
+		{"width 0", gem.New("rose"), 0, gem.New("\u2026"), Options{}, gem.Zero},
+		{"grapheme clusters are not split", gem.New("ros\u00e9 e\u0301e"), 4, gem.New("\u2026"), Options{}, gem.New("\u2026 e\u0301e")},
+		{"display width", gem.New("\u30ed\u30fc\u30ba"), 4, gem.New("\u2026"), Options{DisplayWidth: true}, gem.New("\u2026\u30ba")},
+		{"styles are reopened", gem.New("\x1b[31mrose lalonde\x1b[0m"), 5, gem.New("\u2026"), Options{ANSIEscapes: true}, gem.New("\u2026\x1b[31monde\x1b[0m")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := TruncateHead(tc.input, tc.width, tc.ellipsis, tc.opts)
+
+			assert.Equal(tc.expect.String(), actual.String())
+		})
+	}
+}
+
+func Test_TruncateMiddle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    gem.String
+		width    int
+		ellipsis gem.String
+		opts     Options
+		expect   gem.String
+	}{
+		{"empty string", gem.Zero, 5, gem.New("\u2026"), Options{}, gem.Zero},
+		{"already fits", gem.New("rose"), 4, gem.New("\u2026"), Options{}, gem.New("rose")},
+		{"too wide", gem.New("rose lalonde"), 8, gem.New("\u2026"), Options{}, gem.New("rose\u2026nde")},
+		{"even split", gem.New("rose lalonde"), 7, gem.New("\u2026"), Options{}, gem.New("ros\u2026nde")},
+		{"multi-char ellipsis", gem.New("rose lalonde"), 8, gem.New("..."), Options{}, gem.New("ros...de")},
+		{"ellipsis too wide", gem.New("rose lalonde"), 2, gem.New("..."), Options{}, gem.New("re")},
+		{"width 0", gem.New("rose"), 0, gem.New("\u2026"), Options{}, gem.Zero},
+		{"display width", gem.New("\u30ed\u30fc\u30bax"), 6, gem.New("\u2026"), Options{DisplayWidth: true}, gem.New("\u30ed\u2026\u30bax")},
+		{"styles are closed and reopened", gem.New("\x1b[31mrose lalonde\x1b[0m"), 5, gem.New("\u2026"), Options{ANSIEscapes: true}, gem.New("\x1b[31mro\x1b[0m\u2026\x1b[31mde\x1b[0m")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := TruncateMiddle(tc.input, tc.width, tc.ellipsis, tc.opts)
+
+			assert.Equal(tc.expect.String(), actual.String())
+		})
+	}
+}
+
 func Test_CountLeadingWhitespace(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -472,3 +1294,92 @@ func Test_CountTrailingWhitespace(t *testing.T) {
 		})
 	}
 }
+
+func Test_FindLinePrefix(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  []string
+		expect string
+	}{
+		{"no lines", nil, ""},
+		{"no prefix", []string{"feferi", "peixes"}, ""},
+		{"line comment", []string{"// feferi", "// peixes"}, "// "},
+		{"hash comment", []string{"# feferi", "# peixes"}, "# "},
+		{"nested quotes", []string{"> > feferi", "> > peixes"}, "> > "},
+		{"block comment", []string{" * feferi", " * peixes"}, " * "},
+		{"indent", []string{"    feferi", "    peixes"}, "    "},
+		{"longest shared prefix", []string{"// > feferi", "//   peixes"}, "// "},
+		{"lines of only prefix are skipped", []string{"// feferi", "//", "// peixes"}, "// "},
+		{"prefix must end in whitespace", []string{"*feferi* peixes"}, ""},
+		{"differing prefixes", []string{"# feferi", "#peixes"}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			lines := make([]gem.String, len(tc.input))
+			for i := range tc.input {
+				lines[i] = gem.New(tc.input[i])
+			}
+
+			actual := FindLinePrefix(lines)
+
+			assert.Equal(tc.expect, actual.String())
+		})
+	}
+}
+
+func Test_HasLinePrefix(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  gem.String
+		prefix gem.String
+		expect bool
+	}{
+		{"no prefix", gem.New("// feferi"), gem.Zero, true},
+		{"has prefix", gem.New("// feferi"), gem.New("// "), true},
+		{"prefix without trailing space", gem.New("//"), gem.New("// "), true},
+		{"empty line", gem.Zero, gem.New("# "), true},
+		{"different prefix", gem.New("# feferi"), gem.New("// "), false},
+		{"start of block comment", gem.New("/*"), gem.New(" * "), false},
+		{"end of block comment", gem.New(" */"), gem.New(" * "), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := HasLinePrefix(tc.input, tc.prefix)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_StripLinePrefix(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  gem.String
+		prefix gem.String
+		expect string
+	}{
+		{"no prefix", gem.New("// feferi"), gem.Zero, "// feferi"},
+		{"has prefix", gem.New("// feferi"), gem.New("// "), "feferi"},
+		{"only prefix", gem.New("// "), gem.New("// "), ""},
+		{"prefix without trailing space", gem.New("//"), gem.New("// "), ""},
+		{"start of prefix", gem.New("> "), gem.New("> > "), ""},
+		{"empty line", gem.Zero, gem.New("# "), ""},
+		{"different prefix", gem.New("# feferi"), gem.New("// "), "# feferi"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := StripLinePrefix(tc.input, tc.prefix)
+
+			assert.Equal(tc.expect, actual.String())
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package manip
+
+// This file contains the routines for finding and removing the prefix that is
+// shared by every line of a block of text, such as the markers at the start of
+// a comment or of quoted text.
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/dekarrin/rosed/internal/gem"
+)
+
+// prefixMarkers are the characters other than whitespace that a line prefix
+// can be made of.
+const prefixMarkers = "/#>*;%"
+
+// FindLinePrefix finds the prefix that is shared by the given lines, such as
+// "// ", "# ", "> > ", or " * ". A prefix is made up of whitespace and the
+// characters that mark comments and quoted text, and it must end with
+// whitespace so that text which happens to begin with one of those characters
+// is not mistaken for one. Lines that consist only of those characters are not
+// considered when finding the prefix. If the lines do not share a prefix, the
+// empty string is returned.
+func FindLinePrefix(lines []gem.String) gem.String {
+	prefix := gem.Zero
+	found := false
+	for _, line := range lines {
+		runEnd := line.IndexFunc(func(ch []rune) bool {
+			return !isPrefixChar(ch)
+		})
+		if runEnd == -1 {
+			// nothing but prefix characters; this line is a blank line of the
+			// block and so does not say what the prefix is.
+			continue
+		}
+
+		run := line.Sub(0, runEnd)
+		if !found {
+			prefix = run
+			found = true
+			continue
+		}
+
+		common := 0
+		for common < prefix.Len() && common < run.Len() && string(prefix.CharAt(common)) == string(run.CharAt(common)) {
+			common++
+		}
+		prefix = prefix.Sub(0, common)
+	}
+
+	end := prefix.LastIndexFunc(func(ch []rune) bool {
+		return unicode.IsSpace(ch[0])
+	})
+	return prefix.Sub(0, end+1)
+}
+
+// HasLinePrefix returns whether line is a line of the block of text that has
+// the given prefix. This is true if line starts with prefix, or if it is a
+// blank line of the block; that is, if it is only the start of prefix, not
+// counting any whitespace at its end. Every line has the empty prefix.
+func HasLinePrefix(line gem.String, prefix gem.String) bool {
+	if prefix.IsEmpty() {
+		return true
+	}
+
+	if line.Len() >= prefix.Len() && line.Sub(0, prefix.Len()).Equal(prefix) {
+		return true
+	}
+
+	trimmed := line.Sub(0, line.Len()-CountTrailingWhitespace(line))
+	return trimmed.Len() <= prefix.Len() && prefix.Sub(0, trimmed.Len()).Equal(trimmed)
+}
+
+// StripLinePrefix removes prefix from the start of line. A line that is only
+// the start of prefix, not counting any whitespace at its end, is considered to
+// be a blank line of the block that has the prefix and the empty string is
+// returned for it. A line that does not start with prefix is returned
+// unchanged.
+func StripLinePrefix(line gem.String, prefix gem.String) gem.String {
+	if prefix.IsEmpty() || !HasLinePrefix(line, prefix) {
+		return line
+	}
+
+	if line.Len() >= prefix.Len() && line.Sub(0, prefix.Len()).Equal(prefix) {
+		return line.Sub(prefix.Len(), line.Len())
+	}
+
+	return gem.Zero
+}
+
+// isPrefixChar returns whether the grapheme cluster ch can be a part of a line
+// prefix.
+func isPrefixChar(ch []rune) bool {
+	return len(ch) == 1 && (unicode.IsSpace(ch[0]) || strings.ContainsRune(prefixMarkers, ch[0]))
+}
@@ -0,0 +1,126 @@
+package manip
+
+// This file contains the routines for wrapping text without collapsing its
+// whitespace, for text such as source code where the existing line breaks and
+// spacing are significant.
+
+import (
+	"strings"
+
+	"github.com/dekarrin/rosed/internal/gem"
+	"github.com/dekarrin/rosed/internal/tb"
+)
+
+// wrapPreserving wraps each line of text on its own, appending each resulting
+// line to lines. Lines are broken only at whitespace, and the whitespace that a
+// line is broken at is removed; all other whitespace is kept as it is. If opts
+// has RepeatIndent set, the leading whitespace of each line is repeated at the
+// start of every line it is wrapped onto.
+func wrapPreserving(lines *tb.Block, text gem.String, width int, lineSep gem.String, opts Options) {
+	var srcLines []string
+	if lineSep.IsEmpty() {
+		srcLines = []string{text.String()}
+	} else {
+		srcLines = strings.Split(text.String(), lineSep.String())
+		if len(srcLines) > 1 && srcLines[len(srcLines)-1] == "" {
+			// a trailing line separator ends the last line rather than
+			// starting a new one.
+			srcLines = srcLines[:len(srcLines)-1]
+		}
+	}
+
+	for _, line := range srcLines {
+		opts.wrapLinePreserving(lines, gem.New(line), width)
+	}
+}
+
+// wrapLinePreserving wraps a single line for wrapPreserving.
+func (opts Options) wrapLinePreserving(lines *tb.Block, line gem.String, width int) {
+	if opts.Width(line) <= width {
+		lines.Append(line)
+		return
+	}
+
+	indent, tokens := opts.splitPreserving(line)
+
+	contIndent := gem.Zero
+	if opts.RepeatIndent && opts.Width(indent)+2 <= width {
+		contIndent = indent
+	}
+
+	curLine := indent
+	lineHasWord := false
+	for i := 0; i+1 < len(tokens); i += 2 {
+		gap, word := tokens[i], tokens[i+1]
+
+		if lineHasWord && opts.Width(curLine)+opts.Width(gap)+opts.Width(word) > width {
+			lines.Append(curLine)
+			curLine = contIndent
+			lineHasWord = false
+		}
+		if lineHasWord {
+			curLine = curLine.Add(gap)
+		}
+
+		// a word that will not fit on a line by itself is forcibly split.
+		for opts.Width(curLine)+opts.Width(word) > width {
+			splitIdx := opts.fit(word, width-opts.Width(curLine)-1)
+			if splitIdx < 1 {
+				splitIdx = 1
+			}
+			lines.Append(curLine.Add(word.Sub(0, splitIdx)).Add(gem.New("-")))
+			word = word.Sub(splitIdx, word.Len())
+			curLine = contIndent
+		}
+
+		curLine = curLine.Add(word)
+		lineHasWord = true
+	}
+
+	// whitespace at the end of the line is kept.
+	if len(tokens)%2 == 1 {
+		curLine = curLine.Add(tokens[len(tokens)-1])
+	}
+	lines.Append(curLine)
+}
+
+// splitPreserving splits line into its leading whitespace and the runs of
+// whitespace and non-whitespace that follow it. The returned tokens alternate
+// between whitespace and non-whitespace, starting with the whitespace that
+// comes before the first word, which is empty for the first word. Escape
+// sequences are included in the non-whitespace runs.
+func (opts Options) splitPreserving(line gem.String) (indent gem.String, tokens []gem.String) {
+	indentEnd := 0
+	for indentEnd < line.Len() && opts.isCollapsible(line.CharAt(indentEnd)[0]) && opts.escapeAt(line, indentEnd) == 0 {
+		indentEnd++
+	}
+	indent = line.Sub(0, indentEnd)
+
+	// runs alternate between non-whitespace and whitespace, starting with
+	// non-whitespace as the leading whitespace is not included.
+	tokens = []gem.String{gem.Zero}
+	cur := gem.Zero
+	inSpace := false
+	for i := indentEnd; i < line.Len(); i++ {
+		ch := line.Sub(i, i+1)
+		isSpace := false
+		if escLen := opts.escapeAt(line, i); escLen > 0 {
+			ch = line.Sub(i, i+escLen)
+			i += escLen - 1
+		} else {
+			isSpace = opts.isCollapsible(ch.CharAt(0)[0])
+		}
+
+		if isSpace != inSpace {
+			tokens = append(tokens, cur)
+			cur = gem.Zero
+			inSpace = isSpace
+		}
+		cur = cur.Add(ch)
+	}
+	if !cur.IsEmpty() {
+		tokens = append(tokens, cur)
+	}
+
+	return indent, tokens
+}
@@ -5,109 +5,483 @@ package manip
 // calculation steps.
 
 import (
+	"sort"
 	"strings"
+	"unicode"
 
 	"github.com/dekarrin/rosed/internal/gem"
 	"github.com/dekarrin/rosed/internal/tb"
 )
 
-type tableCharSet struct {
-	corner gem.String
-	vert   gem.String
-	horz   gem.String
+// ColumnAlignment is the way that the content of the cells in a table column is
+// aligned.
+type ColumnAlignment int
+
+const (
+	// ColumnLeft aligns content to the left side of the column.
+	ColumnLeft ColumnAlignment = iota
+
+	// ColumnRight aligns content to the right side of the column.
+	ColumnRight
+
+	// ColumnCenter aligns content to the center of the column.
+	ColumnCenter
+
+	// ColumnDecimal aligns content so that the decimal points of the numbers
+	// in the column line up, with the numbers as a whole aligned to the right
+	// side of the column. Content without a decimal point is treated as though
+	// it had one at its end.
+	ColumnDecimal
+)
+
+// HeaderCase is the way that the letters in the header row of a table are
+// cased.
+type HeaderCase int
+
+const (
+	// HeaderUpper converts the header to upper case.
+	HeaderUpper HeaderCase = iota
+
+	// HeaderAsIs leaves the header as it is given.
+	HeaderAsIs
+
+	// HeaderLower converts the header to lower case.
+	HeaderLower
+
+	// HeaderTitle converts the first letter of each word in the header to
+	// upper case and leaves the others as they are given.
+	HeaderTitle
+)
+
+// minNonBorderInterColumnPadding is the least amount of space between the
+// columns of a table that has no borders.
+const minNonBorderInterColumnPadding = 2
+
+// markdownStyle is the TableStyle that Markdown pipe tables are drawn with.
+// The rule under the header is drawn by markdownRule instead.
+var markdownStyle = TableStyle{
+	Vertical:     gem.New("|"),
+	LeftJunction: gem.New("|"), Horizontal: gem.New("-"), Cross: gem.New("|"), RightJunction: gem.New("|"),
+	HeaderLeft: gem.New("|"), HeaderHorizontal: gem.New("-"), HeaderCross: gem.New("|"), HeaderRight: gem.New("|"),
+}
+
+// minFitColumnWidth is the narrowest that a column with no minimum width is
+// made when a table is fit to its width.
+const minFitColumnWidth = 2
+
+// TableStyle is the set of characters used to draw the borders and rules of a
+// table. Each member is a single grapheme cluster.
+type TableStyle struct {
+	// TopLeft, Top, TopJunction, and TopRight draw the border at the top of
+	// the table. If all of them are empty, there is no top border.
+	TopLeft     gem.String
+	Top         gem.String
+	TopJunction gem.String
+	TopRight    gem.String
+
+	// Vertical draws the borders at the sides of the table and between its
+	// columns.
+	Vertical gem.String
+
+	// LeftJunction, Horizontal, Cross, and RightJunction draw the rules that
+	// go across the table between its rows.
+	LeftJunction  gem.String
+	Horizontal    gem.String
+	Cross         gem.String
+	RightJunction gem.String
+
+	// HeaderLeft, HeaderHorizontal, HeaderCross, and HeaderRight draw the rule
+	// that separates the header row from the rest of the table.
+	HeaderLeft       gem.String
+	HeaderHorizontal gem.String
+	HeaderCross      gem.String
+	HeaderRight      gem.String
+
+	// BottomLeft, Bottom, BottomJunction, and BottomRight draw the border at
+	// the bottom of the table. If all of them are empty, there is no bottom
+	// border.
+	BottomLeft     gem.String
+	Bottom         gem.String
+	BottomJunction gem.String
+	BottomRight    gem.String
+}
+
+// StyleFromCharSet creates a TableStyle from a string of the form
+// "<CORNER><VERT><HORZ>", where <CORNER> is the char used for every corner and
+// junction, <VERT> is the char used for vertical lines, and <HORZ> is the char
+// used for horizontal lines. If charSet is shorter than that, the missing
+// chars are filled in from the remaining chars of "+|-"; anything past the
+// third char is ignored.
+func StyleFromCharSet(charSet gem.String) TableStyle {
+	if charSet.Len() < 3 {
+		defaultSet := gem.New("+|-")
+		toAdd := defaultSet.Sub(charSet.Len(), 3)
+		charSet = charSet.Add(toAdd)
+	} else if charSet.Len() > 3 {
+		charSet = charSet.Sub(0, 3)
+	}
+
+	corner := charSet.Sub(0, 1)
+	vert := charSet.Sub(1, 2)
+	horz := charSet.Sub(2, 3)
+	return TableStyle{
+		TopLeft: corner, Top: horz, TopJunction: corner, TopRight: corner,
+		Vertical:     vert,
+		LeftJunction: corner, Horizontal: horz, Cross: corner, RightJunction: corner,
+		HeaderLeft: corner, HeaderHorizontal: horz, HeaderCross: corner, HeaderRight: corner,
+		BottomLeft: corner, Bottom: horz, BottomJunction: corner, BottomRight: corner,
+	}
+}
+
+// hasTop returns whether style draws a border at the top of a table.
+func (style TableStyle) hasTop() bool {
+	return !style.TopLeft.IsEmpty() || !style.Top.IsEmpty() || !style.TopJunction.IsEmpty() || !style.TopRight.IsEmpty()
+}
+
+// hasBottom returns whether style draws a border at the bottom of a table.
+func (style TableStyle) hasBottom() bool {
+	return !style.BottomLeft.IsEmpty() || !style.Bottom.IsEmpty() || !style.BottomJunction.IsEmpty() || !style.BottomRight.IsEmpty()
+}
+
+// rule draws a line across a table with columns of the given widths that goes
+// between the rows at indexes above and below, either of which may be -1 if
+// the line is at the top or bottom of the table. left and right are drawn at
+// its ends and horz across each column. Where the line meets a boundary
+// between two columns, cross is drawn if the boundary is in the rows both
+// above and below the line, down if it is only in the row below, up if it is
+// only in the row above, and horz if it is in neither.
+func (grid tableGrid) rule(colWidths []int, above, below int, left, horz, cross, right, down, up gem.String) gem.String {
+	bar := left
+	for col := range colWidths {
+		bar = bar.Add(gem.RepeatStr(horz.String(), colWidths[col]))
+		if col+1 >= len(colWidths) {
+			continue
+		}
+
+		inAbove := above >= 0 && grid.slots[above][col] != grid.slots[above][col+1]
+		inBelow := below >= 0 && grid.slots[below][col] != grid.slots[below][col+1]
+		switch {
+		case inAbove && inBelow:
+			bar = bar.Add(cross)
+		case inBelow:
+			bar = bar.Add(down)
+		case inAbove:
+			bar = bar.Add(up)
+		default:
+			bar = bar.Add(horz)
+		}
+	}
+	return bar.Add(right)
+}
+
+// headerJunctions returns the chars that are drawn in the rule under the
+// header row where it meets a boundary between columns that is only in the row
+// below it and where it meets one that is only in the row above it. If the
+// header rule is drawn with the same chars as other rules, these are the chars
+// of the top and bottom borders that have a line going down and up from them;
+// otherwise, the style has no chars for them and HeaderCross is used.
+func (style TableStyle) headerJunctions() (gem.String, gem.String) {
+	if style.HeaderCross.Equal(style.Cross) && style.HeaderHorizontal.Equal(style.Horizontal) && style.hasTop() && style.hasBottom() {
+		return style.TopJunction, style.BottomJunction
+	}
+	return style.HeaderCross, style.HeaderCross
+}
+
+// TableCell is a cell of table data that can span more than one column or row
+// of a table.
+type TableCell struct {
+	// Text is the content of the cell.
+	Text gem.String
+
+	// ColSpan is the number of columns that the cell takes up. Values less
+	// than 1 are treated as 1.
+	ColSpan int
+
+	// RowSpan is the number of rows that the cell takes up. Values less than 1
+	// are treated as 1.
+	RowSpan int
+}
+
+// placedCell is a cell of a table that has been given its place in the grid of
+// rows and columns of the table.
+type placedCell struct {
+	row     int
+	col     int
+	rowSpan int
+	colSpan int
+
+	// text is the content of the cell as it was given.
+	text gem.String
+
+	// lines is the content of the cell split into the lines that it is laid
+	// out on.
+	lines []gem.String
+}
+
+// tableGrid is the cells of a table placed in the rows and columns that they
+// take up.
+type tableGrid struct {
+	// cells is every cell in the table.
+	cells []*placedCell
+
+	// slots gives the cell that takes up each column of each row.
+	slots [][]*placedCell
+
+	// cols is the number of columns in the table.
+	cols int
+}
+
+// placeCells places the cells of data in a tableGrid. Each row's cells are
+// placed from left to right in the columns that are not already taken up by a
+// cell from a row above it that spans more than one row. A cell that would
+// span past the end of the table, or into a column that is already taken, is
+// shortened so that it does not. If header is set, cells in the first row do
+// not span into the rows below it, and if footer is set, cells in the rows
+// above the last row do not span into it. Columns that no cell is placed in
+// are filled with empty cells.
+func placeCells(data [][]TableCell, header, footer bool) tableGrid {
+	grid := tableGrid{slots: make([][]*placedCell, len(data))}
+
+	for row := range data {
+		col := 0
+		for _, cell := range data[row] {
+			for col < len(grid.slots[row]) && grid.slots[row][col] != nil {
+				col++
+			}
+
+			colSpan := cell.ColSpan
+			if colSpan < 1 {
+				colSpan = 1
+			}
+			for c := col + 1; c < col+colSpan; c++ {
+				if c < len(grid.slots[row]) && grid.slots[row][c] != nil {
+					colSpan = c - col
+					break
+				}
+			}
+
+			rowSpan := cell.RowSpan
+			if rowSpan < 1 || (header && row == 0) {
+				rowSpan = 1
+			}
+			if row+rowSpan > len(data) {
+				rowSpan = len(data) - row
+			}
+			if footer && row < len(data)-1 && row+rowSpan > len(data)-1 {
+				rowSpan = len(data) - 1 - row
+			}
+
+			pc := &placedCell{row: row, col: col, rowSpan: rowSpan, colSpan: colSpan, text: cell.Text, lines: []gem.String{cell.Text}}
+			grid.place(pc)
+			col += colSpan
+		}
+	}
+
+	for row := range grid.slots {
+		if len(grid.slots[row]) > grid.cols {
+			grid.cols = len(grid.slots[row])
+		}
+	}
+	if grid.cols == 0 {
+		return grid
+	}
+
+	for row := range grid.slots {
+		for col := 0; col < grid.cols; col++ {
+			if col >= len(grid.slots[row]) || grid.slots[row][col] == nil {
+				grid.place(&placedCell{row: row, col: col, rowSpan: 1, colSpan: 1, lines: []gem.String{gem.Zero}})
+			}
+		}
+	}
+
+	return grid
+}
+
+// place adds pc to the grid in every slot that it takes up.
+func (grid *tableGrid) place(pc *placedCell) {
+	for row := pc.row; row < pc.row+pc.rowSpan; row++ {
+		for len(grid.slots[row]) < pc.col+pc.colSpan {
+			grid.slots[row] = append(grid.slots[row], nil)
+		}
+		for col := pc.col; col < pc.col+pc.colSpan; col++ {
+			grid.slots[row][col] = pc
+		}
+	}
+	grid.cells = append(grid.cells, pc)
+}
+
+// spanWidth returns the width taken up by count columns starting at index col
+// given the width of each column and the width of what goes between two
+// columns.
+func spanWidth(widths []int, col, count int, between int) int {
+	total := (count - 1) * between
+	for i := col; i < col+count; i++ {
+		total += widths[i]
+	}
+	return total
 }
 
 // MakeTable creates a table from the given slice of rows, where each row
 // is a slice of column content.
 //
+// It is identical to MakeSpannedTable but with every cell taking up a single
+// column and row.
+func MakeTable(data [][]gem.String, width int, lineSep gem.String, header bool, border bool, style TableStyle, opts Options) tb.Block {
+	cells := make([][]TableCell, len(data))
+	for row := range data {
+		cells[row] = make([]TableCell, len(data[row]))
+		for col := range data[row] {
+			cells[row][col] = TableCell{Text: data[row][col]}
+		}
+	}
+	return MakeSpannedTable(cells, width, lineSep, header, border, style, opts)
+}
+
+// MakeSpannedTable creates a table from the given slice of rows, where each row
+// is a slice of cells that can each span more than one column or row.
+//
 // width is the width to make the table. If the content and border options make
 // it so it already meets or exceeds this, no adjustments to table content are
-// made; otherwise, cells are padded to make the full table this wide.
+// made unless opts has TableFit set, in which case the widest columns are
+// narrowed and the cells in them wrapped until the table fits; otherwise, cells
+// are padded to make the full table this wide.
 //
-// headerBreak is whether to have the first row be offset from the others. It
-// will also have centered and upper-case content.
+// headerBreak is whether to have the first row be offset from the others. Its
+// content is cased as given by the TableHeaderCase of opts and is centered if
+// there is a border.
 //
 // lineSep is used to separate lines of output.
 //
-// charSet is string with "<CORNER><VERT><HORZ>" where <CORNER> is the char to
-// use for corner character, <VERT> is the char to use for the vertical char,
-// and <HORZ> is the char to use for the horizontal character.
+// style gives the characters used to draw borders and the rule under the
+// header row. Borders are left out between the columns and rows that a cell
+// spans.
 //
 // border is whether to have a border
-func MakeTable(data [][]gem.String, width int, lineSep gem.String, header bool, border bool, charSet gem.String) tb.Block {
-	const minNonBorderInterColumnPadding = 2
+//
+// opts gives how the content of each cell is measured and the alignment of the
+// content in each column. A cell that spans more than one column is aligned as
+// given for the first column it spans.
+//
+// The table is laid out and then drawn by the TableRenderer of opts, which may
+// change the header, border, and style it is laid out with; see TableRenderer
+// for details.
+func MakeSpannedTable(data [][]TableCell, width int, lineSep gem.String, header bool, border bool, style TableStyle, opts Options) tb.Block {
+	return MakeTableFromSpec(TableSpec{
+		Data:   data,
+		Width:  width,
+		Header: header,
+		Border: border,
+		Style:  style,
+		Fit:    opts.TableFit,
+	}, lineSep, opts)
+}
 
+// MakeTableFromSpec creates a table from spec in the same way as
+// MakeSpannedTable. This allows a table to be given a footer and rules between
+// its rows, which MakeSpannedTable does not.
+func MakeTableFromSpec(spec TableSpec, lineSep gem.String, opts Options) tb.Block {
 	// sanity check table input
-	if len(data) < 1 {
+	if len(spec.Data) < 1 {
 		return tb.New(gem.Zero, lineSep)
 	}
 
-	// find how many columns the final table will have
-	colCount := 0
-	for i := range data {
-		if len(data[i]) > colCount {
-			colCount = len(data[i])
-		}
-	}
+	renderer := opts.tableRenderer()
+	spec = renderer.Prepare(spec, lineSep)
 
-	if colCount == 0 {
+	layout := opts.layoutTable(spec, lineSep)
+	if layout.grid.cols == 0 {
 		// there are no columns so no table to create
 		return tb.New(gem.Zero, lineSep)
 	}
 
-	// if charSet is incomplete, set it to defaults
-	tableChars := parseTableCharSet(charSet)
+	if opts.expands(layout, spec.Width) {
+		return opts.makeExpandedTable(spec, lineSep)
+	}
 
-	// need to calc the length of the widest item in each column
-	colContentWidths := make([]int, colCount)
+	return renderer.Render(layout, lineSep, opts)
+}
 
-	for col := 0; col < colCount; col++ {
-		colContentWidths[col] = 0
+// tableRenderer returns the TableRenderer that tables are drawn with.
+func (opts Options) tableRenderer() TableRenderer {
+	if opts.TableRenderer == nil {
+		return TextRenderer
+	}
+	return opts.TableRenderer
+}
 
-		for row := range data {
-			var content gem.String
-			if col < len(data[row]) {
-				content = data[row][col]
-			}
+// layoutTable places the cells of the table given by spec and finds the width
+// of each of its columns.
+func (opts Options) layoutTable(spec TableSpec, lineSep gem.String) TableLayout {
+	header, border, style, width := spec.Header, spec.Border, spec.Style, spec.Width
+
+	// find the place of each cell and how many columns the final table will
+	// have
+	// a footer needs a row of its own below the others
+	footer := spec.Footer && len(spec.Data) > 1
 
-			strLen := content.Len()
+	grid := placeCells(spec.Data, header, footer)
+
+	if grid.cols == 0 {
+		return TableLayout{grid: grid}
+	}
 
-			if strLen >= colContentWidths[col] {
-				colContentWidths[col] = strLen
+	if spec.CellLines && !lineSep.IsEmpty() {
+		for _, pc := range grid.cells {
+			split := strings.Split(pc.lines[0].String(), lineSep.String())
+			pc.lines = make([]gem.String, len(split))
+			for i := range split {
+				pc.lines[i] = gem.New(split[i])
 			}
 		}
 	}
 
-	// add up the column widths with padding to find how much space it takes
-	// up
-	colContentWithPaddingWidths := make([]int, len(colContentWidths))
-	copy(colContentWithPaddingWidths, colContentWidths)
+	// decimal alignment is done by padding the content of the cells before
+	// anything else, so that the widest of them gives the width of the column
+	opts.alignDecimals(grid, header)
 
-	minTableWidth := 0
+	// a cell that spans columns also gets the space between them
+	contentBetween := minNonBorderInterColumnPadding
 	if border {
-		// pre-add extra space for each min col padding (2) along with the
-		// additional horz border char.
-		minTableWidth = tableChars.horz.Len()
+		contentBetween = 2 + opts.Width(style.Vertical)
 	}
 
-	for i := range colContentWidths {
-		var minPadding int
-		if border {
-			minPadding = 2
-		} else if i+1 < len(colContentWidths) {
-			// all except last column get some padding even at the smallest size
-			minPadding = minNonBorderInterColumnPadding
+	// need to calc the length of the widest item in each column
+	colContentWidths := opts.cellContentWidths(grid, contentBetween)
+	for col := range colContentWidths {
+		if colContentWidths[col] < spec.MinColumnWidth {
+			colContentWidths[col] = spec.MinColumnWidth
 		}
-		colContentWithPaddingWidths[i] += minPadding
-		minTableWidth += colContentWithPaddingWidths[i]
+	}
 
-		if border {
-			minTableWidth += tableChars.horz.Len()
+	// columns with width constraints are given the widths they are constrained
+	// to, and the content of each cell that no longer fits is wrapped
+	if len(opts.TableColumnWidths) > 0 {
+		_, overhead := opts.padColumnWidths(make([]int, grid.cols), border, style)
+		colContentWidths = opts.constrainColumnWidths(colContentWidths, width-overhead)
+		opts.wrapCells(grid, colContentWidths, contentBetween, lineSep)
+	}
+
+	// add up the column widths with padding to find how much space it takes
+	// up
+	colContentWithPaddingWidths, minTableWidth := opts.padColumnWidths(colContentWidths, border, style)
+
+	// if the table does not fit and fitting is enabled, narrow the widest
+	// columns and wrap the cells in them that no longer fit
+	if spec.Fit && minTableWidth > width {
+		fitWidths := opts.fitColumnWidths(grid, header, colContentWidths, minTableWidth-width)
+		opts.wrapCells(grid, fitWidths, contentBetween, lineSep)
+
+		colContentWidths = opts.cellContentWidths(grid, contentBetween)
+		for col := range colContentWidths {
+			if opts.columnWidth(col) != (ColumnWidth{}) {
+				colContentWidths[col] = fitWidths[col]
+			}
 		}
+		colContentWithPaddingWidths, minTableWidth = opts.padColumnWidths(colContentWidths, border, style)
 	}
 
 	// now calculate actual target column widths (including full padding)
-	colWidths := make([]int, colCount)
+	colWidths := make([]int, grid.cols)
 	// start with the min content padded widths
 	copy(colWidths, colContentWithPaddingWidths)
 
@@ -126,120 +500,658 @@ func MakeTable(data [][]gem.String, width int, lineSep gem.String, header bool,
 		// the exception to the above is the single-column table; in that case,
 		// all space is added to the first column.
 
-		numColumnsToSpace := colCount
-		if !border && colCount > 1 {
+		//
+		// columns with width constraints only take as much of it as they
+		// allow, so the table may end up narrower than the width.
+
+		numColumnsToSpace := grid.cols
+		if !border && grid.cols > 1 {
 			numColumnsToSpace--
 		}
 
-		spacePerColumn := spaceToAdd / numColumnsToSpace
-		remSpace := spaceToAdd % numColumnsToSpace
-		for i := range colWidths[:numColumnsToSpace] {
-			colWidths[i] += spacePerColumn
-			if i < remSpace {
-				colWidths[i]++
-			}
-		}
+		added := opts.spreadSpace(colWidths[:numColumnsToSpace], colContentWidths[:numColumnsToSpace], spaceToAdd)
+		width = minTableWidth + added
 	} else {
 		width = minTableWidth
 	}
 
-	// now we have our table widths and can begin building the table
-	tableBlock := buildTable(data, colWidths, width, lineSep, header, border, tableChars)
-
-	return tableBlock
+	return TableLayout{
+		grid:      grid,
+		ColWidths: colWidths,
+		Width:     width,
+		Header:    header,
+		Footer:    footer,
+		Rules:     spec.Rules,
+		Border:    border,
+		Style:     style,
+	}
 }
 
-func parseTableCharSet(charSet gem.String) tableCharSet {
-	if charSet.Len() < 3 {
-		defaultSet := gem.New("+|-")
-		toAdd := defaultSet.Sub(0, 3-charSet.Len())
-		charSet = charSet.Add(toAdd)
-	} else if charSet.Len() > 3 {
-		charSet = charSet.Sub(0, 3)
+// padColumnWidths returns the width of each column of a table once the
+// least padding that it has is added to its content width, along with the
+// width of the narrowest table that the columns can be laid out in.
+func (opts Options) padColumnWidths(colContentWidths []int, border bool, style TableStyle) ([]int, int) {
+	colContentWithPaddingWidths := make([]int, len(colContentWidths))
+	copy(colContentWithPaddingWidths, colContentWidths)
+
+	minTableWidth := 0
+	if border {
+		// pre-add extra space for each min col padding (2) along with the
+		// additional horz border char.
+		minTableWidth = opts.Width(style.Vertical)
 	}
-	return tableCharSet{
-		corner: charSet.Sub(0, 1),
-		vert:   charSet.Sub(1, 2),
-		horz:   charSet.Sub(2, 3),
+
+	for i := range colContentWidths {
+		var minPadding int
+		if border {
+			minPadding = 2
+		} else if i+1 < len(colContentWidths) {
+			// all except last column get some padding even at the smallest size
+			minPadding = minNonBorderInterColumnPadding
+		}
+		colContentWithPaddingWidths[i] += minPadding
+		minTableWidth += colContentWithPaddingWidths[i]
+
+		if border {
+			minTableWidth += opts.Width(style.Vertical)
+		}
 	}
+
+	return colContentWithPaddingWidths, minTableWidth
 }
 
-func buildTable(data [][]gem.String, colWidths []int, width int, lineSep gem.String, header bool, border bool, chars tableCharSet) tb.Block {
-	tableBlock := tb.New(gem.Zero, lineSep)
+// cellContentWidths returns the width of the widest line of content in each
+// column of grid. Cells that span more than one column are given the width of
+// the columns they span along with between for the space between each of them,
+// and if that is not enough, the extra width they need is shared among those
+// columns. Cells that span fewer columns have this done first.
+func (opts Options) cellContentWidths(grid tableGrid, between int) []int {
+	widths := make([]int, grid.cols)
+	var spanning []*placedCell
+	for _, pc := range grid.cells {
+		if pc.colSpan > 1 {
+			spanning = append(spanning, pc)
+			continue
+		}
+		if w := opts.linesWidth(pc.lines); w > widths[pc.col] {
+			widths[pc.col] = w
+		}
+	}
 
-	// build top border if needed
-	var horzBar gem.String
-	if border {
-		horzBar = chars.corner
-		for i := range colWidths {
-			for j := 0; j < colWidths[i]; j++ {
-				horzBar = horzBar.Add(chars.horz)
+	sort.SliceStable(spanning, func(i, j int) bool {
+		return spanning[i].colSpan < spanning[j].colSpan
+	})
+	for _, pc := range spanning {
+		extra := opts.linesWidth(pc.lines) - spanWidth(widths, pc.col, pc.colSpan, between)
+		if extra <= 0 {
+			continue
+		}
+		for i := 0; i < pc.colSpan; i++ {
+			widths[pc.col+i] += extra / pc.colSpan
+			if i < extra%pc.colSpan {
+				widths[pc.col+i]++
 			}
-			horzBar = horzBar.Add(chars.corner)
 		}
+	}
 
-		tableBlock.Append(horzBar)
+	return widths
+}
+
+// linesWidth returns the width of the widest of lines.
+func (opts Options) linesWidth(lines []gem.String) int {
+	widest := 0
+	for _, line := range lines {
+		if w := opts.Width(line); w > widest {
+			widest = w
+		}
 	}
+	return widest
+}
 
-	var nonBorderBreakBar gem.String
-	if header && !border {
-		nonBorderBreakBar = gem.Zero
-		for i := 0; i < width; i++ {
-			nonBorderBreakBar = nonBorderBreakBar.Add(chars.horz)
+// fitColumnWidths returns the content widths that the columns of the table in
+// grid must be narrowed to in order to make it overflow narrower. The widest
+// column that can still be narrowed is narrowed one at a time until it is
+// overflow narrower. A column can be narrowed down to its minimum width in
+// opts, or to minFitColumnWidth if it has none, but columns that are not
+// allowed to wrap, columns with decimal alignment, and columns whose width is
+// set exactly by their width constraint are never narrowed, and columns with
+// right alignment or numeric content are never narrowed past their widest
+// word so that no number is split across lines. If the table cannot be made
+// overflow narrower, no column is narrowed at all.
+func (opts Options) fitColumnWidths(grid tableGrid, header bool, colContentWidths []int, overflow int) []int {
+	widths := make([]int, len(colContentWidths))
+	copy(widths, colContentWidths)
+
+	floors := make([]int, len(widths))
+	for col := range widths {
+		floors[col] = widths[col]
+		cw := opts.columnWidth(col)
+		if opts.isNoWrapColumn(col) || opts.columnAlignment(col) == ColumnDecimal || cw.isSet() {
+			continue
+		}
+
+		floor := minFitColumnWidth
+		if col < len(opts.TableMinWidths) && opts.TableMinWidths[col] > floor {
+			floor = opts.TableMinWidths[col]
+		}
+		if cw.Min > floor {
+			floor = cw.Min
+		}
+		if opts.columnAlignment(col) == ColumnRight || opts.isNumericColumn(grid, header, col) {
+			if w := opts.widestWord(grid, col); w > floor {
+				floor = w
+			}
+		}
+		if floor < floors[col] {
+			floors[col] = floor
 		}
 	}
 
-	// layout all lines
-	for row := range data {
-		line := gem.Zero
-		if border {
-			line = chars.vert
+	narrowest := 0
+	for col := range widths {
+		narrowest += widths[col] - floors[col]
+	}
+	if narrowest < overflow {
+		return widths
+	}
+
+	for overflow > 0 {
+		widest := -1
+		for col := range widths {
+			if widths[col] > floors[col] && (widest == -1 || widths[col] > widths[widest]) {
+				widest = col
+			}
 		}
+		widths[widest]--
+		overflow--
+	}
 
-		var cellContent gem.String
-		for col := 0; col < len(colWidths); col++ {
-			var cellData gem.String
-			if col < len(data[row]) {
-				cellData = data[row][col]
+	return widths
+}
+
+// isNumericColumn returns whether every cell in column col of grid that only
+// takes up that column is either empty or holds a number. The header is not
+// checked.
+func (opts Options) isNumericColumn(grid tableGrid, header bool, col int) bool {
+	numeric := false
+	for _, pc := range grid.cells {
+		if pc.col != col || pc.colSpan > 1 || (header && pc.row == 0) {
+			continue
+		}
+		for _, line := range pc.lines {
+			if opts.ANSIEscapes {
+				line = line.StripEscapes()
 			}
+			text := strings.TrimSpace(line.String())
+			if text == "" {
+				continue
+			}
+			if !isNumber(text) {
+				return false
+			}
+			numeric = true
+		}
+	}
+	return numeric
+}
 
-			if row == 0 && header {
-				headerContent := gem.New(strings.ToUpper(cellData.String()))
-				if border {
-					cellContent = AlignLineCenter(headerContent, colWidths[col])
-					cellContent = cellContent.Add(chars.vert)
-				} else {
-					cellContent = AlignLineLeft(headerContent, colWidths[col])
-				}
-			} else {
-				if border {
-					cellContent = AlignLineLeft(cellData, colWidths[col]-1)
-					cellContent = gem.New(" ").Add(cellContent).Add(chars.vert)
-				} else {
-					cellContent = AlignLineLeft(cellData, colWidths[col])
+// isNumber returns whether text is a number, with an optional sign, digit
+// group separators, decimal point, and percent sign.
+func isNumber(text string) bool {
+	digits := false
+	for i, ch := range text {
+		switch {
+		case unicode.IsDigit(ch):
+			digits = true
+		case (ch == '-' || ch == '+') && i == 0:
+		case ch == '.' || ch == ',':
+		case ch == '%' && i == len(text)-1:
+		default:
+			return false
+		}
+	}
+	return digits
+}
+
+// widestWord returns the width of the widest word in the cells in column col
+// of grid that only take up that column.
+func (opts Options) widestWord(grid tableGrid, col int) int {
+	widest := 0
+	for _, pc := range grid.cells {
+		if pc.col != col || pc.colSpan > 1 {
+			continue
+		}
+		for _, line := range pc.lines {
+			for _, word := range opts.splitWords(line) {
+				if w := opts.Width(gem.New(word)); w > widest {
+					widest = w
 				}
 			}
-			line = line.Add(cellContent)
 		}
+	}
+	return widest
+}
+
+// wrapCells wraps every line of content of the cells in grid that is wider
+// than the width given for it by widths. A cell that spans more than one
+// column is given the width of those columns with between for the space
+// between each of them.
+func (opts Options) wrapCells(grid tableGrid, widths []int, between int, lineSep gem.String) {
+	for _, pc := range grid.cells {
+		cellWidth := spanWidth(widths, pc.col, pc.colSpan, between)
+
+		var wrapped []gem.String
+		for _, line := range pc.lines {
+			if opts.Width(line) <= cellWidth {
+				wrapped = append(wrapped, line)
+				continue
+			}
+			wrapped = append(wrapped, Wrap(line, cellWidth, lineSep, opts).Lines...)
+		}
+		pc.lines = wrapped
+	}
+}
+
+// isNoWrapColumn returns whether the column at index col is one that opts
+// does not allow to be wrapped.
+func (opts Options) isNoWrapColumn(col int) bool {
+	for _, noWrap := range opts.TableNoWrapColumns {
+		if noWrap == col {
+			return true
+		}
+	}
+	return false
+}
+
+// textKind is the kind of text table that a textRenderer draws.
+type textKind int
+
+const (
+	// textPlain is a table drawn with the header, border, and style it is
+	// given.
+	textPlain textKind = iota
+
+	// textMarkdown is a GitHub-flavored Markdown pipe table.
+	textMarkdown
+
+	// textRSTGrid is a reStructuredText grid table.
+	textRSTGrid
+
+	// textRSTSimple is a reStructuredText simple table.
+	textRSTSimple
+)
+
+// textRenderer is a TableRenderer that draws tables as fixed-width text.
+type textRenderer struct {
+	kind textKind
+}
+
+// Prepare returns spec as it is for a plain text table. A Markdown table
+// always has a header and borders, is drawn with markdownStyle, and has cells
+// that do not span and are not wrapped. A reStructuredText grid table always
+// has borders and is drawn with rstGridStyle. A reStructuredText simple table
+// never has borders and has cells as given by rstSimpleCells. The cells of
+// both kinds of reStructuredText table are split into lines at each lineSep
+// in them. Neither Markdown nor reStructuredText tables have a footer or rules
+// between their rows, so the footer is drawn as any other row.
+func (r textRenderer) Prepare(spec TableSpec, lineSep gem.String) TableSpec {
+	switch r.kind {
+	case textMarkdown:
+		spec.Data = markdownCells(spec.Data, lineSep)
+		spec.Header, spec.Border, spec.Style = true, true, markdownStyle
+		spec.Footer, spec.Rules = false, nil
+		spec.Fit = false
+		// the rule under the header needs room for at least one "-" between
+		// its alignment markers
+		spec.MinColumnWidth = 1
+	case textRSTGrid:
+		spec.Border, spec.Style = true, rstGridStyle
+		spec.Footer, spec.Rules = false, nil
+		spec.CellLines = true
+	case textRSTSimple:
+		spec.Data = rstSimpleCells(spec.Data, lineSep)
+		spec.Border = false
+		spec.Footer, spec.Rules = false, nil
+		spec.MinColumnWidth = 1
+		spec.CellLines = true
+	}
+	return spec
+}
+
+// Render draws the table given by layout as lines of text.
+func (r textRenderer) Render(layout TableLayout, lineSep gem.String, opts Options) tb.Block {
+	grid, colWidths, header, border, style := layout.grid, layout.ColWidths, layout.Header, layout.Border, layout.Style
+	tableBlock := tb.New(gem.Zero, lineSep)
+	rowCount := len(grid.slots)
+
+	// build top border if needed
+	if border && style.hasTop() {
+		tableBlock.Append(grid.rule(colWidths, -1, 0, style.TopLeft, style.Top, style.TopJunction, style.TopRight, style.TopJunction, style.TopJunction))
+	}
+	if r.kind == textRSTSimple {
+		tableBlock.Append(rstSimpleRule(colWidths))
+	}
+
+	var nonBorderBreakBar gem.String
+	if (header || layout.Footer) && !border {
+		nonBorderBreakBar = gem.RepeatStr(style.HeaderHorizontal.String(), layout.Width)
+		if r.kind == textRSTSimple {
+			nonBorderBreakBar = rstSimpleRule(colWidths)
+		}
+	}
+
+	// a row is as many lines tall as the cell in it with the most lines, and
+	// the last row that a cell spans is made taller if the cell has more lines
+	// than fit in the rows it spans
+	rowHeights := make([]int, rowCount)
+	for row := range rowHeights {
+		rowHeights[row] = 1
+	}
+	for _, pc := range grid.cells {
+		if pc.rowSpan == 1 && len(pc.lines) > rowHeights[pc.row] {
+			rowHeights[pc.row] = len(pc.lines)
+		}
+	}
+	for _, pc := range grid.cells {
+		if pc.rowSpan > 1 {
+			lastRow := pc.row + pc.rowSpan - 1
+			if extra := len(pc.lines) - spanWidth(rowHeights, pc.row, pc.rowSpan, 0); extra > 0 {
+				rowHeights[lastRow] += extra
+			}
+		}
+	}
 
-		tableBlock.Append(line)
+	// layout all lines
+	for row := 0; row < rowCount; row++ {
+		for lineIdx := 0; lineIdx < rowHeights[row]; lineIdx++ {
+			// Markdown headers are aligned like their columns, as the rule
+			// under them gives
+			centerHeader := border && r.kind != textMarkdown
+			tableBlock.Append(buildTableLine(grid, row, lineIdx, rowHeights, colWidths, row == 0 && header, centerHeader, border, style, opts))
+		}
 
 		if row == 0 && header {
-			if border {
+			if r.kind == textMarkdown {
+				// a Markdown table needs the rule even with no other rows
+				tableBlock.Append(opts.markdownRule(colWidths))
+			} else if border {
 				// do this in a bordered table ONLY if there are more elements
-				if len(data) > 1 {
-					tableBlock.Append(horzBar)
+				if rowCount > 1 {
+					down, up := style.headerJunctions()
+					tableBlock.Append(grid.rule(colWidths, 0, 1, style.HeaderLeft, style.HeaderHorizontal, style.HeaderCross, style.HeaderRight, down, up))
 				}
 			} else {
 				tableBlock.Append(nonBorderBreakBar)
 			}
+		} else if r.kind == textRSTGrid && row+1 < rowCount {
+			// each row of a grid table is closed off from the next
+			tableBlock.Append(grid.rowRule(colWidths, row, style))
+		} else if layout.Footer && row+2 == rowCount {
+			// the footer is set off from the rows above it the same way
+			// that the header is set off from the rows below it
+			if border {
+				down, up := style.headerJunctions()
+				tableBlock.Append(grid.rule(colWidths, row, row+1, style.HeaderLeft, style.HeaderHorizontal, style.HeaderCross, style.HeaderRight, down, up))
+			} else {
+				tableBlock.Append(nonBorderBreakBar)
+			}
+		} else if layout.ruleUnder(row) {
+			if border {
+				tableBlock.Append(grid.rowRule(colWidths, row, style))
+			} else {
+				tableBlock.Append(gem.RepeatStr(style.Horizontal.String(), layout.Width))
+			}
 		}
 	}
 
 	// build bottom border if needed
-	if border {
-		tableBlock.Append(horzBar)
+	if border && style.hasBottom() {
+		tableBlock.Append(grid.rule(colWidths, rowCount-1, -1, style.BottomLeft, style.Bottom, style.BottomJunction, style.BottomRight, style.BottomJunction, style.BottomJunction))
+	}
+	if r.kind == textRSTSimple {
+		tableBlock.Append(rstSimpleRule(colWidths))
 	}
 
 	return tableBlock
 }
+
+// buildTableLine builds the line at index lineIdx of the row at index row of
+// grid. A cell that spans more than one row continues onto the lines of the
+// rows after its first, and a cell with fewer lines than that is empty on the
+// line. If isHeader and centerHeader are both set, the content of each cell is
+// centered in it instead of being aligned as given by opts.
+func buildTableLine(grid tableGrid, row int, lineIdx int, rowHeights []int, colWidths []int, isHeader bool, centerHeader bool, border bool, style TableStyle, opts Options) gem.String {
+	line := gem.Zero
+	between := 0
+	if border {
+		line = style.Vertical
+		between = opts.Width(style.Vertical)
+	}
+
+	var cellContent gem.String
+	for col := 0; col < len(colWidths); col += grid.slots[row][col].colSpan {
+		pc := grid.slots[row][col]
+		cellWidth := spanWidth(colWidths, col, pc.colSpan, between)
+
+		// in a table without borders, every column but the last ends with
+		// the space that separates it from the next
+		pad := 0
+		if !border && col+pc.colSpan < len(colWidths) {
+			pad = minNonBorderInterColumnPadding
+		}
+
+		// a cell that started in a row above continues from where it left off
+		var cellData gem.String
+		cellLineIdx := lineIdx
+		for r := pc.row; r < row; r++ {
+			cellLineIdx += rowHeights[r]
+		}
+		if cellLineIdx < len(pc.lines) {
+			cellData = pc.lines[cellLineIdx]
+		}
+
+		// styling in a cell must not leak into the rest of the table
+		cellData = opts.closeStyles(cellData)
+
+		if isHeader {
+			cellData = opts.headerCase(cellData)
+		}
+		if isHeader && centerHeader {
+			cellContent = AlignLineCenter(cellData, cellWidth, opts)
+			cellContent = cellContent.Add(style.Vertical)
+		} else if border {
+			cellContent = opts.alignCell(cellData, col, cellWidth-2, pad)
+			cellContent = gem.New(" ").Add(cellContent).Add(gem.New(" ")).Add(style.Vertical)
+		} else {
+			cellContent = opts.alignCell(cellData, col, cellWidth, pad)
+		}
+		line = line.Add(cellContent)
+	}
+
+	return line
+}
+
+// markdownCells returns the cells of data as they are put in a Markdown table.
+// Each "|" in the text of a cell is escaped, each lineSep is replaced with a
+// "<br>" tag, and no cell spans more than one column or row, as Markdown
+// tables do not support them.
+func markdownCells(data [][]TableCell, lineSep gem.String) [][]TableCell {
+	cells := make([][]TableCell, len(data))
+	for row := range data {
+		cells[row] = make([]TableCell, len(data[row]))
+		for col := range data[row] {
+			text := strings.ReplaceAll(data[row][col].Text.String(), "|", "\\|")
+			if !lineSep.IsEmpty() {
+				text = strings.ReplaceAll(text, lineSep.String(), "<br>")
+			}
+			cells[row][col] = TableCell{Text: gem.New(text)}
+		}
+	}
+	return cells
+}
+
+// markdownRule draws the rule under the header of a Markdown table with
+// columns of the given widths. Each column of the rule has a ":" at the side
+// that the column is aligned to, or at both sides if it is centered. Columns
+// that are not given an alignment in opts have no ":".
+func (opts Options) markdownRule(colWidths []int) gem.String {
+	var sb strings.Builder
+	sb.WriteRune('|')
+	for col := range colWidths {
+		left, right := "-", "-"
+		if col < len(opts.TableAlignments) {
+			switch opts.TableAlignments[col] {
+			case ColumnLeft:
+				left = ":"
+			case ColumnRight, ColumnDecimal:
+				right = ":"
+			case ColumnCenter:
+				left, right = ":", ":"
+			}
+		}
+		sb.WriteString(left)
+		sb.WriteString(strings.Repeat("-", colWidths[col]-2))
+		sb.WriteString(right)
+		sb.WriteRune('|')
+	}
+	return gem.New(sb.String())
+}
+
+// columnAlignment returns the alignment of the column at index col. Columns
+// that are not given an alignment in opts are aligned to the left.
+func (opts Options) columnAlignment(col int) ColumnAlignment {
+	if col < len(opts.TableAlignments) {
+		return opts.TableAlignments[col]
+	}
+	return ColumnLeft
+}
+
+// alignCell aligns the content of a cell in the column at index col to fill
+// width. The last pad spaces of width are the space that separates the cell
+// from the next one and are kept at the end of the cell regardless of
+// alignment. Content in a column with decimal alignment must already have been
+// padded by alignDecimals.
+func (opts Options) alignCell(text gem.String, col int, width int, pad int) gem.String {
+	switch opts.columnAlignment(col) {
+	case ColumnRight:
+		text = AlignLineRight(text, width-pad, opts)
+	case ColumnCenter:
+		text = AlignLineCenter(text, width-pad, opts)
+	case ColumnDecimal:
+		// the padding that lines up the decimal points is whitespace that
+		// must be kept, so the content is not trimmed as it is for the others
+		if extra := width - pad - opts.Width(text); extra > 0 {
+			text = gem.RepeatStr(" ", extra).Add(text)
+		}
+	default:
+		return AlignLineLeft(text, width, opts)
+	}
+
+	if extra := width - opts.Width(text); extra > 0 {
+		text = text.Add(gem.RepeatStr(" ", extra))
+	}
+	return text
+}
+
+// alignDecimals pads the content of every cell in grid that is in a column
+// with decimal alignment with spaces on both sides so that the decimal points
+// of all of them are at the same position. Cells in the first row are not
+// changed if header is set, and neither are empty cells or cells that span
+// more than one column.
+func (opts Options) alignDecimals(grid tableGrid, header bool) {
+	for col := 0; col < grid.cols; col++ {
+		if opts.columnAlignment(col) != ColumnDecimal {
+			continue
+		}
+
+		var cells []*placedCell
+		for _, pc := range grid.cells {
+			if pc.col != col || pc.colSpan > 1 || (header && pc.row == 0) || pc.lines[0].IsEmpty() {
+				continue
+			}
+			cells = append(cells, pc)
+		}
+
+		// find the widest parts before and after the decimal point
+		intWidth, fracWidth := 0, 0
+		for _, pc := range cells {
+			intPart, fracPart := opts.splitDecimal(pc.lines[0])
+			if w := opts.Width(intPart); w > intWidth {
+				intWidth = w
+			}
+			if w := opts.Width(fracPart); w > fracWidth {
+				fracWidth = w
+			}
+		}
+
+		for _, pc := range cells {
+			intPart, fracPart := opts.splitDecimal(pc.lines[0])
+			lead := gem.RepeatStr(" ", intWidth-opts.Width(intPart))
+			trail := gem.RepeatStr(" ", fracWidth-opts.Width(fracPart))
+			pc.lines[0] = lead.Add(intPart).Add(fracPart).Add(trail)
+		}
+	}
+}
+
+// splitDecimal splits the content of a cell with decimal alignment into the
+// part before its decimal point and the part that starts with it, after
+// removing any whitespace around the content. If there is no decimal point, the
+// second part is empty.
+func (opts Options) splitDecimal(text gem.String) (gem.String, gem.String) {
+	start := CountLeadingWhitespace(text)
+	end := text.Len() - CountTrailingWhitespace(text)
+	if start >= end {
+		return gem.Zero, gem.Zero
+	}
+	text = text.Sub(start, end)
+
+	point := text.IndexFunc(func(ch []rune) bool {
+		return len(ch) == 1 && ch[0] == '.'
+	})
+	if point == -1 {
+		return text, gem.Zero
+	}
+	return text.Sub(0, point), text.Sub(point, text.Len())
+}
+
+// headerCase returns text with its letters cased as given by the
+// TableHeaderCase of opts. Escape sequences are left as they are.
+func (opts Options) headerCase(text gem.String) gem.String {
+	switch opts.TableHeaderCase {
+	case HeaderAsIs:
+		return text
+	case HeaderLower:
+		return opts.mapChars(text, func(ch string, wordStart bool) string {
+			return strings.ToLower(ch)
+		})
+	case HeaderTitle:
+		return opts.mapChars(text, func(ch string, wordStart bool) string {
+			if wordStart {
+				return strings.ToUpper(ch)
+			}
+			return ch
+		})
+	default:
+		return opts.toUpper(text)
+	}
+}
+
+// mapChars returns text with every grapheme cluster in it replaced by the
+// result of calling fn on it. wordStart is whether the grapheme cluster is the
+// first one after whitespace or at the start of text. Escape sequences are not
+// passed to fn.
+func (opts Options) mapChars(text gem.String, fn func(ch string, wordStart bool) string) gem.String {
+	var sb strings.Builder
+	wordStart := true
+	for i := 0; i < text.Len(); i++ {
+		if escLen := opts.escapeAt(text, i); escLen > 0 {
+			sb.WriteString(text.Sub(i, i+escLen).String())
+			i += escLen - 1
+			continue
+		}
+		ch := text.CharAt(i)
+		sb.WriteString(fn(string(ch), wordStart))
+		wordStart = unicode.IsSpace(ch[0])
+	}
+	return gem.New(sb.String())
+}
@@ -1,6 +1,7 @@
 package manip
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/dekarrin/rosed/internal/gem"
@@ -17,6 +18,8 @@ func Test_MakeTable(t *testing.T) {
 		header  bool
 		border  bool
 		charSet gem.String
+		style   *TableStyle
+		opts    Options
 		expect  tb.Block
 	}{
 		{
@@ -821,16 +824,1920 @@ func Test_MakeTable(t *testing.T) {
 				LineSeparator: gem.New("\n"),
 			},
 		},
+		{
+			name: "wide chars with display width",
+			table: [][]gem.String{
+				{gem.New("名前"), gem.New("Class")},
+				{gem.New("John"), gem.New("Heir")},
+				{gem.New("ローズ"), gem.New("Seer")},
+			},
+			width:   20,
+			lineSep: gem.New("\n"),
+			header:  true,
+			border:  true,
+			charSet: gem.New("+|-"),
+			opts:    Options{DisplayWidth: true},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("+---------+--------+"),
+					gem.New("|   名前  |  CLASS |"),
+					gem.New("+---------+--------+"),
+					gem.New("| John    | Heir   |"),
+					gem.New("| ローズ  | Seer   |"),
+					gem.New("+---------+--------+"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "styled cells with ANSI escapes",
+			table: [][]gem.String{
+				{gem.New("\x1b[1mName\x1b[0m"), gem.New("Class")},
+				{gem.New("\x1b[32mJohn"), gem.New("Heir")},
+				{gem.New("Rose"), gem.New("\x1b[35mSeer\x1b[0m")},
+			},
+			width:   0,
+			lineSep: gem.New("\n"),
+			header:  true,
+			border:  true,
+			charSet: gem.New("+|-"),
+			opts:    Options{ANSIEscapes: true},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("+------+-------+"),
+					gem.New("| \x1b[1mNAME\x1b[0m | CLASS |"),
+					gem.New("+------+-------+"),
+					gem.New("| \x1b[32mJohn\x1b[0m | Heir  |"),
+					gem.New("| Rose | \x1b[35mSeer\x1b[0m  |"),
+					gem.New("+------+-------+"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "right and center aligned columns without borders",
+			table: [][]gem.String{
+				{gem.New("Name"), gem.New("Qty"), gem.New("Note")},
+				{gem.New("Pen"), gem.New("3"), gem.New("ok")},
+				{gem.New("Notebook"), gem.New("12"), gem.New("low")},
+			},
+			width:   0,
+			lineSep: gem.New("\n"),
+			header:  false,
+			border:  false,
+			charSet: gem.New("+|-"),
+			opts:    Options{TableAlignments: []ColumnAlignment{ColumnLeft, ColumnRight, ColumnCenter}},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("Name      Qty  Note"),
+					gem.New("Pen         3   ok "),
+					gem.New("Notebook   12   low"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "decimal aligned column with borders",
+			table: [][]gem.String{
+				{gem.New("Cost")},
+				{gem.New("1.5")},
+				{gem.New("10.25")},
+				{gem.New("100")},
+				{gem.New("")},
+			},
+			width:   0,
+			lineSep: gem.New("\n"),
+			header:  true,
+			border:  true,
+			charSet: gem.New("+|-"),
+			opts:    Options{TableAlignments: []ColumnAlignment{ColumnDecimal}},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("+--------+"),
+					gem.New("|  COST  |"),
+					gem.New("+--------+"),
+					gem.New("|   1.5  |"),
+					gem.New("|  10.25 |"),
+					gem.New("| 100    |"),
+					gem.New("|        |"),
+					gem.New("+--------+"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "title case headers",
+			table: [][]gem.String{
+				{gem.New("unit price"), gem.New("qty")},
+				{gem.New("a"), gem.New("b")},
+			},
+			width:   0,
+			lineSep: gem.New("\n"),
+			header:  true,
+			border:  false,
+			charSet: gem.New("+|-"),
+			opts:    Options{TableHeaderCase: HeaderTitle},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("Unit Price  Qty"),
+					gem.New("---------------"),
+					gem.New("a           b  "),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "lower case headers",
+			table: [][]gem.String{
+				{gem.New("ID"), gem.New("Name")},
+				{gem.New("1"), gem.New("A")},
+			},
+			width:   0,
+			lineSep: gem.New("\n"),
+			header:  true,
+			border:  false,
+			charSet: gem.New("+|-"),
+			opts:    Options{TableHeaderCase: HeaderLower},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("id  name"),
+					gem.New("--------"),
+					gem.New("1   A   "),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "headers as given",
+			table: [][]gem.String{
+				{gem.New("mIxEd")},
+				{gem.New("x")},
+			},
+			width:   0,
+			lineSep: gem.New("\n"),
+			header:  true,
+			border:  false,
+			charSet: gem.New("+|-"),
+			opts:    Options{TableHeaderCase: HeaderAsIs},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("mIxEd"),
+					gem.New("-----"),
+					gem.New("x    "),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "fit to width without borders",
+			table: [][]gem.String{
+				{gem.New("id"), gem.New("the quick brown fox"), gem.New("jumps over")},
+				{gem.New("1"), gem.New("lazy dogs"), gem.New("x")},
+			},
+			width:   24,
+			lineSep: gem.New("\n"),
+			header:  false,
+			border:  false,
+			charSet: gem.New("+|-"),
+			opts:    Options{TableFit: true},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("id    the quick    jumps"),
+					gem.New("      brown fox    over "),
+					gem.New("1     lazy dogs    x    "),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "fit to width with borders",
+			table: [][]gem.String{
+				{gem.New("id"), gem.New("the quick brown fox"), gem.New("jumps over")},
+				{gem.New("1"), gem.New("lazy dogs"), gem.New("x")},
+			},
+			width:   24,
+			lineSep: gem.New("\n"),
+			header:  false,
+			border:  true,
+			charSet: gem.New("+|-"),
+			opts:    Options{TableFit: true},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("+-----+--------+-------+"),
+					gem.New("| id  | the    | jumps |"),
+					gem.New("|     | quick  | over  |"),
+					gem.New("|     | brown  |       |"),
+					gem.New("|     | fox    |       |"),
+					gem.New("| 1   | lazy   | x     |"),
+					gem.New("|     | dogs   |       |"),
+					gem.New("+-----+--------+-------+"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "fit does not wrap no-wrap columns",
+			table: [][]gem.String{
+				{gem.New("id"), gem.New("the quick brown fox"), gem.New("jumps over")},
+				{gem.New("1"), gem.New("lazy dogs"), gem.New("x")},
+			},
+			width:   30,
+			lineSep: gem.New("\n"),
+			header:  false,
+			border:  false,
+			charSet: gem.New("+|-"),
+			opts:    Options{TableFit: true, TableNoWrapColumns: []int{1}},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("id  the quick brown fox  jumps"),
+					gem.New("                         over "),
+					gem.New("1   lazy dogs            x    "),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "fit does not narrow a table that cannot be made to fit",
+			table: [][]gem.String{
+				{gem.New("id"), gem.New("the quick brown fox"), gem.New("jumps over")},
+				{gem.New("1"), gem.New("lazy dogs"), gem.New("x")},
+			},
+			width:   20,
+			lineSep: gem.New("\n"),
+			header:  false,
+			border:  false,
+			charSet: gem.New("+|-"),
+			opts:    Options{TableFit: true, TableNoWrapColumns: []int{1}},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("id  the quick brown fox  jumps over"),
+					gem.New("1   lazy dogs            x         "),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "fit does not split numbers",
+			table: [][]gem.String{
+				{gem.New("name"), gem.New("count"), gem.New("total")},
+				{gem.New("the quick brown fox"), gem.New("22222"), gem.New("1,234.50")},
+				{gem.New("lazy dogs"), gem.New("7"), gem.New("12")},
+			},
+			width:   22,
+			lineSep: gem.New("\n"),
+			header:  true,
+			border:  false,
+			charSet: gem.New("+|-"),
+			opts:    Options{TableFit: true},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("NAME   COUNT  TOTAL   "),
+					gem.New("----------------------"),
+					gem.New("the    22222  1,234.50"),
+					gem.New("quick                 "),
+					gem.New("brown                 "),
+					gem.New("fox                   "),
+					gem.New("lazy   7      12      "),
+					gem.New("dogs                  "),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "fit does not narrow columns past minimum widths",
+			table: [][]gem.String{
+				{gem.New("id"), gem.New("the quick brown fox"), gem.New("jumps over")},
+				{gem.New("1"), gem.New("lazy dogs"), gem.New("x")},
+			},
+			width:   20,
+			lineSep: gem.New("\n"),
+			header:  false,
+			border:  false,
+			charSet: gem.New("+|-"),
+			opts:    Options{TableFit: true, TableMinWidths: []int{0, 9, 5}},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("id  the quick  jumps"),
+					gem.New("    brown fox  over "),
+					gem.New("1   lazy dogs  x    "),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "too wide without fit",
+			table: [][]gem.String{
+				{gem.New("id"), gem.New("the quick brown fox"), gem.New("jumps over")},
+				{gem.New("1"), gem.New("lazy dogs"), gem.New("x")},
+			},
+			width:   10,
+			lineSep: gem.New("\n"),
+			header:  false,
+			border:  false,
+			charSet: gem.New("+|-"),
+			opts:    Options{},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("id  the quick brown fox  jumps over"),
+					gem.New("1   lazy dogs            x         "),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "box-drawing style with header rule",
+			table: [][]gem.String{
+				{gem.New("a"), gem.New("b")},
+				{gem.New("1"), gem.New("2")},
+			},
+			width:   0,
+			lineSep: gem.New("\n"),
+			header:  true,
+			border:  true,
+			style: &TableStyle{
+				TopLeft: gem.New("┌"), Top: gem.New("─"), TopJunction: gem.New("┬"), TopRight: gem.New("┐"),
+				Vertical:     gem.New("│"),
+				LeftJunction: gem.New("├"), Horizontal: gem.New("─"), Cross: gem.New("┼"), RightJunction: gem.New("┤"),
+				HeaderLeft: gem.New("╞"), HeaderHorizontal: gem.New("═"), HeaderCross: gem.New("╪"), HeaderRight: gem.New("╡"),
+				BottomLeft: gem.New("└"), Bottom: gem.New("─"), BottomJunction: gem.New("┴"), BottomRight: gem.New("┘"),
+			},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("┌───┬───┐"),
+					gem.New("│ A │ B │"),
+					gem.New("╞═══╪═══╡"),
+					gem.New("│ 1 │ 2 │"),
+					gem.New("└───┴───┘"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "style without top or bottom border",
+			table: [][]gem.String{
+				{gem.New("a"), gem.New("b")},
+				{gem.New("1"), gem.New("2")},
+			},
+			width:   0,
+			lineSep: gem.New("\n"),
+			header:  true,
+			border:  true,
+			style: &TableStyle{
+				Vertical:     gem.New("|"),
+				LeftJunction: gem.New("|"), Horizontal: gem.New("-"), Cross: gem.New("|"), RightJunction: gem.New("|"),
+				HeaderLeft: gem.New("|"), HeaderHorizontal: gem.New("-"), HeaderCross: gem.New("|"), HeaderRight: gem.New("|"),
+			},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("| A | B |"),
+					gem.New("|---|---|"),
+					gem.New("| 1 | 2 |"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "styled vertical fills width with ANSI escapes",
+			table: [][]gem.String{
+				{gem.New("a"), gem.New("b")},
+				{gem.New("1"), gem.New("2")},
+			},
+			width:   13,
+			lineSep: gem.New("\n"),
+			header:  true,
+			border:  true,
+			style: &TableStyle{
+				TopLeft: gem.New("+"), Top: gem.New("-"), TopJunction: gem.New("+"), TopRight: gem.New("+"),
+				Vertical:     gem.New("\x1b[2m|\x1b[0m"),
+				LeftJunction: gem.New("+"), Horizontal: gem.New("-"), Cross: gem.New("+"), RightJunction: gem.New("+"),
+				HeaderLeft: gem.New("+"), HeaderHorizontal: gem.New("="), HeaderCross: gem.New("+"), HeaderRight: gem.New("+"),
+				BottomLeft: gem.New("+"), Bottom: gem.New("-"), BottomJunction: gem.New("+"), BottomRight: gem.New("+"),
+			},
+			opts: Options{ANSIEscapes: true},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("+-----+-----+"),
+					gem.New("\x1b[2m|\x1b[0m  A  \x1b[2m|\x1b[0m  B  \x1b[2m|\x1b[0m"),
+					gem.New("+=====+=====+"),
+					gem.New("\x1b[2m|\x1b[0m 1   \x1b[2m|\x1b[0m 2   \x1b[2m|\x1b[0m"),
+					gem.New("+-----+-----+"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "style header rule without borders",
+			table: [][]gem.String{
+				{gem.New("a"), gem.New("b")},
+				{gem.New("1"), gem.New("2")},
+			},
+			width:   0,
+			lineSep: gem.New("\n"),
+			header:  true,
+			border:  false,
+			style: &TableStyle{
+				Vertical:         gem.New("│"),
+				HeaderHorizontal: gem.New("═"),
+			},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("A  B"),
+					gem.New("════"),
+					gem.New("1  2"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "markdown with alignments",
+			table: [][]gem.String{
+				{gem.New("Name"), gem.New("Score"), gem.New("Note"), gem.New("Class")},
+				{gem.New("John"), gem.New("1.5"), gem.New("ok"), gem.New("Heir")},
+				{gem.New("Rose"), gem.New("10"), gem.New("good"), gem.New("Seer")},
+			},
+			width:   0,
+			lineSep: gem.New("\n"),
+			header:  false,
+			border:  false,
+			opts: Options{
+				TableRenderer:   MarkdownRenderer,
+				TableHeaderCase: HeaderAsIs,
+				TableAlignments: []ColumnAlignment{ColumnLeft, ColumnDecimal, ColumnCenter},
+			},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("| Name | Score | Note | Class |"),
+					gem.New("|:-----|------:|:----:|-------|"),
+					gem.New("| John |   1.5 |  ok  | Heir  |"),
+					gem.New("| Rose |  10   | good | Seer  |"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "markdown with pipes and line separators in cells",
+			table: [][]gem.String{
+				{gem.New("a|b"), gem.New("")},
+				{gem.New("c\nd"), gem.New("")},
+			},
+			width:   0,
+			lineSep: gem.New("\n"),
+			header:  true,
+			border:  true,
+			opts:    Options{TableRenderer: MarkdownRenderer},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("| A\\|B   |   |"),
+					gem.New("|--------|---|"),
+					gem.New("| c<br>d |   |"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+		{
+			name: "markdown with only a header",
+			table: [][]gem.String{
+				{gem.New("a"), gem.New("b")},
+			},
+			width:   0,
+			lineSep: gem.New("\n"),
+			header:  true,
+			border:  true,
+			opts:    Options{TableRenderer: MarkdownRenderer},
+			expect: tb.Block{
+				Lines: []gem.String{
+					gem.New("| A | B |"),
+					gem.New("|---|---|"),
+				},
+				LineSeparator: gem.New("\n"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			style := StyleFromCharSet(tc.charSet)
+			if tc.style != nil {
+				style = *tc.style
+			}
+
+			actual := MakeTable(tc.table, tc.width, tc.lineSep, tc.header, tc.border, style, tc.opts)
+
+			assert.True(tc.expect.Equal(actual))
+			assert.Equal(tc.expect.Join().String(), actual.Join().String())
+		})
+	}
+}
+
+func Test_MakeSpannedTable(t *testing.T) {
+	cell := func(text string, colSpan, rowSpan int) TableCell {
+		return TableCell{Text: gem.New(text), ColSpan: colSpan, RowSpan: rowSpan}
+	}
+	lightStyle := TableStyle{
+		TopLeft: gem.New("┌"), Top: gem.New("─"), TopJunction: gem.New("┬"), TopRight: gem.New("┐"),
+		Vertical:     gem.New("│"),
+		LeftJunction: gem.New("├"), Horizontal: gem.New("─"), Cross: gem.New("┼"), RightJunction: gem.New("┤"),
+		HeaderLeft: gem.New("├"), HeaderHorizontal: gem.New("─"), HeaderCross: gem.New("┼"), HeaderRight: gem.New("┤"),
+		BottomLeft: gem.New("└"), Bottom: gem.New("─"), BottomJunction: gem.New("┴"), BottomRight: gem.New("┘"),
+	}
+
+	testCases := []struct {
+		name   string
+		table  [][]TableCell
+		width  int
+		header bool
+		border bool
+		style  TableStyle
+		opts   Options
+		expect []string
+	}{
+		{
+			name:   "nil table",
+			table:  nil,
+			width:  10,
+			header: true,
+			border: true,
+			style:  StyleFromCharSet(gem.Zero),
+			expect: []string{},
+		},
+		{
+			name: "no spans is same as plain table",
+			table: [][]TableCell{
+				{cell("a", 1, 1), cell("b", 0, 0)},
+				{cell("1", 1, 1), cell("2", 1, 1)},
+			},
+			width:  0,
+			header: false,
+			border: true,
+			style:  StyleFromCharSet(gem.Zero),
+			expect: []string{
+				"+---+---+",
+				"| a | b |",
+				"| 1 | 2 |",
+				"+---+---+",
+			},
+		},
+		{
+			name: "header cells spanning columns",
+			table: [][]TableCell{
+				{cell("Region", 1, 1), cell("Q1", 2, 1), cell("Q2", 2, 1)},
+				{cell("North", 1, 1), cell("10", 1, 1), cell("20", 1, 1), cell("30", 1, 1), cell("40", 1, 1)},
+			},
+			width:  0,
+			header: true,
+			border: true,
+			style:  lightStyle,
+			expect: []string{
+				"┌────────┬─────────┬─────────┐",
+				"│ REGION │    Q1   │    Q2   │",
+				"├────────┼────┬────┼────┬────┤",
+				"│ North  │ 10 │ 20 │ 30 │ 40 │",
+				"└────────┴────┴────┴────┴────┘",
+			},
+		},
+		{
+			name: "cell spanning rows",
+			table: [][]TableCell{
+				{cell("North", 1, 2), cell("10", 1, 1)},
+				{cell("20", 1, 1)},
+				{cell("South", 1, 1), cell("30", 1, 1)},
+			},
+			width:  0,
+			header: false,
+			border: true,
+			style:  StyleFromCharSet(gem.Zero),
+			expect: []string{
+				"+-------+----+",
+				"| North | 10 |",
+				"|       | 20 |",
+				"| South | 30 |",
+				"+-------+----+",
+			},
+		},
+		{
+			name: "spanning cell wider than its columns widens them",
+			table: [][]TableCell{
+				{cell("a", 1, 1), cell("b", 1, 1), cell("c", 1, 1)},
+				{cell("1", 1, 1), cell("a long total", 2, 1)},
+			},
+			width:  0,
+			header: false,
+			border: true,
+			style:  lightStyle,
+			expect: []string{
+				"┌───┬───────┬──────┐",
+				"│ a │ b     │ c    │",
+				"│ 1 │ a long total │",
+				"└───┴──────────────┘",
+			},
+		},
+		{
+			name: "spans without borders",
+			table: [][]TableCell{
+				{cell("Name", 1, 1), cell("Scores", 2, 1)},
+				{cell("John", 1, 1), cell("10", 1, 1), cell("20", 1, 1)},
+			},
+			width:  0,
+			header: true,
+			border: false,
+			style:  StyleFromCharSet(gem.Zero),
+			expect: []string{
+				"NAME  SCORES",
+				"------------",
+				"John  10  20",
+			},
+		},
+		{
+			name: "spans are shortened to not overlap or run past the table",
+			table: [][]TableCell{
+				{cell("a", 1, 1), cell("b", 1, 5)},
+				{cell("c", 3, 1)},
+			},
+			width:  0,
+			header: false,
+			border: true,
+			style:  StyleFromCharSet(gem.Zero),
+			expect: []string{
+				"+---+---+",
+				"| a | b |",
+				"| c |   |",
+				"+---+---+",
+			},
+		},
+		{
+			name: "header cells do not span rows",
+			table: [][]TableCell{
+				{cell("a", 1, 2), cell("b", 1, 1)},
+				{cell("1", 1, 1), cell("2", 1, 1)},
+			},
+			width:  0,
+			header: true,
+			border: true,
+			style:  StyleFromCharSet(gem.Zero),
+			expect: []string{
+				"+---+---+",
+				"| A | B |",
+				"+---+---+",
+				"| 1 | 2 |",
+				"+---+---+",
+			},
+		},
+		{
+			name: "html renderer",
+			table: [][]TableCell{
+				{cell("name", 1, 1), cell("stats", 2, 1)},
+				{cell("<John>", 1, 2), cell("1.5", 1, 1), cell("a&b", 1, 1)},
+				{cell("10", 1, 1), cell("x\ny", 1, 1)},
+			},
+			width:  0,
+			header: true,
+			border: true,
+			style:  StyleFromCharSet(gem.Zero),
+			opts: Options{
+				TableRenderer:   HTMLRenderer,
+				TableAlignments: []ColumnAlignment{ColumnLeft, ColumnDecimal, ColumnCenter},
+			},
+			expect: []string{
+				"<table>",
+				"  <thead>",
+				"    <tr>",
+				"      <th>NAME</th>",
+				`      <th colspan="2" style="text-align: right">STATS</th>`,
+				"    </tr>",
+				"  </thead>",
+				"  <tbody>",
+				"    <tr>",
+				`      <td rowspan="2">&lt;John&gt;</td>`,
+				`      <td style="text-align: right">1.5</td>`,
+				`      <td style="text-align: center">a&amp;b</td>`,
+				"    </tr>",
+				"    <tr>",
+				`      <td style="text-align: right">10</td>`,
+				`      <td style="text-align: center">x<br>y</td>`,
+				"    </tr>",
+				"  </tbody>",
+				"</table>",
+			},
+		},
+		{
+			name: "html renderer without header",
+			table: [][]TableCell{
+				{cell("a", 1, 1)},
+			},
+			width:  0,
+			header: false,
+			border: false,
+			style:  StyleFromCharSet(gem.Zero),
+			opts:   Options{TableRenderer: HTMLRenderer},
+			expect: []string{
+				"<table>",
+				"  <tbody>",
+				"    <tr>",
+				"      <td>a</td>",
+				"    </tr>",
+				"  </tbody>",
+				"</table>",
+			},
+		},
+		{
+			name: "rst grid renderer",
+			table: [][]TableCell{
+				{cell("name", 1, 1), cell("stats", 2, 1)},
+				{cell("John", 1, 2), cell("1", 1, 1), cell("2", 1, 1)},
+				{cell("3", 1, 1), cell("4", 1, 1)},
+				{cell("Rose", 1, 1), cell("5", 2, 1)},
+			},
+			width:  0,
+			header: true,
+			border: false,
+			style:  StyleFromCharSet(gem.New("#")),
+			opts:   Options{TableRenderer: RSTGridRenderer},
+			expect: []string{
+				"+------+-------+",
+				"| NAME | STATS |",
+				"+======+===+===+",
+				"| John | 1 | 2 |",
+				"|      +---+---+",
+				"|      | 3 | 4 |",
+				"+------+---+---+",
+				"| Rose | 5     |",
+				"+------+-------+",
+			},
+		},
+		{
+			name: "rst simple renderer",
+			table: [][]TableCell{
+				{cell("name", 1, 1), cell("class", 1, 1)},
+				{cell("John", 1, 1), cell("Heir", 1, 1)},
+				{cell("Rose", 2, 1)},
+			},
+			width:  0,
+			header: true,
+			border: true,
+			style:  StyleFromCharSet(gem.Zero),
+			opts:   Options{TableRenderer: RSTSimpleRenderer},
+			expect: []string{
+				"====  =====",
+				"NAME  CLASS",
+				"====  =====",
+				"John  Heir ",
+				"Rose       ",
+				"====  =====",
+			},
+		},
+		{
+			name: "rst grid renderer splits cells into lines",
+			table: [][]TableCell{
+				{cell("name", 1, 1), cell("notes", 1, 1)},
+				{cell("a", 1, 1), cell("multi\nline", 1, 1)},
+			},
+			width:  0,
+			header: true,
+			border: false,
+			style:  StyleFromCharSet(gem.Zero),
+			opts:   Options{TableRenderer: RSTGridRenderer},
+			expect: []string{
+				"+------+-------+",
+				"| NAME | NOTES |",
+				"+======+=======+",
+				"| a    | multi |",
+				"|      | line  |",
+				"+------+-------+",
+			},
+		},
+		{
+			name: "rst simple renderer splits cells into lines",
+			table: [][]TableCell{
+				{cell("name", 1, 1), cell("notes", 1, 1)},
+				{cell("first\nname", 1, 1), cell("multi\nline", 1, 1)},
+			},
+			width:  0,
+			header: true,
+			border: false,
+			style:  StyleFromCharSet(gem.Zero),
+			opts:   Options{TableRenderer: RSTSimpleRenderer},
+			expect: []string{
+				"==========  =====",
+				"NAME        NOTES",
+				"==========  =====",
+				"first name  multi",
+				"            line ",
+				"==========  =====",
+			},
+		},
+		{
+			name: "rst simple renderer marks empty first cells",
+			table: [][]TableCell{
+				{cell("name", 1, 1), cell("class", 1, 1)},
+				{cell("John", 1, 1), cell("Heir", 1, 1)},
+				{cell("", 1, 1), cell("Seer", 1, 1)},
+			},
+			width:  0,
+			header: true,
+			border: false,
+			style:  StyleFromCharSet(gem.Zero),
+			opts:   Options{TableRenderer: RSTSimpleRenderer},
+			expect: []string{
+				"====  =====",
+				"NAME  CLASS",
+				"====  =====",
+				"John  Heir ",
+				"..    Seer ",
+				"====  =====",
+			},
+		},
+		{
+			name: "latex renderer with border",
+			table: [][]TableCell{
+				{cell("name", 1, 1), cell("stats", 2, 1)},
+				{cell("John", 1, 2), cell("50%", 1, 1), cell("a_b", 1, 1)},
+				{cell("$3", 1, 1), cell("{4}", 1, 1)},
+			},
+			width:  0,
+			header: true,
+			border: true,
+			style:  StyleFromCharSet(gem.Zero),
+			opts: Options{
+				TableRenderer:   LaTeXRenderer,
+				TableAlignments: []ColumnAlignment{ColumnLeft, ColumnRight, ColumnCenter},
+			},
+			expect: []string{
+				`\begin{tabular}{|l|r|c|}`,
+				`\hline`,
+				`NAME & \multicolumn{2}{r|}{STATS} \\`,
+				`\hline`,
+				`\multirow{2}{*}{John} & 50\% & a\_b \\`,
+				` & \$3 & \{4\} \\`,
+				`\hline`,
+				`\end{tabular}`,
+			},
+		},
+		{
+			name: "latex renderer without border",
+			table: [][]TableCell{
+				{cell("a", 2, 1)},
+				{cell("1", 1, 1), cell("2", 1, 1)},
+			},
+			width:  0,
+			header: false,
+			border: false,
+			style:  StyleFromCharSet(gem.Zero),
+			opts:   Options{TableRenderer: LaTeXRenderer},
+			expect: []string{
+				`\begin{tabular}{ll}`,
+				`\multicolumn{2}{l}{a} \\`,
+				`1 & 2 \\`,
+				`\end{tabular}`,
+			},
+		},
+		{
+			name: "latex renderer escapes angle brackets",
+			table: [][]TableCell{
+				{cell("a<b", 1, 1), cell("c>d", 1, 1)},
+			},
+			width:  0,
+			header: false,
+			border: false,
+			style:  StyleFromCharSet(gem.Zero),
+			opts:   Options{TableRenderer: LaTeXRenderer},
+			expect: []string{
+				`\begin{tabular}{ll}`,
+				`a\textless{}b & c\textgreater{}d \\`,
+				`\end{tabular}`,
+			},
+		},
+		{
+			name: "fixed column width",
+			table: [][]TableCell{
+				{cell("name", 1, 1), cell("notes", 1, 1)},
+				{cell("Rose", 1, 1), cell("seer of light", 1, 1)},
+			},
+			width:  20,
+			header: true,
+			border: true,
+			style:  StyleFromCharSet(gem.Zero),
+			opts:   Options{TableColumnWidths: []ColumnWidth{{Fixed: 6}}},
+			expect: []string{
+				"+--------+---------------+",
+				"|  NAME  |     NOTES     |",
+				"+--------+---------------+",
+				"| Rose   | seer of light |",
+				"+--------+---------------+",
+			},
+		},
+		{
+			name: "maximum column width wraps content",
+			table: [][]TableCell{
+				{cell("name", 1, 1), cell("notes", 1, 1)},
+				{cell("Rose", 1, 1), cell("seer of light", 1, 1)},
+			},
+			width:  30,
+			header: true,
+			border: true,
+			style:  StyleFromCharSet(gem.Zero),
+			opts:   Options{TableColumnWidths: []ColumnWidth{{}, {Max: 5}}},
+			expect: []string{
+				"+--------------------+-------+",
+				"|        NAME        | NOTES |",
+				"+--------------------+-------+",
+				"| Rose               | seer  |",
+				"|                    | of    |",
+				"|                    | light |",
+				"+--------------------+-------+",
+			},
+		},
+		{
+			name: "minimum column width",
+			table: [][]TableCell{
+				{cell("name", 1, 1), cell("notes", 1, 1)},
+				{cell("Rose", 1, 1), cell("seer of light", 1, 1)},
+			},
+			width:  0,
+			header: true,
+			border: true,
+			style:  StyleFromCharSet(gem.Zero),
+			opts:   Options{TableColumnWidths: []ColumnWidth{{Min: 6}, {Max: 4}}},
+			expect: []string{
+				"+--------+------+",
+				"|  NAME  | NOT- |",
+				"|        |  ES  |",
+				"+--------+------+",
+				"| Rose   | seer |",
+				"|        | of   |",
+				"|        | lig- |",
+				"|        | ht   |",
+				"+--------+------+",
+			},
+		},
+		{
+			name: "percentage column width",
+			table: [][]TableCell{
+				{cell("name", 1, 1), cell("notes", 1, 1)},
+				{cell("Rose", 1, 1), cell("seer of light", 1, 1)},
+			},
+			width:  24,
+			header: true,
+			border: true,
+			style:  StyleFromCharSet(gem.Zero),
+			opts:   Options{TableColumnWidths: []ColumnWidth{{Percent: 50}}},
+			expect: []string{
+				"+----------+---------------+",
+				"|   NAME   |     NOTES     |",
+				"+----------+---------------+",
+				"| Rose     | seer of light |",
+				"+----------+---------------+",
+			},
+		},
+		{
+			name: "weighted column widths",
+			table: [][]TableCell{
+				{cell("name", 1, 1), cell("notes", 1, 1)},
+				{cell("Rose", 1, 1), cell("seer of light", 1, 1)},
+			},
+			width:  26,
+			header: true,
+			border: true,
+			style:  StyleFromCharSet(gem.Zero),
+			opts:   Options{TableColumnWidths: []ColumnWidth{{Weight: 1}, {Weight: 2}}},
+			expect: []string{
+				"+-------+----------------+",
+				"|  NAME |      NOTES     |",
+				"+-------+----------------+",
+				"| Rose  | seer of light  |",
+				"+-------+----------------+",
+			},
+		},
+		{
+			name: "fitting does not narrow fixed column",
+			table: [][]TableCell{
+				{cell("name", 1, 1), cell("notes", 1, 1)},
+				{cell("Rose", 1, 1), cell("seer of light", 1, 1)},
+			},
+			width:  18,
+			header: true,
+			border: true,
+			style:  StyleFromCharSet(gem.Zero),
+			opts:   Options{TableFit: true, TableColumnWidths: []ColumnWidth{{Fixed: 8}}},
+			expect: []string{
+				"+----------+-----+",
+				"|   NAME   | NO- |",
+				"|          | TES |",
+				"+----------+-----+",
+				"| Rose     | se- |",
+				"|          | er  |",
+				"|          | of  |",
+				"|          | li- |",
+				"|          | ght |",
+				"+----------+-----+",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
 
-			actual := MakeTable(tc.table, tc.width, tc.lineSep, tc.header, tc.border, tc.charSet)
+			expect := make([]gem.String, len(tc.expect))
+			for i := range tc.expect {
+				expect[i] = gem.New(tc.expect[i])
+			}
 
-			assert.True(tc.expect.Equal(actual))
-			assert.Equal(tc.expect.Join().String(), actual.Join().String())
+			actual := MakeSpannedTable(tc.table, tc.width, gem.New("\n"), tc.header, tc.border, tc.style, tc.opts)
+
+			assert.Equal(tb.Block{Lines: expect, LineSeparator: gem.New("\n")}.Join().String(), actual.Join().String())
+		})
+	}
+}
+
+func Test_MakeTableFromSpec(t *testing.T) {
+	cell := func(text string, colSpan, rowSpan int) TableCell {
+		return TableCell{Text: gem.New(text), ColSpan: colSpan, RowSpan: rowSpan}
+	}
+	rows := func(texts ...string) [][]TableCell {
+		data := make([][]TableCell, len(texts))
+		for i := range texts {
+			data[i] = []TableCell{cell(texts[i], 1, 1), cell(strings.ToLower(texts[i]), 1, 1)}
+		}
+		return data
+	}
+
+	testCases := []struct {
+		name   string
+		spec   TableSpec
+		opts   Options
+		expect []string
+	}{
+		{
+			name:   "no data",
+			spec:   TableSpec{Border: true, Style: StyleFromCharSet(gem.Zero)},
+			expect: []string{},
+		},
+		{
+			name: "footer with border",
+			spec: TableSpec{Data: rows("A", "B", "C"), Footer: true, Border: true, Style: StyleFromCharSet(gem.Zero)},
+			expect: []string{
+				"+---+---+",
+				"| A | a |",
+				"| B | b |",
+				"+---+---+",
+				"| C | c |",
+				"+---+---+",
+			},
+		},
+		{
+			name: "footer without border",
+			spec: TableSpec{Data: rows("A", "B", "C"), Footer: true, Style: StyleFromCharSet(gem.Zero)},
+			expect: []string{
+				"A  a",
+				"B  b",
+				"----",
+				"C  c",
+			},
+		},
+		{
+			name: "footer is ignored in table with one row",
+			spec: TableSpec{Data: rows("A"), Footer: true, Border: true, Style: StyleFromCharSet(gem.Zero)},
+			expect: []string{
+				"+---+---+",
+				"| A | a |",
+				"+---+---+",
+			},
+		},
+		{
+			name: "header and footer",
+			spec: TableSpec{Data: rows("A", "B", "C"), Header: true, Footer: true, Border: true, Style: StyleFromCharSet(gem.Zero)},
+			expect: []string{
+				"+---+---+",
+				"| A | A |",
+				"+---+---+",
+				"| B | b |",
+				"+---+---+",
+				"| C | c |",
+				"+---+---+",
+			},
+		},
+		{
+			name: "rules with border",
+			spec: TableSpec{Data: rows("A", "B", "C", "D"), Rules: []int{0, 2}, Border: true, Style: StyleFromCharSet(gem.Zero)},
+			expect: []string{
+				"+---+---+",
+				"| A | a |",
+				"+---+---+",
+				"| B | b |",
+				"| C | c |",
+				"+---+---+",
+				"| D | d |",
+				"+---+---+",
+			},
+		},
+		{
+			name: "rules without border",
+			spec: TableSpec{Data: rows("A", "B", "C"), Rules: []int{1}, Style: StyleFromCharSet(gem.Zero)},
+			expect: []string{
+				"A  a",
+				"B  b",
+				"----",
+				"C  c",
+			},
+		},
+		{
+			name: "rules under header, above footer, and out of range are ignored",
+			spec: TableSpec{Data: rows("A", "B", "C"), Header: true, Footer: true, Rules: []int{-1, 0, 1, 2, 5}, Border: true, Style: StyleFromCharSet(gem.Zero)},
+			expect: []string{
+				"+---+---+",
+				"| A | A |",
+				"+---+---+",
+				"| B | b |",
+				"+---+---+",
+				"| C | c |",
+				"+---+---+",
+			},
+		},
+		{
+			name: "cell does not span into footer",
+			spec: TableSpec{
+				Data: [][]TableCell{
+					{cell("a", 1, 3), cell("b", 1, 1)},
+					{cell("c", 1, 1)},
+					{cell("d", 1, 1), cell("e", 1, 1)},
+				},
+				Footer: true,
+				Border: true,
+				Style:  StyleFromCharSet(gem.Zero),
+			},
+			expect: []string{
+				"+---+---+",
+				"| a | b |",
+				"|   | c |",
+				"+---+---+",
+				"| d | e |",
+				"+---+---+",
+			},
+		},
+		{
+			name: "rule through a cell that spans rows",
+			spec: TableSpec{
+				Data: [][]TableCell{
+					{cell("a", 1, 2), cell("b", 1, 1)},
+					{cell("c", 1, 1)},
+				},
+				Rules:  []int{0},
+				Border: true,
+				Style:  StyleFromCharSet(gem.Zero),
+			},
+			expect: []string{
+				"+---+---+",
+				"| a | b |",
+				"|   +---+",
+				"|   | c |",
+				"+---+---+",
+			},
+		},
+		{
+			name: "latex footer and rules",
+			spec: TableSpec{Data: rows("A", "B", "C", "D"), Header: true, Footer: true, Rules: []int{1}},
+			opts: Options{TableRenderer: LaTeXRenderer},
+			expect: []string{
+				`\begin{tabular}{ll}`,
+				`A & A \\`,
+				`\hline`,
+				`B & b \\`,
+				`\hline`,
+				`C & c \\`,
+				`\hline`,
+				`D & d \\`,
+				`\end{tabular}`,
+			},
+		},
+		{
+			name: "rst grid has no footer rule",
+			spec: TableSpec{Data: rows("A", "B", "C"), Footer: true},
+			opts: Options{TableRenderer: RSTGridRenderer},
+			expect: []string{
+				"+---+---+",
+				"| A | a |",
+				"+---+---+",
+				"| B | b |",
+				"+---+---+",
+				"| C | c |",
+				"+---+---+",
+			},
+		},
+		{
+			name: "expanded without border",
+			spec: TableSpec{Data: [][]TableCell{
+				{cell("Name", 1, 1), cell("Age", 1, 1)},
+				{cell("Rose", 1, 1), cell("13", 1, 1)},
+				{cell("Dave", 1, 1), cell("13", 1, 1)},
+			}, Header: true, Style: StyleFromCharSet(gem.Zero)},
+			opts: Options{TableExpand: ExpandOn},
+			expect: []string{
+				"-[ RECORD 1 ]",
+				"NAME | Rose",
+				"AGE  | 13",
+				"-[ RECORD 2 ]",
+				"NAME | Dave",
+				"AGE  | 13",
+			},
+		},
+		{
+			name: "expanded with border",
+			spec: TableSpec{Data: [][]TableCell{
+				{cell("Name", 1, 1), cell("Age", 1, 1)},
+				{cell("Rose", 1, 1), cell("13", 1, 1)},
+				{cell("Dave", 1, 1), cell("13", 1, 1)},
+			}, Header: true, Border: true, Style: StyleFromCharSet(gem.Zero)},
+			opts: Options{TableExpand: ExpandOn},
+			expect: []string{
+				"+-[ RECORD 1 ]+",
+				"| NAME | Rose |",
+				"| AGE  | 13   |",
+				"+-[ RECORD 2 ]+",
+				"| NAME | Dave |",
+				"| AGE  | 13   |",
+				"+------+------+",
+			},
+		},
+		{
+			name: "expanded with border fills width",
+			spec: TableSpec{Data: [][]TableCell{{cell("Name", 1, 1), cell("Age", 1, 1)}, {cell("Rose", 1, 1), cell("13", 1, 1)}}, Header: true, Border: true, Width: 30, Style: StyleFromCharSet(gem.Zero)},
+			opts: Options{TableExpand: ExpandOn},
+			expect: []string{
+				"+-[ RECORD 1 ]---------------+",
+				"| NAME | Rose                |",
+				"| AGE  | 13                  |",
+				"+------+---------------------+",
+			},
+		},
+		{
+			name: "expanded record title is not cut at narrow width",
+			spec: TableSpec{Data: [][]TableCell{
+				{cell("Name", 1, 1), cell("Age", 1, 1)},
+				{cell("Rose", 1, 1), cell("13", 1, 1)},
+			}, Header: true, Width: 12, Style: StyleFromCharSet(gem.Zero)},
+			opts: Options{TableExpand: ExpandOn},
+			expect: []string{
+				"-[ RECORD 1 ]",
+				"NAME | Rose",
+				"AGE  | 13",
+			},
+		},
+		{
+			name: "expanded record title is not cut at narrow width with border",
+			spec: TableSpec{Data: [][]TableCell{
+				{cell("Name", 1, 1), cell("Age", 1, 1)},
+				{cell("Rose", 1, 1), cell("13", 1, 1)},
+			}, Header: true, Border: true, Width: 13, Style: StyleFromCharSet(gem.Zero)},
+			opts: Options{TableExpand: ExpandOn},
+			expect: []string{
+				"+-[ RECORD 1 ]+",
+				"| NAME | R- |",
+				"|      | o- |",
+				"|      | se |",
+				"| AGE  | 13 |",
+				"+------+----+",
+			},
+		},
+		{
+			name: "expanded record title is not cut at very narrow width with border",
+			spec: TableSpec{Data: [][]TableCell{
+				{cell("Name", 1, 1), cell("Age", 1, 1)},
+				{cell("Rose", 1, 1), cell("13", 1, 1)},
+			}, Header: true, Border: true, Width: 5, Style: StyleFromCharSet(gem.Zero)},
+			opts: Options{TableExpand: ExpandOn},
+			expect: []string{
+				"+-[ RECORD 1 ]+",
+				"| NAME | R- |",
+				"|      | o- |",
+				"|      | se |",
+				"| AGE  | 13 |",
+				"+------+----+",
+			},
+		},
+		{
+			name: "expanded without header numbers columns",
+			spec: TableSpec{Data: rows("Rose", "Dave"), Style: StyleFromCharSet(gem.Zero)},
+			opts: Options{TableExpand: ExpandOn},
+			expect: []string{
+				"-[ RECORD 1 ]",
+				"1 | Rose",
+				"2 | rose",
+				"-[ RECORD 2 ]",
+				"1 | Dave",
+				"2 | dave",
+			},
+		},
+		{
+			name: "expanded wraps long values",
+			spec: TableSpec{
+				Data: [][]TableCell{
+					{cell("Name", 1, 1), cell("Quote", 1, 1)},
+					{cell("Rose", 1, 1), cell("the quick brown fox jumps over the lazy dog", 1, 1)},
+				},
+				Header: true,
+				Width:  20,
+				Style:  StyleFromCharSet(gem.Zero),
+			},
+			opts: Options{TableExpand: ExpandOn},
+			expect: []string{
+				"-[ RECORD 1 ]-------",
+				"NAME  | Rose",
+				"QUOTE | the quick",
+				"      | brown fox",
+				"      | jumps over",
+				"      | the lazy dog",
+			},
+		},
+		{
+			name: "auto expands table too wide for width",
+			spec: TableSpec{
+				Data: [][]TableCell{
+					{cell("Name", 1, 1), cell("Title", 1, 1), cell("Planet", 1, 1)},
+					{cell("Rose", 1, 1), cell("Seer of Light", 1, 1), cell("Land of Light and Rain", 1, 1)},
+				},
+				Header: true,
+				Width:  30,
+				Style:  StyleFromCharSet(gem.Zero),
+			},
+			opts: Options{TableExpand: ExpandAuto},
+			expect: []string{
+				"-[ RECORD 1 ]-----------------",
+				"NAME   | Rose",
+				"TITLE  | Seer of Light",
+				"PLANET | Land of Light and",
+				"       | Rain",
+			},
+		},
+		{
+			name: "auto does not expand table that fits",
+			spec: TableSpec{Data: rows("Name", "Rose"), Header: true, Width: 30, Style: StyleFromCharSet(gem.Zero)},
+			opts: Options{TableExpand: ExpandAuto},
+			expect: []string{
+				"NAME                      NAME",
+				"------------------------------",
+				"Rose                      rose",
+			},
+		},
+		{
+			name: "auto does not expand table that is fit to width",
+			spec: TableSpec{
+				Data: [][]TableCell{
+					{cell("Name", 1, 1), cell("Title", 1, 1), cell("Planet", 1, 1)},
+					{cell("Rose", 1, 1), cell("Seer of Light", 1, 1), cell("Land of Light and Rain", 1, 1)},
+				},
+				Header: true,
+				Width:  30,
+				Fit:    true,
+				Style:  StyleFromCharSet(gem.Zero),
+			},
+			opts: Options{TableExpand: ExpandAuto},
+			expect: []string{
+				"NAME     TITLE       PLANET   ",
+				"------------------------------",
+				"Rose     Seer of     Land of  ",
+				"         Light       Light and",
+				"                     Rain     ",
+			},
+		},
+		{
+			name: "long record title",
+			spec: TableSpec{Data: [][]TableCell{{cell("A", 1, 1)}, {cell("value", 1, 1)}}, Header: true, Style: StyleFromCharSet(gem.Zero)},
+			opts: Options{TableExpand: ExpandOn},
+			expect: []string{
+				"-[ RECORD 1 ]",
+				"A | value",
+			},
+		},
+		{
+			name:   "header only has no records",
+			spec:   TableSpec{Data: rows("Name"), Header: true, Style: StyleFromCharSet(gem.Zero)},
+			opts:   Options{TableExpand: ExpandOn},
+			expect: []string{},
+		},
+		{
+			name: "other formats are not expanded",
+			spec: TableSpec{Data: rows("A", "B"), Style: StyleFromCharSet(gem.Zero)},
+			opts: Options{TableExpand: ExpandOn, TableRenderer: RSTSimpleRenderer},
+			expect: []string{
+				"=  =",
+				"A  a",
+				"B  b",
+				"=  =",
+			},
+		},
+		{
+			name: "record title with cross at long label",
+			spec: TableSpec{Data: [][]TableCell{{cell("Favorite Color", 1, 1)}, {cell("red", 1, 1)}}, Header: true, Border: true, Style: StyleFromCharSet(gem.Zero)},
+			opts: Options{TableExpand: ExpandOn},
+			expect: []string{
+				"+-[ RECORD 1 ]---+-----+",
+				"| FAVORITE COLOR | red |",
+				"+----------------+-----+",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			expect := make([]gem.String, len(tc.expect))
+			for i := range tc.expect {
+				expect[i] = gem.New(tc.expect[i])
+			}
+
+			actual := MakeTableFromSpec(tc.spec, gem.New("\n"), tc.opts)
+
+			assert.Equal(tb.Block{Lines: expect, LineSeparator: gem.New("\n")}.Join().String(), actual.Join().String())
+		})
+	}
+}
+
+func Test_ParseTable(t *testing.T) {
+	testCases := []struct {
+		name      string
+		lines     []string
+		opts      Options
+		expect    [][]string
+		expectErr bool
+	}{
+		{
+			name:      "no lines",
+			lines:     nil,
+			expectErr: true,
+		},
+		{
+			name:      "only rules",
+			lines:     []string{"+---+", "+---+"},
+			expectErr: true,
+		},
+		{
+			name: "bordered table with header",
+			lines: []string{
+				"+------+-------+",
+				"| NAME | CLASS |",
+				"+------+-------+",
+				"| John | Heir  |",
+				"| Rose | Seer  |",
+				"+------+-------+",
+			},
+			expect: [][]string{
+				{"NAME", "CLASS"},
+				{"John", "Heir"},
+				{"Rose", "Seer"},
+			},
+		},
+		{
+			name: "borderless table with header",
+			lines: []string{
+				"NAME         CLASS",
+				"------------------",
+				"John Egbert  Heir",
+				"Rose         Seer",
+			},
+			expect: [][]string{
+				{"NAME", "CLASS"},
+				{"John Egbert", "Heir"},
+				{"Rose", "Seer"},
+			},
+		},
+		{
+			name: "borderless table with empty cells",
+			lines: []string{
+				"  John  Heir",
+				"  Rose",
+				"        Seer",
+			},
+			expect: [][]string{
+				{"John", "Heir"},
+				{"Rose", ""},
+				{"", "Seer"},
+			},
+		},
+		{
+			name: "box-drawing table",
+			lines: []string{
+				"┌──────┬───────┐",
+				"│ NAME │ CLASS │",
+				"╞══════╪═══════╡",
+				"│ John │ Heir  │",
+				"└──────┴───────┘",
+			},
+			expect: [][]string{
+				{"NAME", "CLASS"},
+				{"John", "Heir"},
+			},
+		},
+		{
+			name: "separators without outer border",
+			lines: []string{
+				" id | name ",
+				"----+------",
+				"  1 | foo",
+				"    | bar",
+			},
+			expect: [][]string{
+				{"id", "name"},
+				{"1", "foo"},
+				{"", "bar"},
+			},
+		},
+		{
+			name: "header on several lines",
+			lines: []string{
+				"+------+-------+",
+				"| FULL | TITLE |",
+				"| NAME |       |",
+				"+------+-------+",
+				"| John | Heir  |",
+				"| Rose | Seer  |",
+				"+------+-------+",
+			},
+			expect: [][]string{
+				{"FULL NAME", "TITLE"},
+				{"John", "Heir"},
+				{"Rose", "Seer"},
+			},
+		},
+		{
+			name: "rules between every row",
+			lines: []string{
+				"+------+--------+",
+				"| John | Heir   |",
+				"|      | of     |",
+				"|      | Breath |",
+				"+------+--------+",
+				"| Rose | Seer   |",
+				"+------+--------+",
+				"| Dave | Knight |",
+				"+------+--------+",
+			},
+			expect: [][]string{
+				{"John", "Heir of Breath"},
+				{"Rose", "Seer"},
+				{"Dave", "Knight"},
+			},
+		},
+		{
+			name:      "plain text",
+			lines:     []string{"just some text"},
+			expectErr: true,
+		},
+		{
+			name: "psql output with row count",
+			lines: []string{
+				" id | name  | note",
+				"----+-------+------",
+				"  1 | alice | hi",
+				"  2 | bob   | ",
+				"(2 rows)",
+				"",
+			},
+			expect: [][]string{
+				{"id", "name", "note"},
+				{"1", "alice", "hi"},
+				{"2", "bob", ""},
+			},
+		},
+		{
+			name: "psql output with continued cells",
+			lines: []string{
+				" id | note",
+				"----+-------",
+				"  1 | first+",
+				"    | line",
+				"  2 | hi",
+				"(2 rows)",
+			},
+			expect: [][]string{
+				{"id", "note"},
+				{"1", "first line"},
+				{"2", "hi"},
+			},
+		},
+		{
+			name: "mysql output with query and row count",
+			lines: []string{
+				"mysql> SELECT id, name FROM users;",
+				"+----+-------+",
+				"| id | name  |",
+				"+----+-------+",
+				"|  1 | alice |",
+				"|  2 | bob   |",
+				"+----+-------+",
+				"2 rows in set (0.00 sec)",
+			},
+			expect: [][]string{
+				{"id", "name"},
+				{"1", "alice"},
+				{"2", "bob"},
+			},
+		},
+		{
+			name: "markdown table with escaped vertical lines",
+			lines: []string{
+				"| A\\|B | C |",
+				"|:-----|:--|",
+				"| x\\|y | z |",
+			},
+			expect: [][]string{
+				{"A|B", "C"},
+				{"x|y", "z"},
+			},
+		},
+		{
+			name: "vertical lines in cells",
+			lines: []string{
+				"+---------------+-------------+",
+				"| NAME          | VALUE       |",
+				"+---------------+-------------+",
+				"| beta          | two | pipe  |",
+				"| gamma | delta | |           |",
+				"+---------------+-------------+",
+			},
+			expect: [][]string{
+				{"NAME", "VALUE"},
+				{"beta", "two | pipe"},
+				{"gamma | delta", "|"},
+			},
+		},
+		{
+			name: "vertical lines in cells without borders",
+			lines: []string{
+				"NAME           VALUE",
+				"--------------------",
+				"beta           two | pipe",
+				"gamma | delta  |",
+			},
+			expect: [][]string{
+				{"NAME", "VALUE"},
+				{"beta", "two | pipe"},
+				{"gamma | delta", "|"},
+			},
+		},
+		{
+			name: "prose with vertical lines before a table",
+			lines: []string{
+				"Use a | to pipe output | into another command.",
+				"",
+				"+----+-------+",
+				"| id | name  |",
+				"+----+-------+",
+				"|  1 | alice |",
+				"+----+-------+",
+			},
+			expect: [][]string{
+				{"id", "name"},
+				{"1", "alice"},
+			},
+		},
+		{
+			name: "markdown table with columns that do not line up",
+			lines: []string{
+				"| id | name |",
+				"|---|---|",
+				"| 1 | alice |",
+				"| 2 | bob |",
+			},
+			expect: [][]string{
+				{"id", "name"},
+				{"1", "alice"},
+				{"2", "bob"},
+			},
+		},
+		{
+			name: "vertical lines that line up without rules",
+			lines: []string{
+				"  1 | alice | a|b",
+				"  2 | bob   | c",
+			},
+			expect: [][]string{
+				{"1", "alice", "a|b"},
+				{"2", "bob", "c"},
+			},
+		},
+		{
+			name: "cell that spans columns",
+			lines: []string{
+				"+------+-----------+",
+				"| NAME | STATS     |",
+				"+------+-----+-----+",
+				"| John | 50% | a_b |",
+				"+------+-----+-----+",
+			},
+			expect: [][]string{
+				{"NAME", "STATS", ""},
+				{"John", "50%", "a_b"},
+			},
+		},
+		{
+			name: "wrapped cells are their own rows without fit",
+			lines: []string{
+				"+------+---------+",
+				"| NAME | CLASS   |",
+				"+------+---------+",
+				"| John | Heir of |",
+				"|      | Breath  |",
+				"| Rose | Seer    |",
+				"+------+---------+",
+			},
+			expect: [][]string{
+				{"NAME", "CLASS"},
+				{"John", "Heir of"},
+				{"", "Breath"},
+				{"Rose", "Seer"},
+			},
+		},
+		{
+			name: "wrapped cells with fit",
+			lines: []string{
+				"+------+---------+-----------+",
+				"| NAME | CLASS   | LAND      |",
+				"+------+---------+-----------+",
+				"| John | Heir of | Land of   |",
+				"|      | Breath  | Wind and  |",
+				"|      |         | Shade     |",
+				"| Rose | Seer    | Land of   |",
+				"|      |         | Light and |",
+				"|      |         | Rain      |",
+				"| Dave |         | LOHAC     |",
+				"+------+---------+-----------+",
+			},
+			opts: Options{TableFit: true},
+			expect: [][]string{
+				{"NAME", "CLASS", "LAND"},
+				{"John", "Heir of Breath", "Land of Wind and Shade"},
+				{"Rose", "Seer", "Land of Light and Rain"},
+				{"Dave", "", "LOHAC"},
+			},
+		},
+		{
+			name: "unwrapped cells with fit",
+			lines: []string{
+				"NAME          CLASS           LAND",
+				"-------------------------------------------------------",
+				"John Egbert   Heir of Breath  Land of Wind and Shade",
+				"Rose Lalonde  Seer of Light   Land of Light and Rain",
+				"Dave Strider                  Land of Heat and",
+				"                              Clockwork",
+			},
+			opts: Options{TableFit: true},
+			expect: [][]string{
+				{"NAME", "CLASS", "LAND"},
+				{"John Egbert", "Heir of Breath", "Land of Wind and Shade"},
+				{"Rose Lalonde", "Seer of Light", "Land of Light and Rain"},
+				{"Dave Strider", "", "Land of Heat and Clockwork"},
+			},
+		},
+		{
+			name: "display width",
+			lines: []string{
+				"日本  Heir",
+				"Rose  Seer",
+			},
+			opts: Options{DisplayWidth: true},
+			expect: [][]string{
+				{"日本", "Heir"},
+				{"Rose", "Seer"},
+			},
+		},
+		{
+			name: "escape sequences take up no width",
+			lines: []string{
+				"\x1b[1mJohn\x1b[0m  Heir",
+				"Rose  Seer",
+			},
+			opts: Options{ANSIEscapes: true},
+			expect: [][]string{
+				{"\x1b[1mJohn\x1b[0m", "Heir"},
+				{"Rose", "Seer"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			lines := make([]gem.String, len(tc.lines))
+			for i := range tc.lines {
+				lines[i] = gem.New(tc.lines[i])
+			}
+
+			actual, err := ParseTable(lines, StyleFromCharSet(gem.Zero), tc.opts)
+			if tc.expectErr {
+				assert.Error(err)
+				return
+			}
+			if !assert.NoError(err) {
+				return
+			}
+
+			actualStrings := make([][]string, len(actual))
+			for i := range actual {
+				actualStrings[i] = make([]string, len(actual[i]))
+				for j := range actual[i] {
+					actualStrings[i][j] = actual[i][j].String()
+				}
+			}
+
+			assert.Equal(tc.expect, actualStrings)
+		})
+	}
+}
+
+func Test_CheckColumnWidths(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cols      int
+		width     int
+		border    bool
+		opts      Options
+		expectErr bool
+	}{
+		{
+			name:  "no constraints",
+			cols:  3,
+			width: 0,
+			opts:  Options{},
+		},
+		{
+			name:   "constraints that fit",
+			cols:   3,
+			width:  40,
+			border: true,
+			opts:   Options{TableColumnWidths: []ColumnWidth{{Fixed: 10}, {Percent: 50}, {Min: 2, Max: 8}}},
+		},
+		{
+			name:      "negative constraint",
+			cols:      1,
+			width:     40,
+			opts:      Options{TableColumnWidths: []ColumnWidth{{Weight: -1}}},
+			expectErr: true,
+		},
+		{
+			name:      "minimum more than maximum",
+			cols:      1,
+			width:     40,
+			opts:      Options{TableColumnWidths: []ColumnWidth{{Min: 10, Max: 5}}},
+			expectErr: true,
+		},
+		{
+			name:      "fixed and percentage",
+			cols:      1,
+			width:     40,
+			opts:      Options{TableColumnWidths: []ColumnWidth{{Fixed: 10, Percent: 10}}},
+			expectErr: true,
+		},
+		{
+			name:      "fixed less than minimum",
+			cols:      1,
+			width:     40,
+			opts:      Options{TableColumnWidths: []ColumnWidth{{Fixed: 4, Min: 5}}},
+			expectErr: true,
+		},
+		{
+			name:      "percentage more than maximum",
+			cols:      1,
+			width:     40,
+			opts:      Options{TableColumnWidths: []ColumnWidth{{Percent: 50, Max: 5}}},
+			expectErr: true,
+		},
+		{
+			name:      "percentages add up to more than 100",
+			cols:      2,
+			width:     40,
+			opts:      Options{TableColumnWidths: []ColumnWidth{{Percent: 60}, {Percent: 60}}},
+			expectErr: true,
+		},
+		{
+			name:      "columns need more than width",
+			cols:      2,
+			width:     20,
+			border:    true,
+			opts:      Options{TableColumnWidths: []ColumnWidth{{Fixed: 12}, {Min: 5}}},
+			expectErr: true,
+		},
+		{
+			name:  "constraints past the last column are ignored",
+			cols:  1,
+			width: 20,
+			opts:  Options{TableColumnWidths: []ColumnWidth{{}, {Fixed: 30}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			err := CheckColumnWidths(tc.cols, tc.width, tc.border, StyleFromCharSet(gem.Zero), tc.opts)
+			if tc.expectErr {
+				assert.Error(err)
+			} else {
+				assert.NoError(err)
+			}
 		})
 	}
 }
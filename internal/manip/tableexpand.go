@@ -0,0 +1,182 @@
+package manip
+
+// This file contains the routines for drawing a table in expanded mode, in
+// which each row of the table is drawn as a record of its own that has a line
+// for each of its columns.
+
+import (
+	"strconv"
+
+	"github.com/dekarrin/rosed/internal/gem"
+	"github.com/dekarrin/rosed/internal/tb"
+)
+
+// ExpandMode is when a table is drawn in expanded mode.
+type ExpandMode int
+
+const (
+	// ExpandOff never draws a table in expanded mode.
+	ExpandOff ExpandMode = iota
+
+	// ExpandOn always draws a table in expanded mode.
+	ExpandOn
+
+	// ExpandAuto draws a table in expanded mode only if it is wider than the
+	// width it is made for once it has been laid out.
+	ExpandAuto
+)
+
+// expands returns whether the table with the given layout, made for width, is
+// drawn in expanded mode. Only plain text tables are ever expanded.
+func (opts Options) expands(layout TableLayout, width int) bool {
+	if opts.tableRenderer() != TextRenderer {
+		return false
+	}
+	switch opts.TableExpand {
+	case ExpandOn:
+		return true
+	case ExpandAuto:
+		return width > 0 && layout.Width > width
+	default:
+		return false
+	}
+}
+
+// makeExpandedTable draws the table given by spec in expanded mode. Each row
+// of the table other than the header is drawn as a record that starts with a
+// line giving its number, followed by a line for each column with the label of
+// the column and the content of the row's cell in it. The labels are the cells
+// of the header row if there is one, and the numbers of the columns otherwise.
+// Content too wide to fit in spec.Width is wrapped.
+//
+// Spans, the footer, and rules are not drawn in expanded mode; the footer is
+// drawn as a record like the other rows.
+func (opts Options) makeExpandedTable(spec TableSpec, lineSep gem.String) tb.Block {
+	tableBlock := tb.New(gem.Zero, lineSep)
+	border, style := spec.Border, spec.Style
+
+	cols := 0
+	for _, row := range spec.Data {
+		if len(row) > cols {
+			cols = len(row)
+		}
+	}
+
+	labels := make([]gem.String, cols)
+	records := spec.Data
+	if spec.Header {
+		for col := range spec.Data[0] {
+			labels[col] = opts.headerCase(spec.Data[0][col].Text)
+		}
+		records = spec.Data[1:]
+	} else {
+		for col := range labels {
+			labels[col] = gem.New(strconv.Itoa(col + 1))
+		}
+	}
+	if cols == 0 || len(records) == 0 {
+		return tableBlock
+	}
+
+	labelWidth := 0
+	for _, label := range labels {
+		if w := opts.Width(label); w > labelWidth {
+			labelWidth = w
+		}
+	}
+
+	valueWidth := 0
+	for _, row := range records {
+		for _, cell := range row {
+			if w := opts.Width(cell.Text); w > valueWidth {
+				valueWidth = w
+			}
+		}
+	}
+
+	// the label and value are each padded with a space on the side next to
+	// the vertical line between them, and on the outer side too if there is
+	// a border
+	labelSpan, valueSpan := labelWidth+1, 1
+	overhead := labelSpan + opts.Width(style.Vertical) + valueSpan
+	if border {
+		labelSpan++
+		valueSpan++
+		overhead += 2 + 2*opts.Width(style.Vertical)
+	}
+	if spec.Width > 0 {
+		avail := spec.Width - overhead
+		if valueWidth > avail || border {
+			valueWidth = avail
+		}
+	}
+	if valueWidth < minFitColumnWidth {
+		valueWidth = minFitColumnWidth
+	}
+	valueSpan += valueWidth
+
+	for i, row := range records {
+		title := style.Horizontal.Add(gem.New("[ RECORD " + strconv.Itoa(i+1) + " ]"))
+		left, cross, right := style.LeftJunction, style.Cross, style.RightJunction
+		if border && i == 0 && style.hasTop() {
+			left, cross, right = style.TopLeft, style.TopJunction, style.TopRight
+		}
+		if !border {
+			left, right = gem.Zero, gem.Zero
+		}
+		tableBlock.Append(opts.recordRule(title, labelSpan, valueSpan, left, style.Horizontal, cross, right))
+
+		for col := 0; col < cols; col++ {
+			var text gem.String
+			if col < len(row) {
+				text = row[col].Text
+			}
+			lines := []gem.String{text}
+			if opts.Width(text) > valueWidth {
+				lines = Wrap(text, valueWidth, lineSep, opts).Lines
+			}
+
+			for lineIdx, line := range lines {
+				label := gem.Zero
+				if lineIdx == 0 {
+					label = labels[col]
+				}
+				label = label.Add(gem.RepeatStr(" ", labelWidth-opts.Width(label)))
+				line = opts.closeStyles(line)
+
+				tableLine := label.Add(gem.New(" ")).Add(style.Vertical).Add(gem.New(" ")).Add(line)
+				if border {
+					tableLine = style.Vertical.Add(gem.New(" ")).Add(tableLine)
+					tableLine = tableLine.Add(gem.RepeatStr(" ", valueWidth-opts.Width(line)))
+					tableLine = tableLine.Add(gem.New(" ")).Add(style.Vertical)
+				}
+				tableBlock.Append(tableLine)
+			}
+		}
+	}
+
+	if border && style.hasBottom() {
+		tableBlock.Append(opts.recordRule(gem.Zero, labelSpan, valueSpan, style.BottomLeft, style.Bottom, style.BottomJunction, style.BottomRight))
+	}
+
+	return tableBlock
+}
+
+// recordRule returns the rule that goes across an expanded table above or
+// below a record, with title at the start of it. labelSpan and valueSpan are
+// the widths of the label and value on each line of the record including their
+// padding, and cross is drawn between them if title is not too long to leave
+// room for it. The title is never cut off, so the rule is wider than the rest
+// of the record if the title is, the same way that a label is never cut off.
+func (opts Options) recordRule(title gem.String, labelSpan, valueSpan int, left, horz, cross, right gem.String) gem.String {
+	titleWidth := opts.Width(title)
+	bar := left.Add(title)
+	if titleWidth < labelSpan {
+		bar = bar.Add(gem.RepeatStr(horz.String(), labelSpan-titleWidth))
+		bar = bar.Add(cross)
+		bar = bar.Add(gem.RepeatStr(horz.String(), valueSpan))
+	} else if rest := labelSpan + opts.Width(cross) + valueSpan - titleWidth; rest > 0 {
+		bar = bar.Add(gem.RepeatStr(horz.String(), rest))
+	}
+	return bar.Add(right)
+}
@@ -0,0 +1,806 @@
+package manip
+
+// This file contains the routines for reading the cells of a table back out of
+// text that has the table drawn in it, such as the text made by MakeTable or by
+// other programs that print tables.
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/dekarrin/rosed/internal/gem"
+)
+
+// ruleChars are the characters other than box-drawing characters that rules in
+// a table can be drawn with.
+const ruleChars = "-=+|:"
+
+// horizontalChars are the characters other than box-drawing characters that
+// draw the horizontal part of a rule in a table.
+const horizontalChars = "-="
+
+// verticalChars are the characters that draw the vertical lines between the
+// columns of a table.
+const verticalChars = "|│┃║┆┇┊┋╎╏"
+
+// minParsedColumnGap is the least amount of space that must be between two
+// columns of a table with no vertical lines for them to be read as separate
+// columns.
+const minParsedColumnGap = 2
+
+// tableChars is the set of characters that the parts of a table are drawn with
+// when it is read by ParseTable.
+type tableChars struct {
+	rule       map[string]bool
+	horizontal map[string]bool
+	vertical   map[string]bool
+}
+
+// newTableChars returns the tableChars that recognizes the characters of style
+// along with box-drawing characters and those that are commonly used to draw
+// tables.
+func newTableChars(style TableStyle) tableChars {
+	chars := tableChars{
+		rule:       map[string]bool{},
+		horizontal: map[string]bool{},
+		vertical:   map[string]bool{},
+	}
+
+	for _, ch := range ruleChars {
+		chars.rule[string(ch)] = true
+	}
+	for _, ch := range horizontalChars {
+		chars.horizontal[string(ch)] = true
+	}
+	for _, ch := range verticalChars {
+		chars.vertical[string(ch)] = true
+	}
+
+	members := []gem.String{
+		style.TopLeft, style.Top, style.TopJunction, style.TopRight,
+		style.Vertical,
+		style.LeftJunction, style.Horizontal, style.Cross, style.RightJunction,
+		style.HeaderLeft, style.HeaderHorizontal, style.HeaderCross, style.HeaderRight,
+		style.BottomLeft, style.Bottom, style.BottomJunction, style.BottomRight,
+	}
+	for _, m := range members {
+		if !m.IsEmpty() && !isWhitespace(m) {
+			chars.rule[m.String()] = true
+		}
+	}
+	for _, m := range []gem.String{style.Top, style.Horizontal, style.HeaderHorizontal, style.Bottom} {
+		if !m.IsEmpty() && !isWhitespace(m) {
+			chars.horizontal[m.String()] = true
+		}
+	}
+	if !style.Vertical.IsEmpty() && !isWhitespace(style.Vertical) {
+		chars.vertical[style.Vertical.String()] = true
+	}
+
+	return chars
+}
+
+// isRule returns whether line is a rule of a table. A rule is made up of only
+// whitespace and the characters that rules are drawn with, and it has at least
+// one character that draws the horizontal part of a rule.
+func (chars tableChars) isRule(line gem.String, opts Options) bool {
+	hasHorizontal := false
+	for i := 0; i < line.Len(); i++ {
+		if escLen := opts.escapeAt(line, i); escLen > 0 {
+			i += escLen - 1
+			continue
+		}
+		gc := line.CharAt(i)
+		if unicode.IsSpace(gc[0]) {
+			continue
+		}
+		ch := string(gc)
+		if !chars.rule[ch] && !isBoxDrawing(gc) {
+			return false
+		}
+		if chars.horizontal[ch] || (isBoxDrawing(gc) && !chars.vertical[ch]) {
+			hasHorizontal = true
+		}
+	}
+	return hasHorizontal
+}
+
+// isBoxDrawing returns whether the grapheme cluster gc is one of the characters
+// in the Unicode Box Drawing block.
+func isBoxDrawing(gc []rune) bool {
+	return len(gc) == 1 && gc[0] >= 0x2500 && gc[0] <= 0x257f
+}
+
+// isWhitespace returns whether text is made up of only whitespace.
+func isWhitespace(text gem.String) bool {
+	return CountLeadingWhitespace(text) == text.Len()
+}
+
+// ParseTable reads the cells of the table that is drawn in lines. Each row of
+// the returned data is a row of the table, with the header row first if the
+// table has one.
+//
+// Rules are the lines that are made up of only the characters in style, "-",
+// "=", "+", "|", ":", and box-drawing characters, and they are not included in
+// the data. The lines of the table and the columns of its vertical lines are
+// found from the first rule as described in tableLines, and text that is
+// printed around the table, such as the count of rows that psql prints after
+// one, is ignored. If the table has vertical lines, as drawn by style.Vertical,
+// "|", or a box-drawing character, the cells of each line are the parts between
+// the vertical lines at those columns as described in splitAtVerticals, so a
+// vertical line in a cell is kept as part of its text, and "\|" in a cell is
+// read as "|". Otherwise, the columns are found by looking for runs of
+// whitespace at least two characters wide that go down every line, and the
+// cells of each line are the parts of it in each column. Whitespace around
+// each cell is removed, and blank lines are ignored.
+//
+// Rules split the lines into blocks. If there are rules between every row of
+// the table, every block is read as one row whose cells are the text of the
+// lines of that block joined with a space. Otherwise, if there is a rule after
+// the first block, that block is read as the header row in the same way, and
+// every line after it is its own row unless it continues the row above it. A
+// line continues the row above it if the line above has a cell that ends with
+// a "+" marker as printed by psql, in which case the marker is removed, or if
+// opts has TableFit set and the line is found to hold cells that were wrapped
+// as described in wrappedLines. The cells of a row that continues onto more
+// than one line are joined in the same way as those of a block.
+//
+// An error is returned if there is no table in lines, which is the case when
+// there are no rules, no vertical lines, and no more than one column.
+func ParseTable(lines []gem.String, style TableStyle, opts Options) ([][]gem.String, error) {
+	chars := newTableChars(style)
+	lines, layout := chars.tableLines(lines, opts)
+
+	var blocks [][]gem.String
+	var content []gem.String
+	var blockStarts []bool
+	var cur []gem.String
+	hasRules := false
+	for _, line := range lines {
+		if chars.isRule(line, opts) {
+			hasRules = true
+			if len(cur) > 0 {
+				blocks = append(blocks, cur)
+				cur = nil
+			}
+			continue
+		}
+		if isWhitespace(line) {
+			continue
+		}
+		blockStarts = append(blockStarts, len(cur) < 1)
+		cur = append(cur, line)
+		content = append(content, line)
+	}
+	if len(cur) > 0 {
+		blocks = append(blocks, cur)
+	}
+
+	if len(content) < 1 {
+		return nil, fmt.Errorf("no table found")
+	}
+
+	var split [][]gem.String
+	continued := make([]bool, len(content))
+	if layout != nil {
+		var marked []bool
+		if len(layout.columns) > 0 {
+			split, marked = chars.splitAtVerticals(content, *layout, opts)
+		} else {
+			split, marked = chars.splitByVerticals(content, opts)
+		}
+		for i := 1; i < len(content); i++ {
+			continued[i] = marked[i-1] && !blockStarts[i]
+		}
+	} else {
+		split = opts.splitByColumns(content)
+		if !hasRules && len(split[0]) < 2 {
+			return nil, fmt.Errorf("no table found")
+		}
+	}
+	if opts.TableFit {
+		wrapped := opts.wrappedLines(split, blockStarts)
+		for i := range continued {
+			continued[i] = continued[i] || wrapped[i]
+		}
+	}
+
+	var data [][]gem.String
+	lineIdx := 0
+	for i, block := range blocks {
+		blockCells := split[lineIdx : lineIdx+len(block)]
+		blockContinued := continued[lineIdx : lineIdx+len(block)]
+		lineIdx += len(block)
+
+		if len(blocks) > 2 || (len(blocks) > 1 && i == 0) {
+			data = append(data, joinCellLines(blockCells))
+			continue
+		}
+
+		rowStart := 0
+		for j := 1; j <= len(blockCells); j++ {
+			if j < len(blockCells) && blockContinued[j] {
+				continue
+			}
+			if j-rowStart > 1 {
+				data = append(data, joinCellLines(blockCells[rowStart:j]))
+			} else {
+				data = append(data, blockCells[rowStart])
+			}
+			rowStart = j
+		}
+	}
+
+	return data, nil
+}
+
+// verticalLayout gives where the vertical lines between the columns of a
+// table are.
+type verticalLayout struct {
+	// columns holds the text column that each vertical line is at, counted
+	// by width, in order. If it is empty, the vertical lines of each line of
+	// the table do not line up with those of the others and are found in
+	// each line on its own.
+	columns []int
+
+	// lead is whether the table has a column before its first vertical line.
+	lead bool
+
+	// trail is whether the table has a column after its last vertical line.
+	trail bool
+}
+
+// tableLines returns the part of lines that the table in them is drawn in,
+// along with where its vertical lines are. If the table has no vertical lines,
+// the returned layout is nil.
+//
+// If any of lines is a rule, the table is found from the first one. If the
+// rule has junctions in it, such as the "+" of "+---+---+", the vertical lines
+// of the table are at the same columns as those junctions, and every line of
+// the table is a rule or has a vertical line at the same columns as the first
+// and last junctions of the rule; the table is the run of those lines that
+// goes around the rule, and the junctions of each rule in it are used. If no
+// lines but rules are found that way, the table is instead taken to have
+// vertical lines that do not line up, as in a Markdown table that was written
+// by hand, and is the run of rules and lines with vertical lines in them that
+// goes around the rule. If the rule has no junctions, the table has no
+// vertical lines and is the run of lines that are not blank that goes around
+// the rule.
+//
+// If none of lines is a rule, the table is all of lines, and it has vertical
+// lines only at the columns where every line that is not blank has one.
+func (chars tableChars) tableLines(lines []gem.String, opts Options) ([]gem.String, *verticalLayout) {
+	first := -1
+	for i, line := range lines {
+		if chars.isRule(line, opts) {
+			first = i
+			break
+		}
+	}
+
+	if first < 0 {
+		var common map[int]bool
+		for _, line := range lines {
+			if isWhitespace(line) {
+				continue
+			}
+			cols := chars.verticalColumns(line, opts)
+			if common == nil {
+				common = cols
+				continue
+			}
+			for col := range common {
+				if !cols[col] {
+					delete(common, col)
+				}
+			}
+		}
+		if len(common) < 1 {
+			return lines, nil
+		}
+
+		layout := &verticalLayout{}
+		for col := range common {
+			layout.columns = append(layout.columns, col)
+		}
+		sort.Ints(layout.columns)
+		for _, line := range lines {
+			lead, trail := chars.outerText(line, layout.columns, opts)
+			layout.lead = layout.lead || lead
+			layout.trail = layout.trail || trail
+		}
+		return lines, layout
+	}
+
+	runAround := func(inTable func(line gem.String) bool) []gem.String {
+		start, end := first, first+1
+		for start > 0 && inTable(lines[start-1]) {
+			start--
+		}
+		for end < len(lines) && inTable(lines[end]) {
+			end++
+		}
+		return lines[start:end]
+	}
+
+	layout := chars.ruleLayout(lines[first], opts)
+	if len(layout.columns) < 1 {
+		return runAround(func(line gem.String) bool {
+			return !isWhitespace(line)
+		}), nil
+	}
+
+	left, right := layout.columns[0], layout.columns[len(layout.columns)-1]
+	table := runAround(func(line gem.String) bool {
+		if chars.isRule(line, opts) {
+			return true
+		}
+		cols := chars.verticalColumns(line, opts)
+		return cols[left] && cols[right]
+	})
+
+	hasRows := false
+	for _, line := range table {
+		if chars.isRule(line, opts) {
+			ruleLayout := chars.ruleLayout(line, opts)
+			layout.columns = mergeColumns(layout.columns, ruleLayout.columns)
+			layout.lead = layout.lead || ruleLayout.lead
+			layout.trail = layout.trail || ruleLayout.trail
+		} else {
+			hasRows = true
+		}
+	}
+	if hasRows {
+		return table, &layout
+	}
+
+	return runAround(func(line gem.String) bool {
+		return isWhitespace(line) || chars.isRule(line, opts) || chars.hasVertical(line, opts)
+	}), &verticalLayout{}
+}
+
+// ruleLayout returns where the junctions in rule are, as the layout of the
+// vertical lines of the table that rule is from. A junction is any character
+// in rule other than those that draw the horizontal part of a rule and the ":"
+// that marks the alignment of a column in a Markdown table.
+func (chars tableChars) ruleLayout(rule gem.String, opts Options) verticalLayout {
+	var layout verticalLayout
+	textAfter := false
+	positions := opts.textColumns(rule)
+	for i := 0; i < rule.Len(); i++ {
+		if escLen := opts.escapeAt(rule, i); escLen > 0 {
+			i += escLen - 1
+			continue
+		}
+		gc := rule.CharAt(i)
+		if unicode.IsSpace(gc[0]) {
+			continue
+		}
+		ch := string(gc)
+		if chars.horizontal[ch] || ch == ":" || isBoxHorizontal(gc) {
+			if len(layout.columns) < 1 {
+				layout.lead = true
+			}
+			textAfter = true
+			continue
+		}
+		layout.columns = append(layout.columns, positions[i])
+		textAfter = false
+	}
+	layout.trail = textAfter && len(layout.columns) > 0
+	return layout
+}
+
+// isBoxHorizontal returns whether the grapheme cluster gc is one of the
+// characters in the Unicode Box Drawing block that draws only a horizontal
+// line.
+func isBoxHorizontal(gc []rune) bool {
+	return len(gc) == 1 && strings.ContainsRune("─━═┄┅┈┉╌╍╴╶╸╺╼╾", gc[0])
+}
+
+// mergeColumns returns the columns that are in either of a or b, in order.
+func mergeColumns(a, b []int) []int {
+	merged := append([]int{}, a...)
+	for _, col := range b {
+		found := false
+		for _, have := range merged {
+			if have == col {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, col)
+		}
+	}
+	sort.Ints(merged)
+	return merged
+}
+
+// outerText returns whether line has text before the first of the given
+// columns and whether it has text after the last of them.
+func (chars tableChars) outerText(line gem.String, columns []int, opts Options) (before, after bool) {
+	positions := opts.textColumns(line)
+	for i := 0; i < line.Len(); i++ {
+		if escLen := opts.escapeAt(line, i); escLen > 0 {
+			i += escLen - 1
+			continue
+		}
+		if unicode.IsSpace(line.CharAt(i)[0]) {
+			continue
+		}
+		if positions[i] < columns[0] {
+			before = true
+		}
+		if positions[i] > columns[len(columns)-1] {
+			after = true
+		}
+	}
+	return before, after
+}
+
+// verticalColumns returns the text columns, counted by width, that line has a
+// vertical line at.
+func (chars tableChars) verticalColumns(line gem.String, opts Options) map[int]bool {
+	cols := map[int]bool{}
+	positions := opts.textColumns(line)
+	for i := 0; i < line.Len(); i++ {
+		if escLen := opts.escapeAt(line, i); escLen > 0 {
+			i += escLen - 1
+			continue
+		}
+		if chars.isVerticalAt(line, i) {
+			cols[positions[i]] = true
+		}
+	}
+	return cols
+}
+
+// textColumns returns the text column, counted by width, that each grapheme
+// cluster of line starts at. The grapheme clusters of an escape sequence are
+// all at the column it is at.
+func (opts Options) textColumns(line gem.String) []int {
+	positions := make([]int, line.Len())
+	pos := 0
+	for i := 0; i < line.Len(); i++ {
+		positions[i] = pos
+		if escLen := opts.escapeAt(line, i); escLen > 0 {
+			for j := i + 1; j < i+escLen; j++ {
+				positions[j] = pos
+			}
+			i += escLen - 1
+			continue
+		}
+		pos += opts.charWidth(line.CharAt(i))
+	}
+	return positions
+}
+
+// hasVertical returns whether line contains a vertical line.
+func (chars tableChars) hasVertical(line gem.String, opts Options) bool {
+	for i := 0; i < line.Len(); i++ {
+		if escLen := opts.escapeAt(line, i); escLen > 0 {
+			i += escLen - 1
+			continue
+		}
+		if chars.isVerticalAt(line, i) {
+			return true
+		}
+	}
+	return false
+}
+
+// isVerticalAt returns whether the character at index idx of line is a
+// vertical line. A "|" that comes right after a "\" is escaped, as it is in a
+// cell of a Markdown table, and is not a vertical line.
+func (chars tableChars) isVerticalAt(line gem.String, idx int) bool {
+	ch := string(line.CharAt(idx))
+	if !chars.vertical[ch] {
+		return false
+	}
+	return ch != "|" || idx < 1 || string(line.CharAt(idx-1)) != "\\"
+}
+
+// splitAtVerticals splits each of lines into the cells of the columns of a
+// table with the vertical lines given by layout. A line is only split at the
+// vertical lines that are at the columns of layout, so a vertical line in the
+// text of a cell is kept as part of it. If a line has no vertical line at one
+// of those columns, the cell before it spans the columns on both sides of it;
+// its text is given as the cell of the first of those columns and the others
+// are empty. Each cell is cleaned up as described in splitByVerticals, and the
+// returned marked is the same as it is for that function.
+func (chars tableChars) splitAtVerticals(lines []gem.String, layout verticalLayout, opts Options) (split [][]gem.String, marked []bool) {
+	atColumn := map[int]int{}
+	for idx, col := range layout.columns {
+		atColumn[col] = idx
+	}
+	lead := 0
+	if layout.lead {
+		lead = 1
+	}
+	numCols := len(layout.columns) - 1 + lead
+	if layout.trail {
+		numCols++
+	}
+
+	split = make([][]gem.String, len(lines))
+	marked = make([]bool, len(lines))
+	for lineIdx, line := range lines {
+		cells := make([]gem.String, numCols)
+		positions := opts.textColumns(line)
+
+		// the column that the text up to the next vertical line goes in
+		col := lead - 1
+		start := 0
+		addCell := func(end int) {
+			if col < 0 || col >= numCols {
+				return
+			}
+			var cellMarked bool
+			cells[col], cellMarked = cleanCell(line.Sub(start, end))
+			marked[lineIdx] = marked[lineIdx] || cellMarked
+		}
+		for i := 0; i < line.Len(); i++ {
+			if escLen := opts.escapeAt(line, i); escLen > 0 {
+				i += escLen - 1
+				continue
+			}
+			idx, ok := atColumn[positions[i]]
+			if !ok || !chars.isVerticalAt(line, i) {
+				continue
+			}
+			addCell(i)
+			col = idx + lead
+			start = i + 1
+		}
+		addCell(line.Len())
+
+		split[lineIdx] = cells
+	}
+	return split, marked
+}
+
+// splitByVerticals splits each of lines into the cells between its vertical
+// lines. If every line starts with a vertical line, the empty cell before it is
+// dropped, and if every line ends with a vertical line, the empty cell after it
+// is dropped. Each cell is cleaned up as described in cleanCell.
+//
+// The returned marked gives whether each line has a cell that ends with a "+"
+// marker, which psql prints to show that the text of the cell goes on in the
+// line below.
+func (chars tableChars) splitByVerticals(lines []gem.String, opts Options) (split [][]gem.String, marked []bool) {
+	leftBorder, rightBorder := true, true
+	split = make([][]gem.String, len(lines))
+	marked = make([]bool, len(lines))
+	for lineIdx, line := range lines {
+		var cells []gem.String
+		addCell := func(cell gem.String) {
+			cell, cellMarked := cleanCell(cell)
+			marked[lineIdx] = marked[lineIdx] || cellMarked
+			cells = append(cells, cell)
+		}
+
+		start := 0
+		for i := 0; i < line.Len(); i++ {
+			if escLen := opts.escapeAt(line, i); escLen > 0 {
+				i += escLen - 1
+				continue
+			}
+			if chars.isVerticalAt(line, i) {
+				addCell(line.Sub(start, i))
+				start = i + 1
+			}
+		}
+		addCell(line.Sub(start, line.Len()))
+
+		if !cells[0].IsEmpty() {
+			leftBorder = false
+		}
+		if !cells[len(cells)-1].IsEmpty() {
+			rightBorder = false
+		}
+		split[lineIdx] = cells
+	}
+
+	for i := range split {
+		if leftBorder {
+			split[i] = split[i][1:]
+		}
+		if rightBorder && len(split[i]) > 0 {
+			split[i] = split[i][:len(split[i])-1]
+		}
+	}
+	return split, marked
+}
+
+// cleanCell returns the text of a cell from the text between two vertical lines
+// of a table, along with whether it ends with a "+" marker. psql prints the
+// marker in place of the space that pads the end of a cell to show that its
+// text goes on in the line below, so a "+" is only read as a marker if it is
+// the very last character of the text; the marker is removed. Whitespace
+// around the text is removed, and each "\|" in it is replaced with "|".
+func cleanCell(text gem.String) (cell gem.String, marked bool) {
+	if text.Len() > 1 && text.CharAt(text.Len() - 1)[0] == '+' {
+		text = text.Sub(0, text.Len()-1)
+		marked = true
+	}
+	cell = trimCell(text)
+	if strings.Contains(cell.String(), "\\|") {
+		cell = gem.New(strings.ReplaceAll(cell.String(), "\\|", "|"))
+	}
+	return cell, marked
+}
+
+// wrappedLines returns whether each of the split lines of a table holds the
+// rest of cells in the line above it that were wrapped to fit their columns,
+// as they are by MakeTable when TableFit is set. A line can only hold wrapped
+// cells if, in every column that it has text in, the line above it also has
+// text and the first word of the text in the line would not have fit after
+// that text in the width of the column, which is the width of the widest cell
+// in it.
+//
+// Rows that were not wrapped often look like this as well, so a column is only
+// taken to be wrapped if it has text in a line that starts with an empty cell
+// and can hold wrapped cells, as the lines of a wrapped row do when the first
+// column of the table is not wrapped. A line only holds wrapped cells if every
+// column that it has text in is wrapped. The first line of each block, as given
+// by blockStarts, never holds wrapped cells.
+func (opts Options) wrappedLines(split [][]gem.String, blockStarts []bool) []bool {
+	var widths []int
+	for _, cells := range split {
+		for col, cell := range cells {
+			if col >= len(widths) {
+				widths = append(widths, 0)
+			}
+			if w := opts.Width(cell); w > widths[col] {
+				widths[col] = w
+			}
+		}
+	}
+
+	candidates := make([]bool, len(split))
+	wrappedCols := map[int]bool{}
+	for i := 1; i < len(split); i++ {
+		if blockStarts[i] || !opts.continuesCells(split[i-1], split[i], widths) {
+			continue
+		}
+		candidates[i] = true
+		if split[i][0].IsEmpty() {
+			for col, cell := range split[i] {
+				if !cell.IsEmpty() {
+					wrappedCols[col] = true
+				}
+			}
+		}
+	}
+
+	wrapped := make([]bool, len(split))
+	for i := range split {
+		wrapped[i] = candidates[i]
+		for col, cell := range split[i] {
+			if !cell.IsEmpty() && !wrappedCols[col] {
+				wrapped[i] = false
+			}
+		}
+	}
+	return wrapped
+}
+
+// continuesCells returns whether the cells of a line hold the rest of the
+// wrapped cells of the line above it, whose cells are given by above. The
+// width of each column is given in widths.
+func (opts Options) continuesCells(above, cells []gem.String, widths []int) bool {
+	hasText := false
+	for col, cell := range cells {
+		if cell.IsEmpty() {
+			continue
+		}
+		if col >= len(above) || above[col].IsEmpty() {
+			return false
+		}
+		word := opts.splitWords(cell)[0]
+		if opts.Width(above[col])+1+opts.Width(gem.New(word)) <= widths[col] {
+			return false
+		}
+		hasText = true
+	}
+	return hasText
+}
+
+// splitByColumns splits each of lines into the parts of it that are in each of
+// the columns that the lines are laid out in. A column starts wherever the
+// lines have text after a run of whitespace that is at least
+// minParsedColumnGap wide and that goes down every line.
+func (opts Options) splitByColumns(lines []gem.String) [][]gem.String {
+	// find the position of every grapheme cluster first, and which positions
+	// have something other than whitespace on any line.
+	positions := make([][]int, len(lines))
+	var used []bool
+	for lineIdx, line := range lines {
+		positions[lineIdx] = make([]int, line.Len())
+		pos := 0
+		for i := 0; i < line.Len(); i++ {
+			positions[lineIdx][i] = pos
+			if escLen := opts.escapeAt(line, i); escLen > 0 {
+				for j := i + 1; j < i+escLen; j++ {
+					positions[lineIdx][j] = pos
+				}
+				i += escLen - 1
+				continue
+			}
+
+			gc := line.CharAt(i)
+			w := opts.charWidth(gc)
+			for len(used) < pos+w {
+				used = append(used, false)
+			}
+			if !unicode.IsSpace(gc[0]) {
+				for j := pos; j < pos+w; j++ {
+					used[j] = true
+				}
+			}
+			pos += w
+		}
+	}
+
+	var colStarts []int
+	gap := 0
+	for pos := range used {
+		if !used[pos] {
+			gap++
+			continue
+		}
+		if len(colStarts) < 1 || gap >= minParsedColumnGap {
+			colStarts = append(colStarts, pos)
+		}
+		gap = 0
+	}
+	// anything before the first column is whitespace and goes with it.
+	colStarts[0] = 0
+
+	split := make([][]gem.String, len(lines))
+	for lineIdx, line := range lines {
+		cells := make([]gem.String, len(colStarts))
+		start := 0
+		for col := range colStarts {
+			end := start
+			for end < line.Len() && (col+1 >= len(colStarts) || positions[lineIdx][end] < colStarts[col+1]) {
+				end++
+			}
+			cells[col] = trimCell(line.Sub(start, end))
+			start = end
+		}
+		split[lineIdx] = cells
+	}
+	return split
+}
+
+// joinCellLines joins the cells of several lines that make up one row into the
+// cells of that row. The text of the cells in each column is joined with a
+// space, skipping those that are empty.
+func joinCellLines(lines [][]gem.String) []gem.String {
+	var row []gem.String
+	for _, cells := range lines {
+		for col, cell := range cells {
+			if col >= len(row) {
+				row = append(row, gem.Zero)
+			}
+			if cell.IsEmpty() {
+				continue
+			}
+			if !row[col].IsEmpty() {
+				row[col] = row[col].Add(gem.New(" "))
+			}
+			row[col] = row[col].Add(cell)
+		}
+	}
+	return row
+}
+
+// trimCell removes the whitespace from the start and end of the text of a cell.
+func trimCell(text gem.String) gem.String {
+	text = text.Sub(CountLeadingWhitespace(text), text.Len())
+	return text.Sub(0, text.Len()-CountTrailingWhitespace(text))
+}
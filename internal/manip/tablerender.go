@@ -0,0 +1,547 @@
+package manip
+
+// This file contains the routines for drawing a table once it has been laid
+// out. The layout of a table is the same no matter what it is drawn as, so
+// each format only needs to know how to draw the cells it is given.
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/dekarrin/rosed/internal/gem"
+	"github.com/dekarrin/rosed/internal/tb"
+)
+
+// TableSpec is what a table is laid out from.
+type TableSpec struct {
+	// Data is the rows of cells in the table.
+	Data [][]TableCell
+
+	// Width is the width to make the table.
+	Width int
+
+	// Header is whether the first row of Data is the header of the table.
+	Header bool
+
+	// Footer is whether the last row of Data is the footer of the table.
+	Footer bool
+
+	// Rules are the indexes of the rows of Data that have a rule drawn under
+	// them to separate them from the rows below.
+	Rules []int
+
+	// Border is whether the table has a border.
+	Border bool
+
+	// Style is the characters that the table is drawn with.
+	Style TableStyle
+
+	// Fit is whether the table is narrowed to fit within Width if it is wider
+	// than that.
+	Fit bool
+
+	// MinColumnWidth is the least content width of each column.
+	MinColumnWidth int
+
+	// CellLines is whether the text of each cell is split into a line of the
+	// cell at each line separator in it.
+	CellLines bool
+}
+
+// TableLayout is a table whose cells have been placed in its rows and columns
+// and whose columns have been given their widths.
+type TableLayout struct {
+	grid tableGrid
+
+	// ColWidths is the width of each column including its padding.
+	ColWidths []int
+
+	// Width is the width of the whole table.
+	Width int
+
+	// Header is whether the first row of the table is its header.
+	Header bool
+
+	// Footer is whether the last row of the table is its footer. It is never
+	// set for a table with only one row.
+	Footer bool
+
+	// Rules are the indexes of the rows that have a rule drawn under them to
+	// separate them from the rows below.
+	Rules []int
+
+	// Border is whether the table has a border.
+	Border bool
+
+	// Style is the characters that the table is drawn with.
+	Style TableStyle
+}
+
+// LayoutCell is a cell of a TableLayout.
+type LayoutCell struct {
+	// Row and Col are the indexes of the row and column that the cell starts
+	// in.
+	Row int
+	Col int
+
+	// RowSpan and ColSpan are the number of rows and columns that the cell
+	// takes up.
+	RowSpan int
+	ColSpan int
+
+	// Text is the content of the cell as it was given.
+	Text gem.String
+
+	// Lines is the content of the cell split into the lines that it is laid
+	// out on.
+	Lines []gem.String
+}
+
+// Rows returns the number of rows in layout.
+func (layout TableLayout) Rows() int {
+	return len(layout.grid.slots)
+}
+
+// Cells returns every cell in layout, in order from the top row to the bottom
+// and from the leftmost column to the rightmost within each row.
+func (layout TableLayout) Cells() []LayoutCell {
+	var cells []LayoutCell
+	for row := range layout.grid.slots {
+		for col := 0; col < len(layout.grid.slots[row]); col++ {
+			pc := layout.grid.slots[row][col]
+			if pc.row != row || pc.col != col {
+				continue
+			}
+			lines := make([]gem.String, len(pc.lines))
+			copy(lines, pc.lines)
+			cells = append(cells, LayoutCell{
+				Row: pc.row, Col: pc.col, RowSpan: pc.rowSpan, ColSpan: pc.colSpan,
+				Text: pc.text, Lines: lines,
+			})
+		}
+	}
+	return cells
+}
+
+// WithCells returns a copy of layout whose cells are cells in a table with the
+// given number of rows. Any slot of the table that no cell takes up is given
+// an empty cell of its own, and cells that go past the last row or the last
+// column of ColWidths are cut off at it.
+func (layout TableLayout) WithCells(cells []LayoutCell, rows int) TableLayout {
+	cols := len(layout.ColWidths)
+	grid := tableGrid{slots: make([][]*placedCell, rows), cols: cols}
+	for _, c := range cells {
+		if c.Row < 0 || c.Row >= rows || c.Col < 0 || c.Col >= cols {
+			continue
+		}
+		pc := &placedCell{row: c.Row, col: c.Col, rowSpan: c.RowSpan, colSpan: c.ColSpan, text: c.Text, lines: c.Lines}
+		if pc.rowSpan < 1 || pc.row+pc.rowSpan > rows {
+			pc.rowSpan = rows - pc.row
+		}
+		if pc.colSpan < 1 || pc.col+pc.colSpan > cols {
+			pc.colSpan = cols - pc.col
+		}
+		if len(pc.lines) < 1 {
+			pc.lines = []gem.String{pc.text}
+		}
+		grid.place(pc)
+	}
+	for row := range grid.slots {
+		for col := 0; col < cols; col++ {
+			if col >= len(grid.slots[row]) || grid.slots[row][col] == nil {
+				grid.place(&placedCell{row: row, col: col, rowSpan: 1, colSpan: 1, lines: []gem.String{gem.Zero}})
+			}
+		}
+	}
+	layout.grid = grid
+	return layout
+}
+
+// ruleUnder returns whether a rule from Rules is drawn under the row at index
+// row. There is never one under the header, above the footer, or under the last
+// row, as those already have a rule or border of their own.
+func (layout TableLayout) ruleUnder(row int) bool {
+	last := len(layout.grid.slots) - 1
+	if row < 0 || row >= last || (layout.Header && row == 0) || (layout.Footer && row+1 == last) {
+		return false
+	}
+	for _, r := range layout.Rules {
+		if r == row {
+			return true
+		}
+	}
+	return false
+}
+
+// TableRenderer draws tables.
+type TableRenderer interface {
+	// Prepare returns the TableSpec that a table is laid out from before it is
+	// drawn by the TableRenderer, given the one that was asked for. This lets
+	// a TableRenderer change things such as whether the table has a border to
+	// suit what it draws.
+	Prepare(spec TableSpec, lineSep gem.String) TableSpec
+
+	// Render draws the table given by layout, with each line separated by
+	// lineSep.
+	Render(layout TableLayout, lineSep gem.String, opts Options) tb.Block
+}
+
+var (
+	// TextRenderer draws tables as fixed-width text with the header, border,
+	// and style that they are given.
+	TextRenderer TableRenderer = textRenderer{kind: textPlain}
+
+	// MarkdownRenderer draws tables as GitHub-flavored Markdown pipe tables.
+	MarkdownRenderer TableRenderer = textRenderer{kind: textMarkdown}
+
+	// RSTGridRenderer draws tables as reStructuredText grid tables.
+	RSTGridRenderer TableRenderer = textRenderer{kind: textRSTGrid}
+
+	// RSTSimpleRenderer draws tables as reStructuredText simple tables.
+	RSTSimpleRenderer TableRenderer = textRenderer{kind: textRSTSimple}
+
+	// HTMLRenderer draws tables as HTML table elements.
+	HTMLRenderer TableRenderer = htmlRenderer{}
+
+	// LaTeXRenderer draws tables as LaTeX tabular environments.
+	LaTeXRenderer TableRenderer = latexRenderer{}
+)
+
+// rstGridStyle is the TableStyle that reStructuredText grid tables are drawn
+// with.
+var rstGridStyle = TableStyle{
+	TopLeft: gem.New("+"), Top: gem.New("-"), TopJunction: gem.New("+"), TopRight: gem.New("+"),
+	Vertical:     gem.New("|"),
+	LeftJunction: gem.New("+"), Horizontal: gem.New("-"), Cross: gem.New("+"), RightJunction: gem.New("+"),
+	HeaderLeft: gem.New("+"), HeaderHorizontal: gem.New("="), HeaderCross: gem.New("+"), HeaderRight: gem.New("+"),
+	BottomLeft: gem.New("+"), Bottom: gem.New("-"), BottomJunction: gem.New("+"), BottomRight: gem.New("+"),
+}
+
+// unspannedCells returns the cells of data with none of them spanning more
+// than one column or row.
+func unspannedCells(data [][]TableCell) [][]TableCell {
+	cells := make([][]TableCell, len(data))
+	for row := range data {
+		cells[row] = make([]TableCell, len(data[row]))
+		for col := range data[row] {
+			cells[row][col] = TableCell{Text: data[row][col].Text}
+		}
+	}
+	return cells
+}
+
+// rstSimpleCells returns the cells of data as they are put in a
+// reStructuredText simple table. No cell spans more than one column or row. A
+// line in a simple table whose first column is empty continues the row above
+// it, so each lineSep in a cell of the first column is replaced with a space,
+// and an empty cell in the first column is given an empty comment, "..", in
+// its place.
+func rstSimpleCells(data [][]TableCell, lineSep gem.String) [][]TableCell {
+	cells := unspannedCells(data)
+	for row := range cells {
+		if len(cells[row]) < 1 {
+			continue
+		}
+		text := cells[row][0].Text.String()
+		if !lineSep.IsEmpty() {
+			text = strings.ReplaceAll(text, lineSep.String(), " ")
+		}
+		if strings.TrimSpace(text) == "" {
+			text = ".."
+		}
+		cells[row][0] = TableCell{Text: gem.New(text)}
+	}
+	return cells
+}
+
+// rowRule draws the rule between the row at index above and the row after it
+// in a bordered table with columns of the given widths. Where a cell spans
+// both rows, the rule is left out and the borders at its sides are drawn
+// through it instead.
+func (grid tableGrid) rowRule(colWidths []int, above int, style TableStyle) gem.String {
+	below := above + 1
+	spans := func(col int) bool {
+		return grid.slots[above][col] == grid.slots[below][col]
+	}
+
+	bar := style.LeftJunction
+	if spans(0) {
+		bar = style.Vertical
+	}
+	for col := range colWidths {
+		if spans(col) {
+			bar = bar.Add(gem.RepeatStr(" ", colWidths[col]))
+		} else {
+			bar = bar.Add(gem.RepeatStr(style.Horizontal.String(), colWidths[col]))
+		}
+
+		if col+1 >= len(colWidths) {
+			continue
+		}
+
+		leftSpans, rightSpans := spans(col), spans(col+1)
+		inAbove := grid.slots[above][col] != grid.slots[above][col+1]
+		inBelow := grid.slots[below][col] != grid.slots[below][col+1]
+		switch {
+		case leftSpans && rightSpans && !inAbove:
+			bar = bar.Add(gem.New(" "))
+		case leftSpans && rightSpans:
+			bar = bar.Add(style.Vertical)
+		case leftSpans:
+			bar = bar.Add(style.LeftJunction)
+		case rightSpans:
+			bar = bar.Add(style.RightJunction)
+		case inAbove && inBelow:
+			bar = bar.Add(style.Cross)
+		case inBelow:
+			bar = bar.Add(style.TopJunction)
+		case inAbove:
+			bar = bar.Add(style.BottomJunction)
+		default:
+			bar = bar.Add(style.Horizontal)
+		}
+	}
+
+	if spans(len(colWidths) - 1) {
+		return bar.Add(style.Vertical)
+	}
+	return bar.Add(style.RightJunction)
+}
+
+// rstSimpleRule draws a rule of a reStructuredText simple table with columns
+// of the given widths. Each column but the last is followed by the space that
+// separates it from the next.
+func rstSimpleRule(colWidths []int) gem.String {
+	var sb strings.Builder
+	for col, w := range colWidths {
+		if col+1 < len(colWidths) {
+			sb.WriteString(strings.Repeat("=", w-minNonBorderInterColumnPadding))
+			sb.WriteString(strings.Repeat(" ", minNonBorderInterColumnPadding))
+		} else {
+			sb.WriteString(strings.Repeat("=", w))
+		}
+	}
+	return gem.New(sb.String())
+}
+
+// cellText returns the text of the cell pc as it is drawn by a renderer that
+// does not lay out the text itself, with any escape sequences removed and the
+// header case applied if it is in the header.
+func (opts Options) cellText(pc *placedCell, header bool) gem.String {
+	text := pc.text
+	if opts.ANSIEscapes {
+		text = text.StripEscapes()
+	}
+	if header && pc.row == 0 {
+		text = opts.headerCase(text)
+	}
+	return text
+}
+
+// rowStarts returns the cells that start in the row at index row of grid, in
+// order from left to right.
+func (grid tableGrid) rowStarts(row int) []*placedCell {
+	var cells []*placedCell
+	for col := 0; col < grid.cols; col += grid.slots[row][col].colSpan {
+		pc := grid.slots[row][col]
+		if pc.row == row {
+			cells = append(cells, pc)
+		}
+	}
+	return cells
+}
+
+// htmlRenderer is a TableRenderer that draws tables as HTML table elements.
+type htmlRenderer struct{}
+
+// Prepare returns spec with fitting turned off, as the text of an HTML table
+// is laid out by whatever shows it.
+func (htmlRenderer) Prepare(spec TableSpec, lineSep gem.String) TableSpec {
+	spec.Fit = false
+	return spec
+}
+
+// Render draws the table given by layout as an HTML table element. The header
+// row is put in a thead element, the footer row in a tfoot element, and the
+// other rows in a tbody element, with a new tbody started after each row that
+// has a rule under it. The
+// text of each cell is escaped, each lineSep in it is replaced with a "<br>"
+// tag, and cells in columns that are not aligned to the left are given a
+// text-align style.
+func (htmlRenderer) Render(layout TableLayout, lineSep gem.String, opts Options) tb.Block {
+	grid := layout.grid
+	tableBlock := tb.New(gem.Zero, lineSep)
+
+	lastRow := len(grid.slots) - 1
+
+	tableBlock.Append(gem.New("<table>"))
+	for row := range grid.slots {
+		isHeader := layout.Header && row == 0
+		isFooter := layout.Footer && row == lastRow
+		if isHeader {
+			tableBlock.Append(gem.New("  <thead>"))
+		} else if isFooter {
+			tableBlock.Append(gem.New("  <tfoot>"))
+		} else if row == 0 || (layout.Header && row == 1) || layout.ruleUnder(row-1) {
+			tableBlock.Append(gem.New("  <tbody>"))
+		}
+
+		tableBlock.Append(gem.New("    <tr>"))
+		for _, pc := range grid.rowStarts(row) {
+			tag := "td"
+			if isHeader {
+				tag = "th"
+			}
+
+			var attrs string
+			if pc.colSpan > 1 {
+				attrs += fmt.Sprintf(` colspan="%d"`, pc.colSpan)
+			}
+			if pc.rowSpan > 1 {
+				attrs += fmt.Sprintf(` rowspan="%d"`, pc.rowSpan)
+			}
+			switch opts.columnAlignment(pc.col) {
+			case ColumnRight, ColumnDecimal:
+				attrs += ` style="text-align: right"`
+			case ColumnCenter:
+				attrs += ` style="text-align: center"`
+			}
+
+			text := html.EscapeString(opts.cellText(pc, layout.Header).String())
+			if !lineSep.IsEmpty() {
+				text = strings.ReplaceAll(text, html.EscapeString(lineSep.String()), "<br>")
+			}
+
+			tableBlock.Append(gem.New(fmt.Sprintf("      <%s%s>%s</%s>", tag, attrs, text, tag)))
+		}
+		tableBlock.Append(gem.New("    </tr>"))
+
+		if isHeader {
+			tableBlock.Append(gem.New("  </thead>"))
+		} else if isFooter {
+			tableBlock.Append(gem.New("  </tfoot>"))
+		} else if row == lastRow || (layout.Footer && row+1 == lastRow) || layout.ruleUnder(row) {
+			tableBlock.Append(gem.New("  </tbody>"))
+		}
+	}
+	tableBlock.Append(gem.New("</table>"))
+
+	return tableBlock
+}
+
+// latexSpecials maps each character that has a special meaning in LaTeX to
+// what it is written as to have it appear as itself.
+var latexSpecials = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+	`<`, `\textless{}`,
+	`>`, `\textgreater{}`,
+)
+
+// latexRenderer is a TableRenderer that draws tables as LaTeX tabular
+// environments.
+type latexRenderer struct{}
+
+// Prepare returns spec with fitting turned off, as the text of a LaTeX table is
+// laid out by LaTeX.
+func (latexRenderer) Prepare(spec TableSpec, lineSep gem.String) TableSpec {
+	spec.Fit = false
+	return spec
+}
+
+// Render draws the table given by layout as a LaTeX tabular environment. Each
+// column is given the alignment "l", "r", or "c", and if the table has a
+// border, vertical lines are put between the columns and an \hline is put at
+// the top and bottom. An \hline is put under the header row, above the footer
+// row, and under each row that has a rule under it. The special
+// characters in the text of each cell are escaped, and each lineSep in it is
+// replaced with a space.
+//
+// A cell that spans more than one column is put in a \multicolumn, and one
+// that spans more than one row is put in a \multirow, which needs the multirow
+// package.
+func (latexRenderer) Render(layout TableLayout, lineSep gem.String, opts Options) tb.Block {
+	grid := layout.grid
+	tableBlock := tb.New(gem.Zero, lineSep)
+
+	var colSpec strings.Builder
+	if layout.Border {
+		colSpec.WriteString("|")
+	}
+	for col := 0; col < grid.cols; col++ {
+		colSpec.WriteString(latexAlignment(opts.columnAlignment(col)))
+		if layout.Border {
+			colSpec.WriteString("|")
+		}
+	}
+
+	tableBlock.Append(gem.New(`\begin{tabular}{` + colSpec.String() + `}`))
+	if layout.Border {
+		tableBlock.Append(gem.New(`\hline`))
+	}
+
+	for row := range grid.slots {
+		var cells []string
+		for col := 0; col < grid.cols; col += grid.slots[row][col].colSpan {
+			pc := grid.slots[row][col]
+
+			var text string
+			if pc.row == row {
+				text = latexSpecials.Replace(opts.cellText(pc, layout.Header).String())
+				if !lineSep.IsEmpty() {
+					text = strings.ReplaceAll(text, lineSep.String(), " ")
+				}
+				if pc.rowSpan > 1 {
+					text = fmt.Sprintf(`\multirow{%d}{*}{%s}`, pc.rowSpan, text)
+				}
+			}
+
+			if pc.colSpan > 1 {
+				align := latexAlignment(opts.columnAlignment(col))
+				if layout.Border {
+					align += "|"
+					if col == 0 {
+						align = "|" + align
+					}
+				}
+				text = fmt.Sprintf(`\multicolumn{%d}{%s}{%s}`, pc.colSpan, align, text)
+			}
+			cells = append(cells, text)
+		}
+		tableBlock.Append(gem.New(strings.Join(cells, " & ") + ` \\`))
+
+		if layout.Header && row == 0 && (len(grid.slots) > 1 || !layout.Border) {
+			tableBlock.Append(gem.New(`\hline`))
+		} else if (layout.Footer && row+2 == len(grid.slots)) || layout.ruleUnder(row) {
+			tableBlock.Append(gem.New(`\hline`))
+		}
+	}
+
+	if layout.Border {
+		tableBlock.Append(gem.New(`\hline`))
+	}
+	tableBlock.Append(gem.New(`\end{tabular}`))
+
+	return tableBlock
+}
+
+// latexAlignment returns the LaTeX column alignment for align.
+func latexAlignment(align ColumnAlignment) string {
+	switch align {
+	case ColumnRight, ColumnDecimal:
+		return "r"
+	case ColumnCenter:
+		return "c"
+	default:
+		return "l"
+	}
+}
@@ -0,0 +1,235 @@
+package manip
+
+// This file contains the routines for giving the columns of a table the widths
+// that they are constrained to, and for checking whether those constraints can
+// all be met.
+
+import (
+	"fmt"
+
+	"github.com/dekarrin/rosed/internal/gem"
+)
+
+// ColumnWidth is a constraint on the content width of one column of a table.
+// The zero value places no constraint on the column.
+type ColumnWidth struct {
+	// Fixed is the exact width of the column. If 0, the column is not fixed.
+	Fixed int
+
+	// Min is the least width of the column.
+	Min int
+
+	// Max is the greatest width of the column. If 0, it has no maximum.
+	Max int
+
+	// Percent is the percentage of the width of the table that the column is
+	// given, not counting the space that padding and borders take up. If 0,
+	// the column is not given a percentage.
+	Percent int
+
+	// Weight is the share of the spare width of the table that the column is
+	// given relative to the other columns with a weight.
+	Weight int
+}
+
+// isSet returns whether cw sets the width of its column exactly.
+func (cw ColumnWidth) isSet() bool {
+	return cw.Fixed > 0 || cw.Percent > 0
+}
+
+// target returns the width that cw sets its column to in a table whose columns
+// have avail width to share between them, or -1 if cw does not set the width
+// of its column.
+func (cw ColumnWidth) target(avail int) int {
+	if cw.Fixed > 0 {
+		return cw.Fixed
+	}
+	if cw.Percent > 0 {
+		w := avail * cw.Percent / 100
+		if w < 1 {
+			w = 1
+		}
+		return w
+	}
+	return -1
+}
+
+// clamp returns width limited to the minimum and maximum width of cw.
+func (cw ColumnWidth) clamp(width int) int {
+	if width < cw.Min {
+		width = cw.Min
+	}
+	if cw.Max > 0 && width > cw.Max {
+		width = cw.Max
+	}
+	return width
+}
+
+// columnWidth returns the width constraint on the column at index col.
+func (opts Options) columnWidth(col int) ColumnWidth {
+	if col < 0 || col >= len(opts.TableColumnWidths) {
+		return ColumnWidth{}
+	}
+	return opts.TableColumnWidths[col]
+}
+
+// constrainColumnWidths returns colContentWidths with the width of each column
+// changed to meet its width constraint. avail is the width that the content of
+// the columns has to share between them. If the constraint on a column cannot
+// be met, it is given the width closest to it that can.
+func (opts Options) constrainColumnWidths(colContentWidths []int, avail int) []int {
+	widths := make([]int, len(colContentWidths))
+	for col := range colContentWidths {
+		cw := opts.columnWidth(col)
+		widths[col] = colContentWidths[col]
+		if t := cw.target(avail); t >= 0 {
+			widths[col] = t
+		}
+		widths[col] = cw.clamp(widths[col])
+	}
+	return widths
+}
+
+// spreadSpace adds up to space to the widths of the columns of a table and
+// returns how much of it was added. widths are the widths of the columns
+// including their padding and contentWidths are the widths of their content
+// alone.
+//
+// Columns whose width is set exactly by their constraint are given none of the
+// space, and no column is made wider than its maximum width. If any of the
+// columns that can take space have a weight, the space is shared among those
+// in proportion to their weights; otherwise, it is shared evenly. Space that
+// does not divide between them goes to the leftmost columns.
+func (opts Options) spreadSpace(widths, contentWidths []int, space int) int {
+	growth := make([]int, len(widths))
+	added := 0
+
+	for space > 0 {
+		var growable []int
+		totalWeight := 0
+		for col := range widths {
+			cw := opts.columnWidth(col)
+			if cw.isSet() || (cw.Max > 0 && contentWidths[col]+growth[col] >= cw.Max) {
+				continue
+			}
+			growable = append(growable, col)
+			if cw.Weight > 0 {
+				totalWeight += cw.Weight
+			}
+		}
+		if len(growable) < 1 {
+			break
+		}
+
+		shares := make([]int, 0, len(growable))
+		if totalWeight > 0 {
+			var weighted []int
+			given := 0
+			for _, col := range growable {
+				weight := opts.columnWidth(col).Weight
+				if weight < 1 {
+					continue
+				}
+				weighted = append(weighted, col)
+				shares = append(shares, space*weight/totalWeight)
+				given += shares[len(shares)-1]
+			}
+			for i := 0; given < space; i++ {
+				shares[i]++
+				given++
+			}
+			growable = weighted
+		} else {
+			spacePerColumn := space / len(growable)
+			remSpace := space % len(growable)
+			for i := range growable {
+				shares = append(shares, spacePerColumn)
+				if i < remSpace {
+					shares[i]++
+				}
+			}
+		}
+
+		for i, col := range growable {
+			share := shares[i]
+			cw := opts.columnWidth(col)
+			if room := cw.Max - contentWidths[col] - growth[col]; cw.Max > 0 && share > room {
+				share = room
+			}
+			growth[col] += share
+			space -= share
+			added += share
+		}
+	}
+
+	for col := range widths {
+		widths[col] += growth[col]
+	}
+	return added
+}
+
+// CheckColumnWidths checks whether the width constraints on the columns in
+// opts can all be met by a table with cols columns that is made to be width
+// wide. border and style are the same as they are given to MakeSpannedTable.
+// If any column has a width or minimum width that it needs to be given, each
+// column without one is counted as being one character wide when checking
+// whether they all fit within width. If any of the constraints cannot be met,
+// an error describing the first one found is returned.
+func CheckColumnWidths(cols, width int, border bool, style TableStyle, opts Options) error {
+	renderer := opts.tableRenderer()
+	spec := renderer.Prepare(TableSpec{Width: width, Border: border, Style: style, Fit: opts.TableFit}, gem.Zero)
+
+	_, overhead := opts.padColumnWidths(make([]int, cols), spec.Border, spec.Style)
+	avail := width - overhead
+
+	needed := overhead
+	totalPercent := 0
+	widened := false
+	for col := 0; col < cols; col++ {
+		cw := opts.columnWidth(col)
+
+		if cw.Fixed < 0 || cw.Min < 0 || cw.Max < 0 || cw.Percent < 0 || cw.Weight < 0 {
+			return fmt.Errorf("column %d: width constraint cannot be negative", col)
+		}
+		if cw.Max > 0 && cw.Min > cw.Max {
+			return fmt.Errorf("column %d: minimum width %d is more than maximum width %d", col, cw.Min, cw.Max)
+		}
+		if cw.Fixed > 0 && cw.Percent > 0 {
+			return fmt.Errorf("column %d: cannot have both a fixed and a percentage width", col)
+		}
+		if cw.Percent > 100 {
+			return fmt.Errorf("column %d: percentage width %d%% is more than 100%%", col, cw.Percent)
+		}
+
+		colWidth := 1
+		if spec.MinColumnWidth > colWidth {
+			colWidth = spec.MinColumnWidth
+		}
+		if cw.Min > colWidth {
+			colWidth = cw.Min
+			widened = true
+		}
+		if t := cw.target(avail); t >= 0 {
+			if t < cw.Min {
+				return fmt.Errorf("column %d: width %d is less than minimum width %d", col, t, cw.Min)
+			}
+			if cw.Max > 0 && t > cw.Max {
+				return fmt.Errorf("column %d: width %d is more than maximum width %d", col, t, cw.Max)
+			}
+			colWidth = t
+			widened = true
+		}
+
+		needed += colWidth
+		totalPercent += cw.Percent
+	}
+
+	if totalPercent > 100 {
+		return fmt.Errorf("percentage widths add up to %d%%, which is more than 100%%", totalPercent)
+	}
+	if widened && needed > width {
+		return fmt.Errorf("columns need a table at least %d wide, but width is %d", needed, width)
+	}
+
+	return nil
+}
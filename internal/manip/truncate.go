@@ -0,0 +1,126 @@
+package manip
+
+// This file contains the routines for shortening text to fit within a width by
+// replacing the part of it that is removed with an ellipsis.
+
+import (
+	"github.com/dekarrin/rosed/internal/gem"
+)
+
+// TruncateTail shortens text so that it fits within width by removing grapheme
+// clusters from its end and putting ellipsis in their place. If text already
+// fits, it is returned unchanged. If ellipsis does not fit within width, text
+// is shortened without it.
+//
+// If opts has ANSIEscapes set, escape sequences are never split and any SGR
+// styling that is left active by the text that is kept is closed before the
+// ellipsis.
+func TruncateTail(text gem.String, width int, ellipsis gem.String, opts Options) gem.String {
+	if opts.Width(text) <= width {
+		return text
+	}
+	ellipsis = opts.fittingEllipsis(ellipsis, width)
+
+	keep := opts.fit(text, width-opts.Width(ellipsis))
+	return opts.closeStyles(text.Sub(0, keep)).Add(ellipsis)
+}
+
+// TruncateHead shortens text so that it fits within width by removing grapheme
+// clusters from its start and putting ellipsis in their place. If text already
+// fits, it is returned unchanged. If ellipsis does not fit within width, text
+// is shortened without it.
+//
+// If opts has ANSIEscapes set, escape sequences are never split and any SGR
+// styling that is active at the start of the text that is kept is reopened
+// after the ellipsis.
+func TruncateHead(text gem.String, width int, ellipsis gem.String, opts Options) gem.String {
+	if opts.Width(text) <= width {
+		return text
+	}
+	ellipsis = opts.fittingEllipsis(ellipsis, width)
+
+	start := opts.fitEnd(text, width-opts.Width(ellipsis))
+	return ellipsis.Add(opts.reopenStyles(text, start))
+}
+
+// TruncateMiddle shortens text so that it fits within width by removing
+// grapheme clusters from its middle and putting ellipsis in their place. The
+// text that is kept is split as evenly as possible between the start and the
+// end of text, with the start getting any extra. If text already fits, it is
+// returned unchanged. If ellipsis does not fit within width, text is shortened
+// without it.
+//
+// If opts has ANSIEscapes set, escape sequences are never split, SGR styling
+// that is left active by the kept start of the text is closed before the
+// ellipsis, and SGR styling that is active at the kept end of the text is
+// reopened after it.
+func TruncateMiddle(text gem.String, width int, ellipsis gem.String, opts Options) gem.String {
+	if opts.Width(text) <= width {
+		return text
+	}
+	ellipsis = opts.fittingEllipsis(ellipsis, width)
+
+	avail := width - opts.Width(ellipsis)
+	headEnd := opts.fit(text, avail-avail/2)
+	head := text.Sub(0, headEnd)
+
+	tailStart := opts.fitEnd(text, avail-opts.Width(head))
+	if tailStart < headEnd {
+		tailStart = headEnd
+	}
+
+	return opts.closeStyles(head).Add(ellipsis).Add(opts.reopenStyles(text, tailStart))
+}
+
+// fittingEllipsis returns ellipsis if it fits within width, or the empty string
+// if it does not.
+func (opts Options) fittingEllipsis(ellipsis gem.String, width int) gem.String {
+	if opts.Width(ellipsis) > width {
+		return gem.Zero
+	}
+	return ellipsis
+}
+
+// fitEnd returns the index of the first grapheme cluster of the longest part
+// at the end of text that will fit within the given width as measured using
+// the options. Escape sequences are never split.
+func (opts Options) fitEnd(text gem.String, width int) int {
+	// find the width of every grapheme cluster first, as escape sequences can
+	// only be found by reading forward.
+	widths := make([]int, text.Len())
+	for i := 0; i < text.Len(); i++ {
+		if escLen := opts.escapeAt(text, i); escLen > 0 {
+			i += escLen - 1
+			continue
+		}
+		widths[i] = opts.charWidth(text.CharAt(i))
+	}
+
+	// escape sequences take up no width, so the part never starts within one;
+	// it either starts at the start of text or just after a grapheme cluster
+	// that did not fit.
+	used := 0
+	start := text.Len()
+	for start > 0 && used+widths[start-1] <= width {
+		used += widths[start-1]
+		start--
+	}
+	return start
+}
+
+// reopenStyles returns the part of text that starts at index start with the
+// SGR styling that is active at that point in text added to the start of it.
+// If escape sequences are not being recognized, the part of text is returned
+// as it is.
+func (opts Options) reopenStyles(text gem.String, start int) gem.String {
+	kept := text.Sub(start, text.Len())
+	if !opts.ANSIEscapes {
+		return kept
+	}
+
+	active := activeStyles(nil, text.Sub(0, start))
+	for i := len(active) - 1; i >= 0; i-- {
+		kept = active[i].Add(kept)
+	}
+	return kept
+}
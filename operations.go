@@ -3,7 +3,9 @@ package rosed
 // this file contains operations performed by Editors.
 
 import (
+	"encoding/csv"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/dekarrin/rosed/internal/gem"
@@ -24,6 +26,11 @@ import (
 //
 // This function is affected by the following [Options]:
 //
+//   - ANSIEscapes sets whether ANSI escape sequences are treated as
+//     zero-width units that are never split.
+//   - DisplayWidth sets whether the width of each line is measured by the
+//     number of terminal cells it takes up instead of by the number of
+//     characters in it.
 //   - LineSeparator is used to separate lines of input.
 //   - ParagraphSeparator is the separator used to split paragraphs. It will
 //     only have effect if PreserveParagraphs is set to true.
@@ -68,7 +75,7 @@ func (ed Editor) AlignOpts(align Alignment, width int, opts Options) Editor {
 				endLineIdx := bl.Len() - 1
 				bl.Set(0, bl.Line(0).Add(sepStart))
 				bl.Apply(func(idx int, line string) []string {
-					return []string{manip.AlignLineLeft(gem.New(line), width).String()}
+					return []string{manip.AlignLineLeft(gem.New(line), width, opts.layout()).String()}
 				})
 				// remove separators (if any)
 				if sepStart.Len() > 0 {
@@ -85,7 +92,7 @@ func (ed Editor) AlignOpts(align Alignment, width int, opts Options) Editor {
 				endLineIdx := bl.Len() - 1
 				bl.Set(endLineIdx, sepEnd.Add(bl.Line(endLineIdx)))
 				bl.Apply(func(idx int, line string) []string {
-					return []string{manip.AlignLineRight(gem.New(line), width).String()}
+					return []string{manip.AlignLineRight(gem.New(line), width, opts.layout()).String()}
 				})
 				// remove separators (if any)
 				if sepStart.Len() > 0 {
@@ -100,7 +107,7 @@ func (ed Editor) AlignOpts(align Alignment, width int, opts Options) Editor {
 				// dont pre-add anyfin so center can work its magic
 				bl = tb.New(para, gem.New(opts.LineSeparator))
 				bl.Apply(func(idx int, line string) []string {
-					return []string{manip.AlignLineCenter(gem.New(line), width).String()}
+					return []string{manip.AlignLineCenter(gem.New(line), width, opts.layout()).String()}
 				})
 
 				// now work out how much needs to be removed from the start:
@@ -153,11 +160,11 @@ func (ed Editor) AlignOpts(align Alignment, width int, opts Options) Editor {
 	return ed.ApplyOpts(func(idx int, line string) []string {
 		switch align {
 		case Left:
-			return []string{manip.AlignLineLeft(gem.New(line), width).String()}
+			return []string{manip.AlignLineLeft(gem.New(line), width, opts.layout()).String()}
 		case Right:
-			return []string{manip.AlignLineRight(gem.New(line), width).String()}
+			return []string{manip.AlignLineRight(gem.New(line), width, opts.layout()).String()}
 		case Center:
-			return []string{manip.AlignLineCenter(gem.New(line), width).String()}
+			return []string{manip.AlignLineCenter(gem.New(line), width, opts.layout()).String()}
 		default:
 			return []string{line}
 		}
@@ -265,11 +272,86 @@ func (ed Editor) ApplyParagraphsOpts(op ParagraphOperation, opts Options) Editor
 	}, opts)
 }
 
+// ApplySentences applies the given SentenceOperation to each sentence in the
+// text of the Editor. Sentences are found the same way as in
+// [Editor.Sentences].
+//
+// The SentenceOperation will receive each sentence without any of the
+// whitespace around it. Any whitespace that follows a sentence is kept after
+// whatever it is replaced with, and if the SentenceOperation returns more than
+// one sentence, they are joined with a single space. If a sentence is deleted,
+// the whitespace that follows it is deleted as well, unless it is the last
+// sentence in its paragraph, in which case the whitespace that comes before it
+// is deleted instead.
+//
+// The text is first split into paragraphs, and sentences are counted starting
+// from 0 in each one. Paragraphs that do not contain any sentences are left
+// unchanged. A sentence can go on across lines, and it is given to the
+// SentenceOperation with the LineSeparator between those lines left in it.
+//
+// This function is affected by the following [Options]:
+//
+//   - LineSeparator is treated as a space when finding sentences.
+//   - ParagraphSeparator specifies the string that paragraphs are split by.
+func (ed Editor) ApplySentences(op SentenceOperation) Editor {
+	ed = ed.ApplySentencesOpts(op, ed.Options)
+	return ed
+}
+
+// ApplySentencesOpts applies the given SentenceOperation to each sentence in
+// the text of the Editor, using the provided options.
+//
+// This is identical to [Editor.ApplySentences] but provides the ability to set
+// Options for the invocation.
+func (ed Editor) ApplySentencesOpts(op SentenceOperation, opts Options) Editor {
+	opts = opts.WithDefaults()
+
+	return ed.applyGParagraphsOpts(func(paraIdx int, para, sepPrefix, sepSuffix gem.String) []gem.String {
+		runes := para.Runes()
+		sentences := paragraphSentenceIndexes(para.String(), opts)
+		if len(sentences) < 1 {
+			return []gem.String{para}
+		}
+
+		// parts alternates between the whitespace around sentences and the
+		// sentences themselves, so that the last item is always whitespace.
+		parts := []string{string(runes[:sentences[0][0]])}
+
+		for idx := range sentences {
+			gapEnd := len(runes)
+			if idx+1 < len(sentences) {
+				gapEnd = sentences[idx+1][0]
+			}
+			sentence := string(runes[sentences[idx][0]:sentences[idx][1]])
+			gap := string(runes[sentences[idx][1]:gapEnd])
+
+			newSentences := op(paraIdx, idx, sentence)
+			if len(newSentences) < 1 {
+				// the whitespace after the last sentence replaces the
+				// whitespace before it, so that the paragraph ends the same
+				// way it did before.
+				if idx == len(sentences)-1 {
+					parts[len(parts)-1] = gap
+				}
+				continue
+			}
+
+			parts = append(parts, strings.Join(newSentences, " "), gap)
+		}
+
+		return []gem.String{gem.New(strings.Join(parts, ""))}
+	}, opts)
+}
+
 // CollapseSpace converts all consecutive whitespace characters to a single
 // space character.
 //
 // This function is affected by the following [Options]:
 //
+//   - ANSIEscapes sets whether ANSI escape sequences are left as they are
+//     instead of having any whitespace within them collapsed.
+//   - LineBreaking sets whether no-break spaces are collapsed. If set to
+//     LineBreakUAX14, they are left as they are.
 //   - LineSeparator is always considered whitespace, and will be collapsed into
 //     a space regardless of the classification of the characters within it.
 func (ed Editor) CollapseSpace() Editor {
@@ -283,7 +365,7 @@ func (ed Editor) CollapseSpace() Editor {
 // Options for the invocation.
 func (ed Editor) CollapseSpaceOpts(opts Options) Editor {
 	opts = opts.WithDefaults()
-	ed.Text = manip.CollapseSpace(gem.New(ed.Text), gem.New(opts.LineSeparator)).String()
+	ed.Text = manip.CollapseSpace(gem.New(ed.Text), gem.New(opts.LineSeparator), opts.layout()).String()
 	return ed
 }
 
@@ -373,12 +455,115 @@ func (ed Editor) IndentOpts(level int, opts Options) Editor {
 // This function is grapheme-aware and indexes text by human-readable
 // characters, not by the bytes or runes that make it up. See the note on
 // Grapheme-Awareness in the [rosed] package docs for more info.
+//
+// This function is affected by the following [Options]:
+//
+//   - Normalization gives the Unicode normalization form that text is
+//     converted to before it is inserted.
 func (ed Editor) Insert(charPos int, text string) Editor {
-	before := ed.CharsTo(charPos).Text
-	after := ed.CharsFrom(charPos).Text
+	return ed.InsertOpts(charPos, text, ed.Options)
+}
 
-	ed.Text = before + text + after
-	return ed
+// InsertOpts adds a string to the text at the given position using the
+// provided options.
+//
+// This is identical to [Editor.Insert] but provides the ability to set Options
+// for the invocation.
+func (ed Editor) InsertOpts(charPos int, text string, opts Options) Editor {
+	return ed.insert(charPos, opts.Normalization.normalize(text))
+}
+
+// InsertColumns builds a layout of any number of side-by-side columns of text
+// and inserts it into the text of the Editor. Each text in texts is the body of
+// one column, from left to right, and does not need any special preparation,
+// as it will be automatically wrapped to fit. This is the same as
+// [Editor.InsertTwoColumns] but without a limit on the number of columns.
+//
+// This function has several parameters:
+//   - pos gives the position to insert the columns at within the Editor.
+//   - texts are the texts to put into each column.
+//   - colPercents are numbers from 0.0 to 1.0 that give how much of the
+//     available width (width minus the space between every pair of columns)
+//     each column should take up, in the same order as texts. A percent that
+//     is less than 0.0 is assumed to be 0.0, and one that is greater than 1.0
+//     is assumed to be 1.0. Columns that are not given a percent, either
+//     because colPercents is shorter than texts or because it is nil, evenly
+//     share whatever width remains.
+//   - minSpaceBetween is the amount of space between each pair of columns at
+//     the left column's widest possible length.
+//   - width is how much horizontal space the columns along with the space
+//     between them should be wrapped to.
+//
+// The minimum width that a column can be is always 2 characters wide. If the
+// percents given add up to more than 1.0, the columns after the available
+// width is used up are given this minimum and the layout will be wider than
+// width.
+//
+// If a column ends up taking less vertical space than a column to the right of
+// it, it will have spaces added on subsequent lines to meet with where the next
+// column starts.
+//
+// This function is grapheme-aware and indexes text by human-readable
+// characters, not by the bytes or runes that make it up. See the note on
+// Grapheme-Awareness in the [rosed] package docs for more info.
+//
+// This function is affected by the following [Options]:
+//
+//   - ANSIEscapes sets whether ANSI escape sequences are treated as
+//     zero-width units that are never split, with styling carried over to
+//     the next line wherever a line is broken.
+//   - DisplayWidth sets whether the width of each column is measured by the
+//     number of terminal cells it takes up instead of by the number of
+//     characters in it.
+//   - Hyphenate sets whether words are hyphenated where doing so lets a line
+//     of a column hold more of the text, and whether soft hyphens are used as
+//     hyphenation points.
+//   - Hyphenator gives the hyphenation patterns that are used when Hyphenate
+//     is set.
+//   - LineBreaking sets where lines may be broken. If set to LineBreakUAX14,
+//     lines are broken at the break opportunities given by the Unicode Line
+//     Breaking Algorithm instead of only at spaces.
+//   - LineSeparator is used to separate each line of the output.
+//   - NoTrailingLineSeparators sets whether to include a trailing LineSeparator
+//     at the end of the generated columns. If set to true, it will be omitted,
+//     otherwise the columns will end with a LineSeparator.
+//   - PreserveWhitespace sets whether the line breaks and runs of whitespace
+//     within each column's text are kept instead of being collapsed before it
+//     is wrapped.
+//   - RecognizeLists sets whether the items of bulleted and numbered lists
+//     within each column's text are wrapped on their own with hanging
+//     indents.
+//   - RepeatIndent sets whether the leading whitespace of a line of a column
+//     is repeated on each line it is wrapped onto when PreserveWhitespace is
+//     set.
+//   - WrapAlgorithm sets how the line breaks in each column are chosen.
+func (ed Editor) InsertColumns(pos int, texts []string, colPercents []float64, minSpaceBetween int, width int) Editor {
+	return ed.InsertColumnsOpts(pos, texts, colPercents, minSpaceBetween, width, ed.Options)
+}
+
+// InsertColumnsOpts builds a layout of any number of side-by-side columns of
+// text using the options provided and inserts it into the text of the Editor.
+//
+// This is identical to [Editor.InsertColumns] but provides the ability to set
+// Options for the invocation.
+func (ed Editor) InsertColumnsOpts(pos int, texts []string, colPercents []float64, minSpaceBetween int, width int, opts Options) Editor {
+	if strings.Join(texts, "") == "" {
+		return ed
+	}
+
+	opts = opts.WithDefaults()
+
+	colWidths := columnWidths(len(texts), colPercents, minSpaceBetween, width)
+	colBlocks := make([]tb.Block, len(texts))
+	for i := range texts {
+		colBlocks[i] = manip.Wrap(gem.New(texts[i]), colWidths[i], gem.New(opts.LineSeparator), opts.layout())
+	}
+
+	combinedBlock := manip.CombineColumns(colBlocks, colWidths, minSpaceBetween, opts.layout())
+	combinedBlock.LineSeparator = gem.New(opts.LineSeparator)
+	combinedBlock.TrailingSeparator = !opts.NoTrailingLineSeparators
+
+	return ed.insert(pos, combinedBlock.Join().String())
 }
 
 // InsertDefinitionsTable creates a table of term definitions and inserts it
@@ -418,12 +603,37 @@ func (ed Editor) Insert(charPos int, text string) Editor {
 //
 // This function is affected by the following [Options]:
 //
+//   - ANSIEscapes sets whether ANSI escape sequences are treated as
+//     zero-width units that are never split, with styling carried over to
+//     the next line wherever a line is broken.
+//   - DisplayWidth sets whether the widths of terms and definitions are
+//     measured by the number of terminal cells they take up instead of by the
+//     number of characters in them.
+//   - Hyphenate sets whether words are hyphenated where doing so lets a line
+//     of a definition hold more of the text, and whether soft hyphens are
+//     used as hyphenation points.
+//   - Hyphenator gives the hyphenation patterns that are used when Hyphenate
+//     is set.
+//   - LineBreaking sets where lines of each definition may be broken. If set
+//     to LineBreakUAX14, lines are broken at the break opportunities given by
+//     the Unicode Line Breaking Algorithm instead of only at spaces.
 //   - LineSeparator is used to separate each line of the table output.
 //   - ParagraphSeparator is used to separate each term/definition pair from the
 //     other definitions.
 //   - NoTrailingLineSeparators sets whether to include a trailing
 //     LineSeparator at the end of the table. If set to true, it will be
 //     omitted, otherwise the table will end with a LineSeparator.
+//   - Normalization gives the Unicode normalization form that each term and
+//     definition is converted to before the table is laid out.
+//   - PreserveWhitespace sets whether the line breaks and runs of whitespace
+//     within each definition are kept instead of being collapsed before it
+//     is wrapped.
+//   - RecognizeLists sets whether the items of bulleted and numbered lists
+//     within each definition are wrapped on their own with hanging indents.
+//   - RepeatIndent sets whether the leading whitespace of a line of a
+//     definition is repeated on each line it is wrapped onto when
+//     PreserveWhitespace is set.
+//   - WrapAlgorithm sets how the line breaks in each definition are chosen.
 func (ed Editor) InsertDefinitionsTable(pos int, definitions [][2]string, width int) Editor {
 	return ed.InsertDefinitionsTableOpts(pos, definitions, width, ed.Options)
 }
@@ -442,10 +652,19 @@ func (ed Editor) InsertDefinitionsTableOpts(pos int, definitions [][2]string, wi
 		definitionStart  = "- "
 	)
 
+	if opts.Normalization != NoNormalization {
+		normalized := make([][2]string, len(definitions))
+		for i := range definitions {
+			normalized[i][0] = opts.Normalization.normalize(definitions[i][0])
+			normalized[i][1] = opts.Normalization.normalize(definitions[i][1])
+		}
+		definitions = normalized
+	}
+
 	// first find the longest term
 	longestTermLen := -1
 	for _, t := range definitions {
-		strLen := len([]rune(t[0]))
+		strLen := opts.layout().Width(gem.New(t[0]))
 		if strLen > longestTermLen {
 			longestTermLen = strLen
 		}
@@ -463,22 +682,23 @@ func (ed Editor) InsertDefinitionsTableOpts(pos int, definitions [][2]string, wi
 		term := item[0]
 		def := item[1]
 		rightPadding := ""
-		if len([]rune(term)) < longestTermLen {
-			rightPadding = strings.Repeat(" ", longestTermLen-len([]rune(term)))
+		termLen := opts.layout().Width(gem.New(term))
+		if termLen < longestTermLen {
+			rightPadding = strings.Repeat(" ", longestTermLen-termLen)
 		}
 		leftTab := strings.Repeat(" ", termLeftTabWidth)
 		leftCol := tb.Block{
 			Lines: []gem.String{gem.New(fmt.Sprintf("%s%s%s", leftTab, term, rightPadding))},
 		}
 		// subtract 2 from width so we can put in a left margin of "  "
-		rightCol := manip.Wrap(gem.New(def), rightWidth-2, gem.New(opts.LineSeparator))
+		rightCol := manip.Wrap(gem.New(def), rightWidth-2, gem.New(opts.LineSeparator), opts.layout())
 		rightCol.Apply(func(idx int, line string) []string {
 			if idx == 0 {
 				return []string{"- " + line}
 			}
 			return []string{"  " + line}
 		})
-		combined := manip.CombineColumnBlocks(leftCol, rightCol, minBetween)
+		combined := manip.CombineColumnBlocks(leftCol, rightCol, minBetween, opts.layout())
 
 		if fullTable.Len() > 0 && combined.Len() > 0 {
 			// grab the first line and append it to last line first.
@@ -495,19 +715,206 @@ func (ed Editor) InsertDefinitionsTableOpts(pos int, definitions [][2]string, wi
 	}
 
 	if fullTable.Len() > 0 {
-		return ed.Insert(pos, fullTable.Join().String())
+		return ed.insert(pos, fullTable.Join().String())
 	} else {
 		return ed
 	}
 }
 
+// InsertFlowColumns builds a newspaper-style layout of side-by-side columns
+// from a single text and inserts it into the text of the Editor. The text is
+// wrapped to the width of a column and its lines are then divided among the
+// columns so that each column has as close to the same number of lines as
+// possible, with the text continuing from the bottom of each column to the top
+// of the next. Columns to the left are given the extra lines when they cannot
+// be divided evenly.
+//
+// This function has several parameters:
+//   - pos gives the position to insert the columns at within the Editor.
+//   - text is the text to flow across the columns.
+//   - numCols is the number of columns. If it is less than 1, it is assumed to
+//     be 1.
+//   - minSpaceBetween is the amount of space between each pair of columns at
+//     the left column's widest possible length.
+//   - width is how much horizontal space the columns along with the space
+//     between them should be wrapped to. It is divided evenly among the
+//     columns, with columns to the left given the extra when it cannot be.
+//
+// The minimum width that a column can be is always 2 characters wide.
+//
+// This function is grapheme-aware and indexes text by human-readable
+// characters, not by the bytes or runes that make it up. See the note on
+// Grapheme-Awareness in the [rosed] package docs for more info.
+//
+// This function is affected by the following [Options]:
+//
+//   - ANSIEscapes sets whether ANSI escape sequences are treated as
+//     zero-width units that are never split, with styling carried over to
+//     the next line wherever a line is broken.
+//   - DisplayWidth sets whether the width of each column is measured by the
+//     number of terminal cells it takes up instead of by the number of
+//     characters in it.
+//   - Hyphenate sets whether words are hyphenated where doing so lets a line
+//     hold more of the text, and whether soft hyphens are used as hyphenation
+//     points.
+//   - Hyphenator gives the hyphenation patterns that are used when Hyphenate
+//     is set.
+//   - LineBreaking sets where lines may be broken. If set to LineBreakUAX14,
+//     lines are broken at the break opportunities given by the Unicode Line
+//     Breaking Algorithm instead of only at spaces.
+//   - LineSeparator is used to separate each line of the output.
+//   - NoTrailingLineSeparators sets whether to include a trailing LineSeparator
+//     at the end of the generated columns. If set to true, it will be omitted,
+//     otherwise the columns will end with a LineSeparator.
+//   - ParagraphSeparator is the separator used to split paragraphs. It will
+//     only have effect if PreserveParagraphs is set to true.
+//   - PreserveParagraphs gives whether to respect paragraphs instead of
+//     considering them text to be wrapped. If set to true, the text is split
+//     into paragraphs by ParagraphSeparator, each paragraph is wrapped on its
+//     own, and an empty line is placed between each paragraph before the
+//     lines are divided among the columns.
+//   - PreserveWhitespace sets whether the line breaks and runs of whitespace
+//     within the text are kept instead of being collapsed before it is
+//     wrapped.
+//   - RecognizeLists sets whether the items of bulleted and numbered lists
+//     within the text are wrapped on their own with hanging indents.
+//   - RepeatIndent sets whether the leading whitespace of a line is repeated
+//     on each line it is wrapped onto when PreserveWhitespace is set.
+//   - WrapAlgorithm sets how the line breaks in the text are chosen.
+func (ed Editor) InsertFlowColumns(pos int, text string, numCols int, minSpaceBetween int, width int) Editor {
+	return ed.InsertFlowColumnsOpts(pos, text, numCols, minSpaceBetween, width, ed.Options)
+}
+
+// InsertFlowColumnsOpts builds a newspaper-style layout of side-by-side
+// columns from a single text using the options provided and inserts it into
+// the text of the Editor.
+//
+// This is identical to [Editor.InsertFlowColumns] but provides the ability to
+// set Options for the invocation.
+func (ed Editor) InsertFlowColumnsOpts(pos int, text string, numCols int, minSpaceBetween int, width int, opts Options) Editor {
+	if text == "" {
+		return ed
+	}
+	if numCols < 1 {
+		numCols = 1
+	}
+
+	opts = opts.WithDefaults()
+
+	colWidths := columnWidths(numCols, nil, minSpaceBetween, width)
+
+	// every column must be able to hold every line, so wrap to the narrowest
+	// one.
+	wrapWidth := colWidths[numCols-1]
+	lineSep := gem.New(opts.LineSeparator)
+
+	var textLines []gem.String
+	if opts.PreserveParagraphs {
+		for i, para := range strings.Split(text, opts.ParagraphSeparator) {
+			if i > 0 {
+				textLines = append(textLines, gem.Zero)
+			}
+			textLines = append(textLines, manip.Wrap(gem.New(para), wrapWidth, lineSep, opts.layout()).Lines...)
+		}
+	} else {
+		textLines = manip.Wrap(gem.New(text), wrapWidth, lineSep, opts.layout()).Lines
+	}
+
+	colBlocks := make([]tb.Block, numCols)
+	perCol := len(textLines) / numCols
+	extra := len(textLines) % numCols
+	start := 0
+	for i := range colBlocks {
+		end := start + perCol
+		if i < extra {
+			end++
+		}
+		colBlocks[i] = tb.Block{Lines: textLines[start:end]}
+		start = end
+	}
+
+	combinedBlock := manip.CombineColumns(colBlocks, colWidths, minSpaceBetween, opts.layout())
+	combinedBlock.LineSeparator = lineSep
+	combinedBlock.TrailingSeparator = !opts.NoTrailingLineSeparators
+
+	return ed.insert(pos, combinedBlock.Join().String())
+}
+
+// InsertStructTable creates a table from the provided slice of structs or maps
+// and inserts it into the text of the Editor. Each element of slice is a row of
+// the table, and the first row is a header that gives the name of each column.
+//
+// The parameter slice must be a slice or array whose elements are structs,
+// pointers to structs, or maps; if it is not, an error is returned along with
+// the Editor unchanged. A nil pointer element gives a row of empty cells.
+//
+// For structs, there is a column for each exported field, in the order they are
+// declared, with the name of the field as its header. The fields of embedded
+// structs are included as though they were fields of the struct they are
+// embedded in. Each field can be given a struct tag with the key "rosed" whose
+// value is the header of the column followed by options separated by commas,
+// such as `rosed:"Name,align=right,width=10"`. If the header is left empty, the
+// name of the field is used, and a tag of `rosed:"-"` leaves the field out of
+// the table. The options are:
+//
+//   - align gives the alignment of the column as "left", "right", "center",
+//     or "decimal", which is used instead of the one in TableAlignments.
+//   - width gives the fixed width of the column, as in a [ColumnWidth].
+//   - min gives the minimum width of the column, as in a [ColumnWidth].
+//   - max gives the maximum width of the column, as in a [ColumnWidth].
+//   - order gives the place of the column relative to the others. Columns
+//     are sorted by their order, which is 0 for those that are not given one,
+//     and columns with the same order keep the order of their fields.
+//
+// If a field has a width, min, or max option, all three of them are used for
+// the column instead of the entry in TableColumnWidths. An error is returned if
+// a struct tag has an option that is not one of these or has a value that is
+// not valid for it.
+//
+// For maps, there is a column for each key in any of the maps, in order of the
+// keys as formatted by fmt.Sprint, and a cell is empty if the map of its row
+// does not have its key.
+//
+// Each value is shown as the text that TableValueFormatter gives for it. If it
+// is not set, a value that implements fmt.Stringer or error is shown as the
+// string that it gives, a nil pointer or interface is shown as the empty
+// string, and any other value is shown as formatted by fmt.Sprint.
+//
+// Once the data is read, the table is created as by [Editor.InsertTable] with
+// TableHeaders always set.
+//
+// This function is affected by the same [Options] as [Editor.InsertTable], and
+// also by the following [Options]:
+//
+//   - TableValueFormatter gives the text that each value is shown as.
+func (ed Editor) InsertStructTable(pos int, slice interface{}, width int) (Editor, error) {
+	return ed.InsertStructTableOpts(pos, slice, width, ed.Options)
+}
+
+// InsertStructTableOpts creates a table from the provided slice of structs or
+// maps using the provided options and inserts it into the text of the Editor.
+//
+// This is identical to [Editor.InsertStructTable] but provides the ability to
+// set Options for the invocation.
+func (ed Editor) InsertStructTableOpts(pos int, slice interface{}, width int, opts Options) (Editor, error) {
+	data, opts, err := structTableData(slice, opts)
+	if err != nil {
+		return ed, err
+	}
+
+	opts.TableHeaders = true
+	return ed.InsertTableOpts(pos, data, width, opts), nil
+}
+
 // InsertTable creates a table from the provided data and inserts it into the
 // text of the Editor.
 //
 // By default, this will create a table with equally-sized columns spaced out
 // to reach width, with every row of data treated the same. The options
-// TableHeaders, TableBorders, and TableCharSet are all consulted to determine
-// how to draw the table and can be set to customize the table output.
+// TableHeaders, TableBorders, TableCharSet, TableStyle, TableAlignments, and
+// TableHeaderCase are all consulted to determine how to draw the table and can
+// be set to customize the table output. If the data is too wide for the table
+// to fit in width, the table is made wider than width unless TableFit is set.
 //
 // The parameter data is a slice of rows, each of which is a slice of cells of
 // table data. The resulting table will have as many columns as the row in data
@@ -517,12 +924,20 @@ func (ed Editor) InsertDefinitionsTableOpts(pos int, definitions [][2]string, wi
 //
 // This function is affected by the following [Options]:
 //
+//   - ANSIEscapes sets whether ANSI escape sequences are treated as
+//     zero-width units that are never split, with any styling left active
+//     at the end of a cell closed before the cell border.
+//   - DisplayWidth sets whether the contents of each cell are measured by the
+//     number of terminal cells they take up instead of by the number of
+//     characters in them.
 //   - LineSeparator is used to separate each line of the output.
 //   - NoTrailingLineSeparators sets whether to include a trailing LineSeparator
 //     at the end of the generated table. If set to true, it will be omitted,
 //     otherwise the table will end with a LineSeparator. Note that this does
 //     not apply to any case where InsertTable output is empty (e.g. if given an
 //     empty set of data).
+//   - Normalization gives the Unicode normalization form that the contents of
+//     each cell are converted to before the table is laid out.
 //   - TableBorders controls whether the table will have a border.
 //   - TableHeaders controls whether the first row of data is layed out as
 //     headers for the table. They will be separated from the rest of the data
@@ -533,6 +948,39 @@ func (ed Editor) InsertDefinitionsTableOpts(pos int, definitions [][2]string, wi
 //     TableCharSet are used to draw the borders. If TableBorders is disabled
 //     but TableHeaders is enabled, the characters in TableCharSet are used to
 //     draw the horizontal rule separating the headers from the data.
+//   - TableStyle gives a full set of characters to draw the table with, with a
+//     separate character for each corner and junction. If it is set, it is
+//     used instead of TableCharSet.
+//   - TableAlignments gives the alignment of the content of each column. Any
+//     column it does not give an alignment for is aligned to the left.
+//     Columns with Decimal alignment have their numbers lined up on their
+//     decimal points.
+//   - TableHeaderCase gives how the letters of the headers are cased when
+//     TableHeaders is enabled.
+//   - TableFit sets whether a table that would be wider than width is
+//     narrowed to fit by wrapping the content of its widest columns onto
+//     multiple lines.
+//   - TableMinWidths gives the narrowest that each column is made when
+//     TableFit is enabled.
+//   - TableNoWrapColumns gives the columns that are never narrowed or wrapped
+//     when TableFit is enabled.
+//   - TableColumnWidths gives fixed, minimum, maximum, percentage, and
+//     weighted widths for each column. If they cannot all be met, the table
+//     is made as close to them as it can be; use [CheckColumnWidths] to find
+//     out whether they can be.
+//   - TableExpand sets whether the table is drawn in expanded mode, with
+//     each row as a record that has a "header | value" line for each column.
+//     If it is ExpandAuto, the table is only expanded if it would otherwise
+//     be wider than width.
+//   - TableFormat gives the format that the table is created in, such as
+//     fixed-width text, Markdown, HTML, reStructuredText, or LaTeX. The table
+//     is laid out the same way in each format. See [TableFormat] for the
+//     options that each format ignores.
+//   - TableRenderer draws the table from its layout in place of TableFormat,
+//     to create it in a format that TableFormat does not have.
+//   - LineBreaking, WrapAlgorithm, Hyphenate, and Hyphenator are used to wrap
+//     the content of cells when TableFit is enabled or the table is drawn in
+//     expanded mode, as they are by [Editor.Wrap].
 func (ed Editor) InsertTable(pos int, data [][]string, width int) Editor {
 	return ed.InsertTableOpts(pos, data, width, ed.Options)
 }
@@ -543,25 +991,156 @@ func (ed Editor) InsertTable(pos int, data [][]string, width int) Editor {
 // This is identical to [Editor.InsertTable] but provides the ability to set
 // Options for the invocation.
 func (ed Editor) InsertTableOpts(pos int, data [][]string, width int, opts Options) Editor {
+	cells := make([][]Cell, len(data))
+	for row := range data {
+		cells[row] = make([]Cell, len(data[row]))
+		for col := range data[row] {
+			cells[row][col] = Cell{Text: data[row][col]}
+		}
+	}
+
+	return ed.InsertTableCellsOpts(pos, cells, width, opts)
+}
+
+// InsertTableCells creates a table from the provided cells and inserts it into
+// the text of the Editor. Each cell can span more than one column or row of the
+// table, which allows for things such as a header cell that is over several
+// columns of data.
+//
+// This is identical to [Editor.InsertTable] except that each cell is given as
+// a Cell instead of a string. The cells of each row in data are placed from
+// left to right in the columns that are not already taken up by a cell from a
+// row above that spans into it. The borders between the columns and rows that a
+// cell spans are left out, and the columns that a cell spans are made wider if
+// they are not wide enough for it together. A cell that spans more than one
+// column is aligned as given for the first column that it spans in
+// TableAlignments.
+//
+// A cell will not span past the last row of the table, nor into a column that
+// is already taken up by another cell; it is shortened so that it does not. If
+// TableHeaders is set, cells in the first row do not span into the rows after
+// it.
+//
+// This function is affected by the same [Options] as [Editor.InsertTable].
+func (ed Editor) InsertTableCells(pos int, data [][]Cell, width int) Editor {
+	return ed.InsertTableCellsOpts(pos, data, width, ed.Options)
+}
+
+// InsertTableCellsOpts creates a table from the provided cells using the
+// provided options and inserts it into the text of the Editor.
+//
+// This is identical to [Editor.InsertTableCells] but provides the ability to
+// set Options for the invocation.
+func (ed Editor) InsertTableCellsOpts(pos int, data [][]Cell, width int, opts Options) Editor {
 	opts = opts.WithDefaults()
 
-	gemData := make([][]gem.String, len(data))
-	for row := range data {
-		gemRow := gem.Slice(data[row])
-		gemData[row] = gemRow
+	spec := manip.TableSpec{
+		Data:   opts.tableCells(data),
+		Width:  width,
+		Header: opts.TableHeaders,
+		Border: opts.TableBorders,
+		Style:  opts.tableStyle(),
+		Fit:    opts.TableFit,
+	}
+
+	return ed.insertTable(pos, spec, opts)
+}
+
+// InsertTableFromCSV reads table data in CSV format from r and inserts a table
+// created from it into the text of the Editor. Each record that is read is a
+// row of the table, and records do not all need to have the same number of
+// fields.
+//
+// This is identical to [Editor.InsertTable] except that the data is read from
+// r. The data is read in the format that is given in RFC 4180, as it is by
+// [csv.Reader].
+//
+// If the data cannot be read from r or is not valid CSV, the error is returned
+// along with the Editor unchanged.
+//
+// This function is affected by the same [Options] as [Editor.InsertTable].
+func (ed Editor) InsertTableFromCSV(pos int, r io.Reader, width int) (Editor, error) {
+	return ed.InsertTableFromCSVOpts(pos, r, width, ed.Options)
+}
+
+// InsertTableFromCSVOpts reads table data in CSV format from r and inserts a
+// table created from it into the text of the Editor using the provided options.
+//
+// This is identical to [Editor.InsertTableFromCSV] but provides the ability to
+// set Options for the invocation.
+func (ed Editor) InsertTableFromCSVOpts(pos int, r io.Reader, width int, opts Options) (Editor, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	data, err := cr.ReadAll()
+	if err != nil {
+		return ed, err
 	}
 
-	gemLineSep := gem.New(opts.LineSeparator)
-	gemCharSet := gem.New(opts.TableCharSet)
+	return ed.InsertTableOpts(pos, data, width, opts), nil
+}
 
-	tableBlock := manip.MakeTable(gemData, width, gemLineSep, opts.TableHeaders, opts.TableBorders, gemCharSet)
-	table := tableBlock.Join().String()
+// InsertTableFromTSV reads table data in TSV format from r and inserts a table
+// created from it into the text of the Editor. Each line that is read is a row
+// of the table, and its fields are separated by tabs. Lines do not all need to
+// have the same number of fields.
+//
+// This is identical to [Editor.InsertTableFromCSV] except that fields are
+// separated by tabs instead of commas, and a quote that appears in a field that
+// does not start with one is read as a part of the field.
+//
+// If the data cannot be read from r or is not valid TSV, the error is returned
+// along with the Editor unchanged.
+//
+// This function is affected by the same [Options] as [Editor.InsertTable].
+func (ed Editor) InsertTableFromTSV(pos int, r io.Reader, width int) (Editor, error) {
+	return ed.InsertTableFromTSVOpts(pos, r, width, ed.Options)
+}
 
-	if !opts.NoTrailingLineSeparators && len(table) > 0 {
-		table += opts.LineSeparator
+// InsertTableFromTSVOpts reads table data in TSV format from r and inserts a
+// table created from it into the text of the Editor using the provided options.
+//
+// This is identical to [Editor.InsertTableFromTSV] but provides the ability to
+// set Options for the invocation.
+func (ed Editor) InsertTableFromTSVOpts(pos int, r io.Reader, width int, opts Options) (Editor, error) {
+	cr := csv.NewReader(r)
+	cr.Comma = '\t'
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	data, err := cr.ReadAll()
+	if err != nil {
+		return ed, err
 	}
 
-	return ed.Insert(pos, table)
+	return ed.InsertTableOpts(pos, data, width, opts), nil
+}
+
+// InsertTableObj creates a table from the provided Table and inserts it into the
+// text of the Editor. The table has the header, footer, and rows of t, with a
+// rule under each row of t that has a separator after it.
+//
+// This is identical to [Editor.InsertTable] except that the data is taken from
+// t. The first row is the header only if t has one, regardless of
+// TableHeaders. If t has a footer, it is set off from the rows above it with a
+// rule drawn in the same way as the one under the header. Tables created in
+// the formats of TableFormatRSTGrid, TableFormatRSTSimple, or
+// TableFormatMarkdown do not have rules between their rows, and their footer is
+// drawn as any other row.
+//
+// This function is affected by the same [Options] as [Editor.InsertTable].
+func (ed Editor) InsertTableObj(pos int, t Table, width int) Editor {
+	return ed.InsertTableObjOpts(pos, t, width, ed.Options)
+}
+
+// InsertTableObjOpts creates a table from the provided Table using the provided
+// options and inserts it into the text of the Editor.
+//
+// This is identical to [Editor.InsertTableObj] but provides the ability to set
+// Options for the invocation.
+func (ed Editor) InsertTableObjOpts(pos int, t Table, width int, opts Options) Editor {
+	opts = opts.WithDefaults()
+	return ed.insertTable(pos, t.spec(width, opts), opts)
 }
 
 // InsertTwoColumns builds a two-column layout of side-by-side text from two
@@ -595,10 +1174,34 @@ func (ed Editor) InsertTableOpts(pos int, data [][]string, width int, opts Optio
 //
 // This function is affected by the following [Options]:
 //
+//   - ANSIEscapes sets whether ANSI escape sequences are treated as
+//     zero-width units that are never split, with styling carried over to
+//     the next line wherever a line is broken.
+//   - DisplayWidth sets whether the width of each column is measured by the
+//     number of terminal cells it takes up instead of by the number of
+//     characters in it.
+//   - Hyphenate sets whether words are hyphenated where doing so lets a line
+//     of a column hold more of the text, and whether soft hyphens are used as
+//     hyphenation points.
+//   - Hyphenator gives the hyphenation patterns that are used when Hyphenate
+//     is set.
+//   - LineBreaking sets where lines may be broken. If set to LineBreakUAX14,
+//     lines are broken at the break opportunities given by the Unicode Line
+//     Breaking Algorithm instead of only at spaces.
 //   - LineSeparator is used to separate each line of the output.
 //   - NoTrailingLineSeparators sets whether to include a trailing LineSeparator
 //     at the end of the generated columns. If set to true, it will be omitted,
 //     otherwise the columns will end with a LineSeparator.
+//   - PreserveWhitespace sets whether the line breaks and runs of whitespace
+//     within each column's text are kept instead of being collapsed before it
+//     is wrapped.
+//   - RecognizeLists sets whether the items of bulleted and numbered lists
+//     within each column's text are wrapped on their own with hanging
+//     indents.
+//   - RepeatIndent sets whether the leading whitespace of a line of a column
+//     is repeated on each line it is wrapped onto when PreserveWhitespace is
+//     set.
+//   - WrapAlgorithm sets how the line breaks in each column are chosen.
 func (ed Editor) InsertTwoColumns(pos int, leftText string, rightText string, minSpaceBetween int, width int, leftColPercent float64) Editor {
 	return ed.InsertTwoColumnsOpts(pos, leftText, rightText, minSpaceBetween, width, leftColPercent, ed.Options)
 }
@@ -647,14 +1250,14 @@ func (ed Editor) InsertTwoColumnsOpts(pos int, leftText string, rightText string
 	}
 
 	opts = opts.WithDefaults()
-	leftColBlock := manip.Wrap(gem.New(leftText), leftColWidth, gem.New(opts.LineSeparator))
-	rightColBlock := manip.Wrap(gem.New(rightText), rightColWidth, gem.New(opts.LineSeparator))
+	leftColBlock := manip.Wrap(gem.New(leftText), leftColWidth, gem.New(opts.LineSeparator), opts.layout())
+	rightColBlock := manip.Wrap(gem.New(rightText), rightColWidth, gem.New(opts.LineSeparator), opts.layout())
 
 	// need to get longest left-hand line and make the space between make up for the
 	// difference
 	maxLeftColLineLen := 0
 	for i := 0; i < len(leftColBlock.Lines); i++ {
-		lineLen := leftColBlock.Line(i).Len()
+		lineLen := opts.layout().Width(leftColBlock.Line(i))
 		if lineLen > maxLeftColLineLen {
 			maxLeftColLineLen = lineLen
 		}
@@ -662,29 +1265,42 @@ func (ed Editor) InsertTwoColumnsOpts(pos int, leftText string, rightText string
 	// if left col isnt the size it should be, add space so it is
 	spaceBetween := minSpaceBetween + (leftColWidth - maxLeftColLineLen)
 
-	combinedBlock := manip.CombineColumnBlocks(leftColBlock, rightColBlock, spaceBetween)
+	combinedBlock := manip.CombineColumnBlocks(leftColBlock, rightColBlock, spaceBetween, opts.layout())
 	combinedBlock.LineSeparator = gem.New(opts.LineSeparator)
 	combinedBlock.TrailingSeparator = !opts.NoTrailingLineSeparators
 
-	return ed.Insert(pos, combinedBlock.Join().String())
+	return ed.insert(pos, combinedBlock.Join().String())
 }
 
 // Justify edits the whitespace in each line of the Editor's text such that all
 // words are spaced approximately equally and the line as a whole spans the
 // given width.
 //
+// Justify does not change where lines are broken unless WrapAlgorithm is set to
+// WrapKnuthPlass. In that case, the text is first wrapped to width as by
+// [Editor.WrapOpts], with each line break chosen by how far the spaces in the
+// lines around it must be stretched to justify them, so that no justified line
+// has very wide gaps between its words.
+//
 // This function is grapheme-aware and indexes text by human-readable
 // characters, not by the bytes or runes that make it up. See the note on
 // Grapheme-Awareness in the [rosed] package docs for more info.
 //
 // This function is affected by the following [Options]:
 //
+//   - ANSIEscapes sets whether ANSI escape sequences are treated as
+//     zero-width units that are never split.
+//   - DisplayWidth sets whether the width of each line is measured by the
+//     number of terminal cells it takes up instead of by the number of
+//     characters in it.
 //   - JustifyLastLine is used to determine whether the last line of input text
 //     will be justified. The default behavior with JustifyLastLine set to
 //     false is to leave the last line alone. If the option is set to true, the
 //     last line is justified the same way as every other line. Note that if
 //     JustifyLastLine is set to false, Justify will have no effect on an Editor
 //     with only 1 line of text.
+//   - LineBreaking sets whether no-break spaces are used as gaps to be
+//     widened. If set to LineBreakUAX14, they are not.
 //   - LineSeparator is used to separate lines of input.
 //   - ParagraphSeparator is the separator used to split paragraphs. It will
 //     only have effect if PreserveParagraphs is set to true.
@@ -699,6 +1315,9 @@ func (ed Editor) InsertTwoColumnsOpts(pos int, leftText string, rightText string
 //     the justify will be called at least once for an empty string. If
 //     NoTrailingLineSeparators is set to false and the Editor text is set to an
 //     empty string, the justify will not be called even once.
+//   - WrapAlgorithm sets whether the text is wrapped before it is justified.
+//     If set to WrapKnuthPlass, it is, and all options that affect
+//     [Editor.Wrap] affect how it is wrapped.
 func (ed Editor) Justify(width int) Editor {
 	return ed.JustifyOpts(width, ed.Options)
 }
@@ -712,6 +1331,10 @@ func (ed Editor) Justify(width int) Editor {
 func (ed Editor) JustifyOpts(width int, opts Options) Editor {
 	opts = opts.WithDefaults()
 
+	if opts.WrapAlgorithm == WrapKnuthPlass {
+		ed = ed.WrapOpts(width, opts)
+	}
+
 	if opts.PreserveParagraphs {
 		ed = ed.applyGParagraphsOpts(func(idx int, para, pre, suf gem.String) []gem.String {
 			sepStart := gem.RepeatStr("A", pre.Len())
@@ -722,7 +1345,7 @@ func (ed Editor) JustifyOpts(width int, opts Options) Editor {
 				if !opts.JustifyLastLine && idx == bl.Len()-1 {
 					return []string{line}
 				}
-				return []string{manip.JustifyLine(gem.New(line), width).String()}
+				return []string{manip.JustifyLine(gem.New(line), width, opts.layout()).String()}
 			})
 			text := bl.Join()
 
@@ -742,7 +1365,7 @@ func (ed Editor) JustifyOpts(width int, opts Options) Editor {
 		}
 
 		ed = ed.ApplyOpts(func(idx int, line string) []string {
-			return []string{manip.JustifyLine(gem.New(line), width).String()}
+			return []string{manip.JustifyLine(gem.New(line), width, opts.layout()).String()}
 		}, opts)
 
 		if !opts.JustifyLastLine {
@@ -753,6 +1376,19 @@ func (ed Editor) JustifyOpts(width int, opts Options) Editor {
 	}
 }
 
+// Normalize converts the text in the Editor to the given Unicode normalization
+// form. Text that is normalized to the same form can be compared, searched, and
+// stored consistently regardless of how each character in it was originally
+// represented. If form is NoNormalization, the text is not modified.
+//
+// Note that normalization to a compatibility form (NFKC or NFKD) may change the
+// appearance of text, as it replaces characters such as ligatures and fullwidth
+// forms with their plain equivalents.
+func (ed Editor) Normalize(form NormalizationForm) Editor {
+	ed.Text = form.normalize(ed.Text)
+	return ed
+}
+
 // Overtype adds characters at the given position, writing over any that already
 // exist. If the added text would extend beyond the current end of the Editor
 // text, the Editor text is extended to make room for it.
@@ -760,8 +1396,23 @@ func (ed Editor) JustifyOpts(width int, opts Options) Editor {
 // This function is grapheme-aware and indexes text by human-readable
 // characters, not by the bytes or runes that make it up. See the note on
 // Grapheme-Awareness in the [rosed] package docs for more info.
+//
+// This function is affected by the following [Options]:
+//
+//   - Normalization gives the Unicode normalization form that text is
+//     converted to before it is written. The number of characters written
+//     over is the number of characters in the converted text.
 func (ed Editor) Overtype(charPos int, text string) Editor {
-	inboundText := gem.New(text)
+	return ed.OvertypeOpts(charPos, text, ed.Options)
+}
+
+// OvertypeOpts adds characters at the given position, writing over any that
+// already exist, using the provided options.
+//
+// This is identical to [Editor.Overtype] but provides the ability to set
+// Options for the invocation.
+func (ed Editor) OvertypeOpts(charPos int, text string, opts Options) Editor {
+	inboundText := gem.New(opts.Normalization.normalize(text))
 
 	before := ed.CharsTo(charPos).Text
 	after := ed.CharsFrom(charPos + inboundText.Len()).Text
@@ -771,6 +1422,222 @@ func (ed Editor) Overtype(charPos int, text string) Editor {
 	return ed
 }
 
+// Reflow wraps the Editor text to the given width while keeping the prefix
+// that begins each of its lines, such as the "// " of a comment or the "> " of
+// quoted email text. The prefix is removed from each line, the remaining text
+// is wrapped as by [Editor.Wrap] to the given width minus the width of the
+// prefix, and the prefix is then added back to the start of every wrapped
+// line. Lines that consist only of the prefix, such as the empty lines of a
+// comment, are treated as empty lines. Those that are kept as the separators
+// between paragraphs are left as they were given, and any other empty lines
+// that wrapping makes are given the prefix without its trailing whitespace.
+//
+// Lines at the start and end of the text that do not begin with the prefix,
+// such as the "/*" and " */" that open and close a block comment, are left as
+// they are and are not wrapped along with the rest of the text.
+//
+// Unless LinePrefix is set, the prefix is found automatically as the longest
+// run of whitespace and the characters "/", "#", ">", "*", ";", and "%" that
+// begins every line and ends with whitespace, such as "// ", "# ", "> > ", or
+// " * ". If the lines do not share such a prefix, this function is the same as
+// Wrap.
+//
+// If the width left after the prefix is less than 2, it is assumed to be 2
+// because no meaningful wrap algorithm can be applied to anything smaller.
+//
+// This function is grapheme-aware and indexes text by human-readable
+// characters, not by the bytes or runes that make it up. See the note on
+// Grapheme-Awareness in the [rosed] package docs for more info.
+//
+// This function is affected by the following [Options]:
+//
+//   - LinePrefix gives the prefix to use instead of finding it automatically.
+//   - LineSeparator is the separator used to split the text into lines. It is
+//     also placed at the end of each wrapped line.
+//   - ParagraphSeparator is the separator used to split paragraphs once the
+//     prefix has been removed. It will only have effect if PreserveParagraphs
+//     is set to true.
+//   - PreserveParagraphs gives whether to respect paragraphs instead of
+//     considering them text to be wrapped. If set to true, the text is split
+//     into paragraphs at the lines that consist only of the prefix, then the
+//     wrap is applied to each paragraph.
+//
+// This function is also affected by every option that affects Wrap.
+func (ed Editor) Reflow(width int) Editor {
+	return ed.ReflowOpts(width, ed.Options)
+}
+
+// ReflowOpts wraps the Editor text to the given width while keeping the prefix
+// that begins each of its lines using the supplied options.
+//
+// This is identical to [Editor.Reflow] but provides the ability to set Options
+// for the invocation.
+func (ed Editor) ReflowOpts(width int, opts Options) Editor {
+	opts = opts.WithDefaults()
+
+	lines := strings.Split(ed.Text, opts.LineSeparator)
+	trailingSep := len(lines) > 1 && lines[len(lines)-1] == ""
+	if trailingSep {
+		lines = lines[:len(lines)-1]
+	}
+
+	gLines := make([]gem.String, len(lines))
+	for i := range lines {
+		gLines[i] = gem.New(lines[i])
+	}
+
+	prefix := gem.New(opts.LinePrefix)
+	if prefix.IsEmpty() {
+		prefix = manip.FindLinePrefix(gLines)
+	}
+	blankPrefix := prefix.Sub(0, prefix.Len()-manip.CountTrailingWhitespace(prefix))
+
+	// lines at the start and end that do not have the prefix, such as those
+	// that open and close a block comment, are kept out of the wrap. If no
+	// line has the prefix, it is added to all of them.
+	start, end := 0, len(gLines)
+	for start < end && !manip.HasLinePrefix(gLines[start], prefix) {
+		start++
+	}
+	for end > start && !manip.HasLinePrefix(gLines[end-1], prefix) {
+		end--
+	}
+	if start == end {
+		start, end = 0, len(gLines)
+	}
+
+	// the empty lines between paragraphs are kept as they were given, so
+	// track the ones that fall between the lines of text
+	var blanks []string
+	var pendingBlanks []string
+	seenText := false
+	for i := start; i < end; i++ {
+		lines[i] = manip.StripLinePrefix(gLines[i], prefix).String()
+		if strings.TrimSpace(lines[i]) == "" {
+			if seenText {
+				pendingBlanks = append(pendingBlanks, gLines[i].String())
+			}
+		} else {
+			blanks = append(blanks, pendingBlanks...)
+			pendingBlanks = nil
+			seenText = true
+		}
+	}
+
+	body := Edit(strings.Join(lines[start:end], opts.LineSeparator))
+	wrapped := body.WrapOpts(width-opts.layout().Width(prefix), opts).Text
+
+	wrappedLines := strings.Split(wrapped, opts.LineSeparator)
+	for i := range wrappedLines {
+		if wrappedLines[i] == "" {
+			if len(blanks) > 0 {
+				wrappedLines[i] = blanks[0]
+				blanks = blanks[1:]
+			} else {
+				wrappedLines[i] = blankPrefix.String()
+			}
+		} else {
+			wrappedLines[i] = prefix.String() + wrappedLines[i]
+		}
+	}
+
+	var out []string
+	out = append(out, lines[:start]...)
+	out = append(out, wrappedLines...)
+	out = append(out, lines[end:]...)
+
+	ed.Text = strings.Join(out, opts.LineSeparator)
+	if trailingSep {
+		ed.Text += opts.LineSeparator
+	}
+	return ed
+}
+
+// Truncate shortens the Editor text so that it fits within the given width by
+// removing characters from the part of it given by mode and putting an ellipsis
+// in their place. If the text already fits within width, it is not changed. The
+// text is treated as a single line; to truncate each line on its own, use
+// [Editor.TruncateLines].
+//
+// If the ellipsis is wider than width, the text is shortened without it. If
+// width is less than 1, the text is removed entirely.
+//
+// This function is grapheme-aware and indexes text by human-readable
+// characters, not by the bytes or runes that make it up. Characters made up of
+// more than one rune, such as emoji ZWJ sequences, are never split. See the
+// note on Grapheme-Awareness in the [rosed] package docs for more info.
+//
+// This function is affected by the following [Options]:
+//
+//   - ANSIEscapes sets whether ANSI escape sequences are treated as
+//     zero-width units that are never split. If set, SGR styling that is
+//     active before the ellipsis is closed before it and styling that is
+//     active after the ellipsis is reopened after it.
+//   - DisplayWidth sets whether the width of the text is measured by the
+//     number of terminal cells it takes up instead of by the number of
+//     characters in it.
+//   - Ellipsis is the sequence that is put in place of the removed text.
+func (ed Editor) Truncate(width int, mode TruncationMode) Editor {
+	return ed.TruncateOpts(width, mode, ed.Options)
+}
+
+// TruncateOpts shortens the Editor text so that it fits within the given width
+// using the provided options.
+//
+// This is identical to [Editor.Truncate] but provides the ability to set
+// Options for the invocation.
+func (ed Editor) TruncateOpts(width int, mode TruncationMode, opts Options) Editor {
+	opts = opts.WithDefaults()
+
+	ed.Text = mode.truncate(gem.New(ed.Text), width, opts).String()
+	return ed
+}
+
+// TruncateLines shortens each line of the Editor text so that it fits within
+// the given width by removing characters from the part of the line given by
+// mode and putting an ellipsis in their place. Lines that already fit within
+// width are not changed.
+//
+// If the ellipsis is wider than width, lines are shortened without it. If width
+// is less than 1, the contents of every line are removed entirely.
+//
+// This function is grapheme-aware and indexes text by human-readable
+// characters, not by the bytes or runes that make it up. Characters made up of
+// more than one rune, such as emoji ZWJ sequences, are never split. See the
+// note on Grapheme-Awareness in the [rosed] package docs for more info.
+//
+// This function is affected by the following [Options]:
+//
+//   - ANSIEscapes sets whether ANSI escape sequences are treated as
+//     zero-width units that are never split. If set, SGR styling that is
+//     active before the ellipsis is closed before it and styling that is
+//     active after the ellipsis is reopened after it.
+//   - DisplayWidth sets whether the width of each line is measured by the
+//     number of terminal cells it takes up instead of by the number of
+//     characters in it.
+//   - Ellipsis is the sequence that is put in place of the removed text.
+//   - LineSeparator is used to separate lines of input.
+//   - NoTrailingLineSeparators specifies whether the function should consider a
+//     final instance of LineSeparator to be ending the prior line or giving the
+//     start of a new line. If NoTrailingLineSeparators is true, a trailing
+//     LineSeparator is considered to start a new (empty) line.
+func (ed Editor) TruncateLines(width int, mode TruncationMode) Editor {
+	return ed.TruncateLinesOpts(width, mode, ed.Options)
+}
+
+// TruncateLinesOpts shortens each line of the Editor text so that it fits
+// within the given width using the provided options.
+//
+// This is identical to [Editor.TruncateLines] but provides the ability to set
+// Options for the invocation.
+func (ed Editor) TruncateLinesOpts(width int, mode TruncationMode, opts Options) Editor {
+	opts = opts.WithDefaults()
+
+	return ed.ApplyOpts(func(idx int, line string) []string {
+		return []string{mode.truncate(gem.New(line), width, opts).String()}
+	}, opts)
+}
+
 // Wrap wraps the Editor text to the given width. All runs of whitespace are
 // collapsed automatically prior to the wrap.
 //
@@ -783,16 +1650,49 @@ func (ed Editor) Overtype(charPos int, text string) Editor {
 //
 // This function is affected by the following [Options]:
 //
+//   - ANSIEscapes sets whether ANSI escape sequences are treated as
+//     zero-width units that are never split, with styling carried over to
+//     the next line wherever a line is broken.
+//   - DisplayWidth sets whether the width of each line is measured by the
+//     number of terminal cells it takes up instead of by the number of
+//     characters in it.
+//   - Hyphenate sets whether words are hyphenated where doing so lets a line
+//     hold more of the text, and whether soft hyphens are used as
+//     hyphenation points.
+//   - Hyphenator gives the hyphenation patterns that are used when Hyphenate
+//     is set.
+//   - LineBreaking sets where lines may be broken. If set to LineBreakUAX14,
+//     lines are broken at the break opportunities given by the Unicode Line
+//     Breaking Algorithm instead of only at spaces.
 //   - LineSeparator is placed at the end of each wrapped line. In addition, any
 //     sequence of LineSeparator that exists in the text prior to calling this
 //     function will be treated as whitespace and collapsed into a single space
-//     character.
+//     character, unless PreserveWhitespace is set.
 //   - ParagraphSeparator is the separator used to split paragraphs. It will
 //     only have effect if PreserveParagraphs is set to true.
 //   - PreserveParagraphs gives whether to respect paragraphs instead of
 //     considering them text to be wrapped. If set to true, the text is first
 //     split into paragraphs by ParagraphSeparator, then the wrap is applied to
 //     each paragraph.
+//   - PreserveWhitespace gives whether to keep the existing line breaks and
+//     whitespace in the text. If set to true, each line is wrapped on its own
+//     at the whitespace within it, and all whitespace other than that which a
+//     line is broken at is left as it is.
+//   - RecognizeLists gives whether to recognize the items of bulleted and
+//     numbered lists. If set to true, each line that begins with a bullet
+//     ("-", "*", "+", or "•") or a number followed by "." or ")" starts a new
+//     list item, and each item is wrapped on its own with a hanging indent
+//     that lines up its wrapped lines with the text after its marker. The
+//     leading whitespace of each item is kept, so nested lists keep their
+//     structure. This has no effect if PreserveWhitespace is set.
+//   - RepeatIndent gives whether to repeat the leading whitespace of a line at
+//     the start of each line it is wrapped onto when PreserveWhitespace is
+//     set.
+//   - WrapAlgorithm sets how the line breaks are chosen. If set to
+//     WrapMinimumRaggedness or WrapKnuthPlass, the breaks are chosen to make
+//     the lines of each paragraph as even as possible; if PreserveParagraphs
+//     is false, the entire text is treated as a single paragraph for this
+//     purpose.
 func (ed Editor) Wrap(width int) Editor {
 	return ed.WrapOpts(width, ed.Options)
 }
@@ -814,14 +1714,14 @@ func (ed Editor) WrapOpts(width int, opts Options) Editor {
 
 			sepStart := gem.RepeatStr("A", sepPrefix.Len())
 			sepEnd := gem.RepeatStr("A", sepSuffix.Len())
-			textBlock := manip.Wrap(sepStart.Add(para).Add(sepEnd), width, gem.New(opts.LineSeparator))
+			textBlock := manip.Wrap(sepStart.Add(para).Add(sepEnd), width, gem.New(opts.LineSeparator), opts.layout())
 			text := textBlock.Join()
 			return []gem.String{text}
 		}, opts)
 		return edi
 	}
 
-	textBlock := manip.Wrap(gem.New(ed.Text), width, gem.New(opts.LineSeparator))
+	textBlock := manip.Wrap(gem.New(ed.Text), width, gem.New(opts.LineSeparator), opts.layout())
 	text := textBlock.Join()
 	if strings.HasSuffix(ed.Text, opts.LineSeparator) {
 		text = text.Add(gem.New(opts.LineSeparator))
@@ -63,6 +63,11 @@ func Test_Wrap(t *testing.T) {
 }
 
 func Test_WrapOpts(t *testing.T) {
+	doubleLHyphenator, err := NewHyphenator("l1l", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	testCases := []struct {
 		name    string
 		input   string
@@ -128,6 +133,207 @@ func Test_WrapOpts(t *testing.T) {
 				"third",
 			},
 		},
+		{
+			name:    "wide characters, measured by display width",
+			input:   "\u65e5\u672c\u8a9e \u306e\u30c6\u30ad\u30b9\u30c8 abc",
+			width:   6,
+			options: Options{DisplayWidth: true},
+			expect: []string{
+				"\u65e5\u672c\u8a9e",
+				"\u306e\u30c6-",
+				"\u30ad\u30b9\u30c8",
+				"abc",
+			},
+		},
+		{
+			name:    "ANSI escapes, not recognized",
+			input:   "\x1b[1mbold\x1b[0m text",
+			width:   10,
+			options: Options{},
+			expect: []string{
+				"\x1b[1mbold\x1b-",
+				"[0m text",
+			},
+		},
+		{
+			name:    "ANSI escapes, recognized",
+			input:   "\x1b[1mbold\x1b[0m text",
+			width:   10,
+			options: Options{ANSIEscapes: true},
+			expect: []string{
+				"\x1b[1mbold\x1b[0m text",
+			},
+		},
+		{
+			name:    "UAX #14 line breaking",
+			input:   "a well-known and/or 100\u00a0kg thing",
+			width:   9,
+			options: Options{LineBreaking: LineBreakUAX14},
+			expect: []string{
+				"a well-",
+				"known",
+				"and/or",
+				"100\u00a0kg",
+				"thing",
+			},
+		},
+		{
+			name:    "minimum raggedness, 2 paragraphs, not preserved",
+			input:   "this is a line that is split by paragraph in the input.\n\nThis is the second paragraph",
+			width:   20,
+			options: Options{WrapAlgorithm: WrapMinimumRaggedness},
+			expect: []string{
+				"this is a line",
+				"that is split by",
+				"paragraph in the",
+				"input. This is the",
+				"second paragraph",
+			},
+		},
+		{
+			name:    "minimum raggedness, 2 paragraphs, preserved",
+			input:   "this is a line that is split by paragraph in the input.\n\nThis is the second paragraph",
+			width:   20,
+			options: Options{WrapAlgorithm: WrapMinimumRaggedness, PreserveParagraphs: true},
+			expect: []string{
+				"this is a line",
+				"that is split by",
+				"paragraph in the",
+				"input.",
+				"",
+				"This is the second",
+				"paragraph",
+			},
+		},
+		{
+			name:    "knuth-plass, 2 paragraphs, preserved",
+			input:   "Your name is ROSE LALONDE. You are a master of the dark arts.\n\nYou write long novels about wizards in your spare time.",
+			width:   20,
+			options: Options{WrapAlgorithm: WrapKnuthPlass, PreserveParagraphs: true},
+			expect: []string{
+				"Your name is ROSE",
+				"LALONDE. You are a",
+				"master of the dark",
+				"arts.",
+				"",
+				"You write long",
+				"novels about wizards",
+				"in your spare time.",
+			},
+		},
+		{
+			name:    "hyphenate",
+			input:   "You write extraordinarily long novels.",
+			width:   17,
+			options: Options{Hyphenate: true},
+			expect: []string{
+				"You write ex-",
+				"traordinarily",
+				"long novels.",
+			},
+		},
+		{
+			name:    "hyphenate with a custom hyphenator",
+			input:   "Rose likes a balloon.",
+			width:   17,
+			options: Options{Hyphenate: true, Hyphenator: doubleLHyphenator},
+			expect: []string{
+				"Rose likes a bal-",
+				"loon.",
+			},
+		},
+		{
+			name:    "soft hyphens with hyphenate",
+			input:   "a game of in\u00ADter\u00ADdi\u00ADmen\u00ADsion\u00ADal scope",
+			width:   16,
+			options: Options{Hyphenate: true},
+			expect: []string{
+				"a game of inter-",
+				"dimensional",
+				"scope",
+			},
+		},
+		{
+			name:    "soft hyphens without hyphenate",
+			input:   "a game of in\u00ADter\u00ADdi\u00ADmen\u00ADsion\u00ADal scope",
+			width:   25,
+			options: Options{},
+			expect: []string{
+				"a game of",
+				"in\u00ADter\u00ADdi\u00ADmen\u00ADsion\u00ADal",
+				"scope",
+			},
+		},
+		{
+			name:    "preserve whitespace",
+			input:   "Your name is   JADE HARLEY.\n  You have a passion for RATHER OBSCURE MUSIC.\n",
+			width:   20,
+			options: Options{PreserveWhitespace: true},
+			expect: []string{
+				"Your name is   JADE",
+				"HARLEY.",
+				"  You have a passion",
+				"for RATHER OBSCURE",
+				"MUSIC.",
+				"",
+			},
+		},
+		{
+			name:    "preserve whitespace with repeated indent",
+			input:   "Your name is   JADE HARLEY.\n  You have a passion for RATHER OBSCURE MUSIC.",
+			width:   20,
+			options: Options{PreserveWhitespace: true, RepeatIndent: true},
+			expect: []string{
+				"Your name is   JADE",
+				"HARLEY.",
+				"  You have a passion",
+				"  for RATHER OBSCURE",
+				"  MUSIC.",
+			},
+		},
+		{
+			name:    "preserve whitespace, 2 paragraphs, preserved",
+			input:   "  You have a passion for music.\n\n  You have a passion for science.",
+			width:   20,
+			options: Options{PreserveWhitespace: true, RepeatIndent: true, PreserveParagraphs: true},
+			expect: []string{
+				"  You have a passion",
+				"  for music.",
+				"",
+				"  You have a passion",
+				"  for science.",
+			},
+		},
+		{
+			name:    "recognize lists",
+			input:   "Your name is JADE HARLEY.\n- You have a passion for RATHER OBSCURE MUSIC.\n- You like science.\n",
+			width:   20,
+			options: Options{RecognizeLists: true},
+			expect: []string{
+				"Your name is JADE",
+				"HARLEY.",
+				"- You have a passion",
+				"  for RATHER OBSCURE",
+				"  MUSIC.",
+				"- You like science.",
+				"",
+			},
+		},
+		{
+			name:    "recognize lists, 2 paragraphs, preserved",
+			input:   "1. You have a passion for music.\n\n2. You have a passion for science.",
+			width:   20,
+			options: Options{RecognizeLists: true, PreserveParagraphs: true},
+			expect: []string{
+				"1. You have a",
+				"   passion for",
+				"   music.",
+				"",
+				"2. You have a",
+				"   passion for",
+				"   science.",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -146,6 +352,238 @@ func Test_WrapOpts(t *testing.T) {
 	}
 }
 
+func Test_Reflow(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		width  int
+		expect []string
+	}{
+		{
+			name:  "empty string",
+			input: "",
+			width: 20,
+			expect: []string{
+				"",
+			},
+		},
+		{
+			name:  "no prefix",
+			input: "Things will never stop from keep happening constantly.",
+			width: 20,
+			expect: []string{
+				"Things will never",
+				"stop from keep",
+				"happening",
+				"constantly.",
+			},
+		},
+		{
+			name:  "line comment",
+			input: "// Things will never stop from keep happening constantly.",
+			width: 20,
+			expect: []string{
+				"// Things will never",
+				"// stop from keep",
+				"// happening",
+				"// constantly.",
+			},
+		},
+		{
+			name:  "short lines are joined",
+			input: "# Things will\n# never stop\n# from keep happening.",
+			width: 20,
+			expect: []string{
+				"# Things will never",
+				"# stop from keep",
+				"# happening.",
+			},
+		},
+		{
+			name:  "nested quotes",
+			input: "> > Things will never stop from keep happening constantly.",
+			width: 20,
+			expect: []string{
+				"> > Things will",
+				"> > never stop from",
+				"> > keep happening",
+				"> > constantly.",
+			},
+		},
+		{
+			name:  "trailing line separator is kept",
+			input: " * Things will never stop from keep happening.\n",
+			width: 20,
+			expect: []string{
+				" * Things will never",
+				" * stop from keep",
+				" * happening.",
+				"",
+			},
+		},
+		{
+			name:  "block comment delimiters are kept",
+			input: "/*\n * star comment text that is long and wraps around\n * again\n */",
+			width: 25,
+			expect: []string{
+				"/*",
+				" * star comment text that",
+				" * is long and wraps",
+				" * around again",
+				" */",
+			},
+		},
+		{
+			name:  "2 paragraphs are joined into one",
+			input: "// this is a line that is split by paragraph.\n//\n// This is the second paragraph",
+			width: 20,
+			expect: []string{
+				"// this is a line",
+				"// that is split by",
+				"// paragraph. This",
+				"// is the second",
+				"// paragraph",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualText := Edit(tc.input).Reflow(tc.width).String()
+
+			// String returns one string; turn it into actual lines
+			actual := strings.Split(actualText, DefaultLineSeparator)
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_ReflowOpts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		width   int
+		options Options
+		expect  []string
+	}{
+		{
+			name:    "2 paragraphs, preserved",
+			input:   "// this is a line that is split by paragraph.\n//\n// This is the second paragraph",
+			width:   20,
+			options: Options{PreserveParagraphs: true},
+			expect: []string{
+				"// this is a line",
+				"// that is split by",
+				"// paragraph.",
+				"//",
+				"// This is the",
+				"// second paragraph",
+			},
+		},
+		{
+			name:    "2 paragraphs, preserved, empty line kept as given",
+			input:   "# this is a line that is split by paragraph.\n\n# This is the second paragraph",
+			width:   20,
+			options: Options{PreserveParagraphs: true},
+			expect: []string{
+				"# this is a line",
+				"# that is split by",
+				"# paragraph.",
+				"",
+				"# This is the second",
+				"# paragraph",
+			},
+		},
+		{
+			name:    "2 paragraphs in a block comment, preserved",
+			input:   "/**\n * this is a line that is split by paragraph.\n * \n * This is the second paragraph\n */",
+			width:   20,
+			options: Options{PreserveParagraphs: true},
+			expect: []string{
+				"/**",
+				" * this is a line",
+				" * that is split by",
+				" * paragraph.",
+				" * ",
+				" * This is the",
+				" * second paragraph",
+				" */",
+			},
+		},
+		{
+			name:    "given prefix",
+			input:   "-- Things will never stop from keep happening constantly.",
+			width:   20,
+			options: Options{LinePrefix: "-- "},
+			expect: []string{
+				"-- Things will never",
+				"-- stop from keep",
+				"-- happening",
+				"-- constantly.",
+			},
+		},
+		{
+			name:    "list in a comment",
+			input:   "// - Things will never stop from keep happening.\n// - Constantly.",
+			width:   20,
+			options: Options{RecognizeLists: true},
+			expect: []string{
+				"// - Things will",
+				"//   never stop from",
+				"//   keep happening.",
+				"// - Constantly.",
+			},
+		},
+		{
+			name:    "custom line separator",
+			input:   "# Things will never stop from keep happening.<br/>",
+			width:   20,
+			options: Options{LineSeparator: "<br/>"},
+			expect: []string{
+				"# Things will never",
+				"# stop from keep",
+				"# happening.",
+				"",
+			},
+		},
+		{
+			name:    "prefix wider than width",
+			input:   "//// feferi peixes",
+			width:   4,
+			options: Options{},
+			expect: []string{
+				"//// f-",
+				"//// e-",
+				"//// f-",
+				"//// e-",
+				"//// ri",
+				"//// p-",
+				"//// e-",
+				"//// i-",
+				"//// x-",
+				"//// es",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualDirectText := Edit(tc.input).ReflowOpts(tc.width, tc.options).String()
+			actualPreOptsText := Edit(tc.input).WithOptions(tc.options).Reflow(tc.width).String()
+
+			actualDirect := strings.Split(actualDirectText, tc.options.WithDefaults().LineSeparator)
+			actualPreOpts := strings.Split(actualPreOptsText, tc.options.WithDefaults().LineSeparator)
+
+			assert.Equal(tc.expect, actualDirect, "ReflowOpts(opts) check failed")
+			assert.Equal(tc.expect, actualPreOpts, "WithOptions(opts).Reflow() check failed")
+		})
+	}
+}
+
 func Test_CollapseSpace(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -265,29 +703,234 @@ func Test_Insert(t *testing.T) {
 	}
 }
 
-func Test_Apply(t *testing.T) {
+func Test_InsertOpts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		pos     int
+		insert  string
+		options Options
+		expect  string
+	}{
+		{"no normalization", "ab", 1, "e\u0301", Options{}, "ae\u0301b"},
+		{"NFC", "ab", 1, "e\u0301", Options{Normalization: NFC}, "a\u00e9b"},
+		{"NFD", "ab", 1, "\u00e9", Options{Normalization: NFD}, "ae\u0301b"},
+		{"NFKC", "ab", 1, "\ufb01e\u0301", Options{Normalization: NFKC}, "afi\u00e9b"},
+		{"NFKD", "ab", 1, "\ufb01\u00e9", Options{Normalization: NFKD}, "afie\u0301b"},
+		{"existing text is not normalized", "e\u0301", 1, "e\u0301", Options{Normalization: NFC}, "e\u0301\u00e9"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualDirect := Edit(tc.input).InsertOpts(tc.pos, tc.insert, tc.options).String()
+			actualPreOpts := Edit(tc.input).WithOptions(tc.options).Insert(tc.pos, tc.insert).String()
+
+			assert.Equal(tc.expect, actualDirect, "InsertOpts(opts) check failed")
+			assert.Equal(tc.expect, actualPreOpts, "WithOptions(opts).Insert() check failed")
+		})
+	}
+}
+
+func Test_Normalize(t *testing.T) {
 	testCases := []struct {
 		name   string
 		input  string
-		op     LineOperation
+		form   NormalizationForm
 		expect string
 	}{
-		{
-			name:  "apply nothing to empty editor",
-			input: "",
-			op: func(lineNo int, line string) []string {
-				return []string{"test"}
-			},
-			expect: "",
-		},
-		{
-			name:  "replace one terminated empty line",
-			input: DefaultLineSeparator,
-			op: func(lineNo int, line string) []string {
-				return []string{"test"}
-			},
-			expect: "test" + DefaultLineSeparator,
-		},
+		{"empty", "", NFC, ""},
+		{"no normalization", "fiance\u0301e", NoNormalization, "fiance\u0301e"},
+		{"invalid form", "fiance\u0301e", NormalizationForm(-1), "fiance\u0301e"},
+		{"NFC of decomposed", "fiance\u0301e", NFC, "fianc\u00e9e"},
+		{"NFC of precomposed", "fianc\u00e9e", NFC, "fianc\u00e9e"},
+		{"NFD of precomposed", "fianc\u00e9e", NFD, "fiance\u0301e"},
+		{"NFD of decomposed", "fiance\u0301e", NFD, "fiance\u0301e"},
+		{"NFC keeps compatibility chars", "\ufb01nal \uff21", NFC, "\ufb01nal \uff21"},
+		{"NFKC of compatibility chars", "\ufb01nal \uff21", NFKC, "final A"},
+		{"NFKD of compatibility chars", "\ufb01anc\u00e9e", NFKD, "fiance\u0301e"},
+		{"hangul", "\u1112\u1161\u11ab", NFC, "\ud55c"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := Edit(tc.input).Normalize(tc.form).String()
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_Truncate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		width  int
+		mode   TruncationMode
+		expect string
+	}{
+		{"empty", "", 5, TruncateTail, ""},
+		{"already fits", "John Egbert", 11, TruncateTail, "John Egbert"},
+		{"tail", "John Egbert", 8, TruncateTail, "John Eg\u2026"},
+		{"head", "John Egbert", 8, TruncateHead, "\u2026 Egbert"},
+		{"middle", "John Egbert", 8, TruncateMiddle, "John\u2026ert"},
+		{"invalid mode", "John Egbert", 8, TruncationMode(-1), "John Eg\u2026"},
+		{"width 0", "John Egbert", 0, TruncateTail, ""},
+		{"negative width", "John Egbert", -1, TruncateMiddle, ""},
+		{"text is one line", "John\nEgbert", 8, TruncateTail, "John\nEg\u2026"},
+		{"ZWJ sequence is not split", "\U0001F468\u200D\U0001F469\u200D\U0001F466 family", 3, TruncateTail, "\U0001F468\u200D\U0001F469\u200D\U0001F466 \u2026"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := Edit(tc.input).Truncate(tc.width, tc.mode).String()
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_TruncateOpts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		width   int
+		mode    TruncationMode
+		options Options
+		expect  string
+	}{
+		{
+			name:    "custom ellipsis",
+			input:   "/home/jegbert/music/piano/sburban_jungle.mid",
+			width:   24,
+			mode:    TruncateMiddle,
+			options: Options{Ellipsis: "..."},
+			expect:  "/home/jegbe...jungle.mid",
+		},
+		{
+			name:    "display width",
+			input:   "\u30b8\u30e7\u30f3\u30fb\u30a8\u30b0\u30d0\u30fc\u30c8",
+			width:   7,
+			mode:    TruncateHead,
+			options: Options{DisplayWidth: true},
+			expect:  "\u2026\u30d0\u30fc\u30c8",
+		},
+		{
+			name:    "ansi escapes",
+			input:   "\x1b[34mJohn Egbert\x1b[0m",
+			width:   5,
+			mode:    TruncateTail,
+			options: Options{ANSIEscapes: true},
+			expect:  "\x1b[34mJohn\x1b[0m\u2026",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualDirect := Edit(tc.input).TruncateOpts(tc.width, tc.mode, tc.options).String()
+			actualPreOpts := Edit(tc.input).WithOptions(tc.options).Truncate(tc.width, tc.mode).String()
+
+			assert.Equal(tc.expect, actualDirect, "TruncateOpts(opts) check failed")
+			assert.Equal(tc.expect, actualPreOpts, "WithOptions(opts).Truncate() check failed")
+		})
+	}
+}
+
+func Test_TruncateLines(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		width  int
+		mode   TruncationMode
+		expect string
+	}{
+		{"empty", "", 5, TruncateTail, ""},
+		{"one line", "John Egbert", 8, TruncateTail, "John Eg\u2026"},
+		{"each line is truncated", "John Egbert\nRose Lalonde\nDave\n", 6, TruncateHead, "\u2026gbert\n\u2026londe\nDave\n"},
+		{"middle", "John Egbert\nRose Lalonde", 7, TruncateMiddle, "Joh\u2026ert\nRos\u2026nde"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := Edit(tc.input).TruncateLines(tc.width, tc.mode).String()
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_TruncateLinesOpts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		width   int
+		mode    TruncationMode
+		options Options
+		expect  string
+	}{
+		{
+			name:    "custom line separator",
+			input:   "John Egbert<br/>Rose Lalonde<br/>",
+			width:   6,
+			mode:    TruncateTail,
+			options: Options{LineSeparator: "<br/>"},
+			expect:  "John \u2026<br/>Rose \u2026<br/>",
+		},
+		{
+			name:    "custom ellipsis",
+			input:   "John Egbert\nRose Lalonde",
+			width:   8,
+			mode:    TruncateTail,
+			options: Options{Ellipsis: "..."},
+			expect:  "John ...\nRose ...",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualDirect := Edit(tc.input).TruncateLinesOpts(tc.width, tc.mode, tc.options).String()
+			actualPreOpts := Edit(tc.input).WithOptions(tc.options).TruncateLines(tc.width, tc.mode).String()
+
+			assert.Equal(tc.expect, actualDirect, "TruncateLinesOpts(opts) check failed")
+			assert.Equal(tc.expect, actualPreOpts, "WithOptions(opts).TruncateLines() check failed")
+		})
+	}
+}
+
+func Test_Apply(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		op     LineOperation
+		expect string
+	}{
+		{
+			name:  "apply nothing to empty editor",
+			input: "",
+			op: func(lineNo int, line string) []string {
+				return []string{"test"}
+			},
+			expect: "",
+		},
+		{
+			name:  "replace one terminated empty line",
+			input: DefaultLineSeparator,
+			op: func(lineNo int, line string) []string {
+				return []string{"test"}
+			},
+			expect: "test" + DefaultLineSeparator,
+		},
 		{
 			name:  "replace two terminated lines",
 			input: DefaultLineSeparator + DefaultLineSeparator,
@@ -613,6 +1256,169 @@ func Test_ApplyParagraphsOpts(t *testing.T) {
 	}
 }
 
+func Test_ApplySentences(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		op     SentenceOperation
+		expect string
+	}{
+		{
+			name:  "empty string",
+			input: "",
+			op: func(paraIdx, idx int, sentence string) []string {
+				return []string{"test"}
+			},
+			expect: "",
+		},
+		{
+			name:  "replace each sentence using sentence number",
+			input: "One. Two? Three!",
+			op: func(paraIdx, idx int, sentence string) []string {
+				return []string{fmt.Sprintf("%d:%s", idx, sentence)}
+			},
+			expect: "0:One. 1:Two? 2:Three!",
+		},
+		{
+			name:  "abbreviations and decimals do not end sentences",
+			input: "It costs 3.50, e.g. a lot. Buy it.",
+			op: func(paraIdx, idx int, sentence string) []string {
+				return []string{"[" + sentence + "]"}
+			},
+			expect: "[It costs 3.50, e.g. a lot.] [Buy it.]",
+		},
+		{
+			name:  "whitespace around sentences is kept",
+			input: "  One.   Two.\n",
+			op: func(paraIdx, idx int, sentence string) []string {
+				return []string{strings.ToUpper(sentence)}
+			},
+			expect: "  ONE.   TWO.\n",
+		},
+		{
+			name:  "sentence numbers restart in each paragraph",
+			input: "One. Two." + DefaultParagraphSeparator + "Three. Four.",
+			op: func(paraIdx, idx int, sentence string) []string {
+				return []string{fmt.Sprintf("%d.%d", paraIdx, idx)}
+			},
+			expect: "0.0 0.1" + DefaultParagraphSeparator + "1.0 1.1",
+		},
+		{
+			name:  "replace first sentence of each wrapped paragraph",
+			input: "This is a wrapped\nparagraph that goes on. It has\ntwo sentences." + DefaultParagraphSeparator + "Another\none. Done.",
+			op: func(paraIdx, idx int, sentence string) []string {
+				if idx == 0 {
+					return []string{"First."}
+				}
+				return []string{sentence}
+			},
+			expect: "First. It has\ntwo sentences." + DefaultParagraphSeparator + "First. Done.",
+		},
+		{
+			name:  "insert extra sentence at target position",
+			input: "One. Three.",
+			op: func(paraIdx, idx int, sentence string) []string {
+				if idx == 0 {
+					return []string{sentence, "Two."}
+				}
+				return []string{sentence}
+			},
+			expect: "One. Two. Three.",
+		},
+		{
+			name:  "delete first sentence",
+			input: "One. Two. Three.\n",
+			op: func(paraIdx, idx int, sentence string) []string {
+				if idx == 0 {
+					return nil
+				}
+				return []string{sentence}
+			},
+			expect: "Two. Three.\n",
+		},
+		{
+			name:  "delete last sentence",
+			input: "One. Two. Three.\n",
+			op: func(paraIdx, idx int, sentence string) []string {
+				if idx == 2 {
+					return nil
+				}
+				return []string{sentence}
+			},
+			expect: "One. Two.\n",
+		},
+		{
+			name:  "delete all sentences",
+			input: "One. Two.",
+			op: func(paraIdx, idx int, sentence string) []string {
+				return nil
+			},
+			expect: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := Edit(tc.input).ApplySentences(tc.op).String()
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_ApplySentencesOpts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		op      SentenceOperation
+		options Options
+		expect  string
+	}{
+		{
+			name:  "sentence numbers restart in each paragraph, custom paraSep",
+			input: "One. Two.<P>Three. Four.",
+			op: func(paraIdx, idx int, sentence string) []string {
+				return []string{fmt.Sprintf("%d.%d", paraIdx, idx)}
+			},
+			options: Options{ParagraphSeparator: "<P>"},
+			expect:  "0.0 0.1<P>1.0 1.1",
+		},
+		{
+			name:  "replace first sentence of each paragraph, custom paraSep",
+			input: "One. Two.<P>Three. Four.",
+			op: func(paraIdx, idx int, sentence string) []string {
+				if idx == 0 {
+					return []string{"First."}
+				}
+				return []string{sentence}
+			},
+			options: Options{ParagraphSeparator: "<P>"},
+			expect:  "First. Two.<P>First. Four.",
+		},
+		{
+			name:  "sentences go across lines, custom lineSep",
+			input: "One goes<br/>on. Two.",
+			op: func(paraIdx, idx int, sentence string) []string {
+				return []string{fmt.Sprintf("[%s]", sentence)}
+			},
+			options: Options{LineSeparator: "<br/>"},
+			expect:  "[One goes<br/>on.] [Two.]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualDirect := Edit(tc.input).ApplySentencesOpts(tc.op, tc.options).String()
+			actualPreOpts := Edit(tc.input).WithOptions(tc.options).ApplySentences(tc.op).String()
+
+			assert.Equal(tc.expect, actualDirect, "ApplySentencesOpts(opts) check failed")
+			assert.Equal(tc.expect, actualPreOpts, "WithOptions(opts).ApplySentences() check failed")
+		})
+	}
+}
+
 func Test_Indent(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -1152,6 +1958,23 @@ func Test_JustifyOpts(t *testing.T) {
 				"which  should  also be" + DefaultLineSeparator +
 				"respected",
 		},
+		{
+			name: "knuth-plass rewraps before justifying",
+			input: "Your name is ROSE LALONDE. You are a" + DefaultLineSeparator +
+				"master of the dark arts and you write long novels" + DefaultLineSeparator +
+				"about wizards in your spare time.",
+			width: 20,
+			options: Options{
+				WrapAlgorithm: WrapKnuthPlass,
+			},
+			expect: "Your  name  is  ROSE" + DefaultLineSeparator +
+				"LALONDE.   You   are" + DefaultLineSeparator +
+				"a  master   of   the" + DefaultLineSeparator +
+				"dark  arts  and  you" + DefaultLineSeparator +
+				"write   long  novels" + DefaultLineSeparator +
+				"about   wizards   in" + DefaultLineSeparator +
+				"your spare time.",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1376,6 +2199,24 @@ func Test_InsertTwoColumnsOpts(t *testing.T) {
 				"one is right    string for the<br/>" +
 				"here!           right side<br/>",
 		},
+		{
+			name: "hyphenate",
+			args: args{
+				pos:         0,
+				left:        "Typographical conventions",
+				right:       "Hyphenation is quite incomprehensible",
+				minBetween:  2,
+				width:       30,
+				leftPercent: 0.5,
+			},
+			options: Options{
+				Hyphenate: true,
+			},
+			input: "",
+			expect: "Typographical   Hyphenation is" + DefaultLineSeparator +
+				"conventions     quite incom-" + DefaultLineSeparator +
+				"                prehensible" + DefaultLineSeparator,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1407,43 +2248,424 @@ func Test_InsertTwoColumnsOpts(t *testing.T) {
 	}
 }
 
-func Test_InsertDefinitionsTable(t *testing.T) {
+func Test_InsertColumns(t *testing.T) {
+	type args struct {
+		pos        int
+		texts      []string
+		percents   []float64
+		minBetween int
+		width      int
+	}
+
 	testCases := []struct {
 		name   string
+		args   args
 		input  string
-		pos    int
-		defs   [][2]string
-		width  int
 		expect string
 	}{
 		{
-			name:   "empty lines",
+			name: "empty texts",
+			args: args{
+				pos:        0,
+				texts:      []string{"", "", ""},
+				minBetween: 2,
+				width:      30,
+			},
 			input:  "",
-			pos:    0,
-			defs:   [][2]string{},
-			width:  80,
 			expect: "",
 		},
 		{
-			name:  "one def, too short to wrap",
-			input: "",
-			pos:   0,
-			defs: [][2]string{
-				{"def1", "this is the first definition"},
+			name: "no texts",
+			args: args{
+				pos:        0,
+				texts:      nil,
+				minBetween: 2,
+				width:      30,
 			},
-			width:  80,
-			expect: "  def1  - this is the first definition" + DefaultLineSeparator,
+			input:  "",
+			expect: "",
 		},
 		{
-			name:  "one def, long enough to wrap once",
-			input: "",
-			pos:   0,
-			defs: [][2]string{
-				{"def1", "this is the first definition with a rather long outro which will cause it to become wrapped"},
+			name: "three columns share width evenly",
+			args: args{
+				pos:        0,
+				texts:      []string{"Your name is JOHN EGBERT.", "You have a variety of interests.", "You like to program computers."},
+				minBetween: 2,
+				width:      30,
 			},
-			width: 80,
-			expect: "  def1  - this is the first definition with a rather long outro which will cause" + DefaultLineSeparator +
-				"          it to become wrapped" + DefaultLineSeparator,
+			input: "",
+			expect: "Your name  You have   You like" + DefaultLineSeparator +
+				"is JOHN    a variety  to" + DefaultLineSeparator +
+				"EGBERT.    of         program" + DefaultLineSeparator +
+				"           interest-  compute-" + DefaultLineSeparator +
+				"           s.         rs." + DefaultLineSeparator,
+		},
+		{
+			name: "given percents",
+			args: args{
+				pos:        0,
+				texts:      []string{"Your name is JOHN EGBERT.", "You have a variety of interests."},
+				percents:   []float64{0.25},
+				minBetween: 2,
+				width:      30,
+			},
+			input: "",
+			expect: "Your     You have a variety of" + DefaultLineSeparator +
+				"name is  interests." + DefaultLineSeparator +
+				"JOHN     " + DefaultLineSeparator +
+				"EGBERT.  " + DefaultLineSeparator,
+		},
+		{
+			name: "insert into existing text",
+			args: args{
+				pos:        5,
+				texts:      []string{"A B", "C D", "E F"},
+				minBetween: 1,
+				width:      11,
+			},
+			input:  "John\n",
+			expect: "John\nA B C D E F" + DefaultLineSeparator,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			ed := Edit(tc.input)
+			ed = ed.InsertColumns(
+				tc.args.pos,
+				tc.args.texts,
+				tc.args.percents,
+				tc.args.minBetween,
+				tc.args.width,
+			)
+			actual := ed.String()
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_InsertColumnsOpts(t *testing.T) {
+	type args struct {
+		pos        int
+		texts      []string
+		percents   []float64
+		minBetween int
+		width      int
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		options Options
+		input   string
+		expect  string
+	}{
+		{
+			name: "no trailing line separator",
+			args: args{
+				pos:        0,
+				texts:      []string{"A B", "C D", "E F"},
+				minBetween: 1,
+				width:      5,
+			},
+			options: Options{NoTrailingLineSeparators: true},
+			input:   "",
+			expect:  "A  C  E" + DefaultLineSeparator + "B  D  F",
+		},
+		{
+			name: "custom line separator",
+			args: args{
+				pos:        0,
+				texts:      []string{"A B", "C D", "E F"},
+				minBetween: 1,
+				width:      5,
+			},
+			options: Options{LineSeparator: "<br/>"},
+			input:   "",
+			expect:  "A  C  E<br/>B  D  F<br/>",
+		},
+		{
+			name: "display width",
+			args: args{
+				pos:        0,
+				texts:      []string{"\u30b8\u30e7\u30f3", "A B", "C"},
+				minBetween: 1,
+				width:      14,
+			},
+			options: Options{DisplayWidth: true},
+			input:   "",
+			expect:  "\u30b8-  A B  C" + DefaultLineSeparator + "\u30e7\u30f3      " + DefaultLineSeparator,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualDirect := Edit(tc.input).InsertColumnsOpts(
+				tc.args.pos,
+				tc.args.texts,
+				tc.args.percents,
+				tc.args.minBetween,
+				tc.args.width,
+				tc.options,
+			).String()
+
+			actualPreOpts := Edit(tc.input).WithOptions(tc.options).InsertColumns(
+				tc.args.pos,
+				tc.args.texts,
+				tc.args.percents,
+				tc.args.minBetween,
+				tc.args.width,
+			).String()
+
+			assert.Equal(tc.expect, actualDirect, "InsertColumnsOpts(opts) check failed")
+			assert.Equal(tc.expect, actualPreOpts, "WithOptions(opts).InsertColumns() check failed")
+		})
+	}
+}
+
+func Test_InsertFlowColumns(t *testing.T) {
+	type args struct {
+		pos        int
+		text       string
+		numCols    int
+		minBetween int
+		width      int
+	}
+
+	testCases := []struct {
+		name   string
+		args   args
+		input  string
+		expect string
+	}{
+		{
+			name: "empty text",
+			args: args{
+				pos:        0,
+				text:       "",
+				numCols:    2,
+				minBetween: 2,
+				width:      30,
+			},
+			input:  "",
+			expect: "",
+		},
+		{
+			name: "lines divide evenly",
+			args: args{
+				pos:        0,
+				text:       "A B C D E F",
+				numCols:    3,
+				minBetween: 1,
+				width:      8,
+			},
+			input: "",
+			expect: "A  C  E" + DefaultLineSeparator +
+				"B  D  F" + DefaultLineSeparator,
+		},
+		{
+			name: "extra lines go to the left",
+			args: args{
+				pos:        0,
+				text:       "A B C D E F G",
+				numCols:    3,
+				minBetween: 1,
+				width:      8,
+			},
+			input: "",
+			expect: "A  D  F" + DefaultLineSeparator +
+				"B  E  G" + DefaultLineSeparator +
+				"C     " + DefaultLineSeparator,
+		},
+		{
+			name: "fewer lines than columns",
+			args: args{
+				pos:        0,
+				text:       "A",
+				numCols:    3,
+				minBetween: 1,
+				width:      8,
+			},
+			input:  "",
+			expect: "A     " + DefaultLineSeparator,
+		},
+		{
+			name: "columns less than 1",
+			args: args{
+				pos:        0,
+				text:       "Your name is JOHN EGBERT.",
+				numCols:    0,
+				minBetween: 1,
+				width:      15,
+			},
+			input: "",
+			expect: "Your name is" + DefaultLineSeparator +
+				"JOHN EGBERT." + DefaultLineSeparator,
+		},
+		{
+			name: "newspaper columns",
+			args: args{
+				pos:        0,
+				text:       "Your name is JOHN EGBERT. You have a variety of interests. You have a passion for REALLY TERRIBLE MOVIES.",
+				numCols:    2,
+				minBetween: 3,
+				width:      35,
+			},
+			input: "",
+			expect: "Your name is       You have a" + DefaultLineSeparator +
+				"JOHN EGBERT. You   passion for" + DefaultLineSeparator +
+				"have a variety     REALLY TERRIBLE" + DefaultLineSeparator +
+				"of interests.      MOVIES." + DefaultLineSeparator,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			ed := Edit(tc.input)
+			ed = ed.InsertFlowColumns(
+				tc.args.pos,
+				tc.args.text,
+				tc.args.numCols,
+				tc.args.minBetween,
+				tc.args.width,
+			)
+			actual := ed.String()
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_InsertFlowColumnsOpts(t *testing.T) {
+	type args struct {
+		pos        int
+		text       string
+		numCols    int
+		minBetween int
+		width      int
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		options Options
+		input   string
+		expect  string
+	}{
+		{
+			name: "paragraphs not preserved",
+			args: args{
+				pos:        0,
+				text:       "A B\n\nC D",
+				numCols:    2,
+				minBetween: 1,
+				width:      5,
+			},
+			options: Options{},
+			input:   "",
+			expect:  "A  C" + DefaultLineSeparator + "B  D" + DefaultLineSeparator,
+		},
+		{
+			name: "paragraphs preserved",
+			args: args{
+				pos:        0,
+				text:       "A B\n\nC D",
+				numCols:    2,
+				minBetween: 1,
+				width:      5,
+			},
+			options: Options{PreserveParagraphs: true},
+			input:   "",
+			expect: "A  C" + DefaultLineSeparator +
+				"B  D" + DefaultLineSeparator +
+				"   " + DefaultLineSeparator,
+		},
+		{
+			name: "no trailing line separator",
+			args: args{
+				pos:        0,
+				text:       "A B C D",
+				numCols:    2,
+				minBetween: 1,
+				width:      5,
+			},
+			options: Options{NoTrailingLineSeparators: true},
+			input:   "",
+			expect:  "A  C" + DefaultLineSeparator + "B  D",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualDirect := Edit(tc.input).InsertFlowColumnsOpts(
+				tc.args.pos,
+				tc.args.text,
+				tc.args.numCols,
+				tc.args.minBetween,
+				tc.args.width,
+				tc.options,
+			).String()
+
+			actualPreOpts := Edit(tc.input).WithOptions(tc.options).InsertFlowColumns(
+				tc.args.pos,
+				tc.args.text,
+				tc.args.numCols,
+				tc.args.minBetween,
+				tc.args.width,
+			).String()
+
+			assert.Equal(tc.expect, actualDirect, "InsertFlowColumnsOpts(opts) check failed")
+			assert.Equal(tc.expect, actualPreOpts, "WithOptions(opts).InsertFlowColumns() check failed")
+		})
+	}
+}
+
+func Test_InsertDefinitionsTable(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		pos    int
+		defs   [][2]string
+		width  int
+		expect string
+	}{
+		{
+			name:   "empty lines",
+			input:  "",
+			pos:    0,
+			defs:   [][2]string{},
+			width:  80,
+			expect: "",
+		},
+		{
+			name:  "one def, too short to wrap",
+			input: "",
+			pos:   0,
+			defs: [][2]string{
+				{"def1", "this is the first definition"},
+			},
+			width:  80,
+			expect: "  def1  - this is the first definition" + DefaultLineSeparator,
+		},
+		{
+			name:  "one def, long enough to wrap once",
+			input: "",
+			pos:   0,
+			defs: [][2]string{
+				{"def1", "this is the first definition with a rather long outro which will cause it to become wrapped"},
+			},
+			width: 80,
+			expect: "  def1  - this is the first definition with a rather long outro which will cause" + DefaultLineSeparator +
+				"          it to become wrapped" + DefaultLineSeparator,
 		},
 		{
 			name:  "one def, long enough to wrap multiple times",
@@ -1911,6 +3133,54 @@ func Test_Overtype(t *testing.T) {
 	}
 }
 
+func Test_OvertypeOpts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		pos     int
+		text    string
+		options Options
+		expect  string
+	}{
+		{
+			name:    "no normalization",
+			input:   "test",
+			pos:     1,
+			text:    "e\u0301",
+			options: Options{},
+			expect:  "te\u0301st",
+		},
+		{
+			name:    "NFC",
+			input:   "test",
+			pos:     1,
+			text:    "e\u0301",
+			options: Options{Normalization: NFC},
+			expect:  "t\u00e9st",
+		},
+		{
+			name:    "NFKC changes number of characters written over",
+			input:   "testing",
+			pos:     1,
+			text:    "\ufb01",
+			options: Options{Normalization: NFKC},
+			expect:  "tfiting",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualDirect := Edit(tc.input).OvertypeOpts(tc.pos, tc.text, tc.options).String()
+			actualPreOpts := Edit(tc.input).WithOptions(tc.options).Overtype(tc.pos, tc.text).String()
+
+			assert.Equal(tc.expect, actualDirect, "OvertypeOpts(opts) check failed")
+			assert.Equal(tc.expect, actualPreOpts, "WithOptions(opts).Overtype() check failed")
+		})
+	}
+}
+
 func Test_Align(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -2402,19 +3672,296 @@ func Test_AlignOpts(t *testing.T) {
 	}
 }
 
-func Test_InsertTable(t *testing.T) {
+func Test_InsertStructTable(t *testing.T) {
+	type person struct {
+		Name    string
+		Class   string `rosed:"Title"`
+		Age     int    `rosed:",align=right"`
+		private string
+		Skipped string `rosed:"-"`
+	}
+
 	testCases := []struct {
-		name   string
-		input  string
-		pos    int
-		data   [][]string
-		width  int
-		expect string
+		name      string
+		input     string
+		pos       int
+		slice     interface{}
+		width     int
+		expect    string
+		expectErr bool
 	}{
 		{
-			name:   "empty table",
-			input:  "",
-			pos:    0,
+			name:  "slice of structs",
+			input: "",
+			pos:   0,
+			slice: []person{
+				{Name: "John", Class: "Heir", Age: 13, private: "x", Skipped: "y"},
+				{Name: "Rose", Class: "Seer", Age: 9},
+			},
+			width: 0,
+			expect: "NAME  TITLE  AGE\n" +
+				"----------------\n" +
+				"John  Heir    13\n" +
+				"Rose  Seer     9\n",
+		},
+		{
+			name:  "slice of struct pointers with nil",
+			input: "",
+			pos:   0,
+			slice: []*person{
+				{Name: "John", Class: "Heir", Age: 13},
+				nil,
+			},
+			width: 0,
+			expect: "NAME  TITLE  AGE\n" +
+				"----------------\n" +
+				"John  Heir    13\n" +
+				"                \n",
+		},
+		{
+			name:  "slice of maps",
+			input: "",
+			pos:   0,
+			slice: []map[string]int{
+				{"b": 2, "a": 1},
+				{"c": 3},
+			},
+			width: 0,
+			expect: "A  B  C\n" +
+				"-------\n" +
+				"1  2   \n" +
+				"      3\n",
+		},
+		{
+			name:  "empty slice of structs has only header",
+			input: "",
+			pos:   0,
+			slice: []person{},
+			width: 0,
+			expect: "NAME  TITLE  AGE\n" +
+				"----------------\n",
+		},
+		{
+			name:   "empty slice of maps",
+			input:  "text",
+			pos:    0,
+			slice:  []map[string]string{},
+			width:  0,
+			expect: "text",
+		},
+		{
+			name:      "not a slice",
+			input:     "text",
+			pos:       0,
+			slice:     person{},
+			width:     0,
+			expect:    "text",
+			expectErr: true,
+		},
+		{
+			name:      "slice of non-structs",
+			input:     "text",
+			pos:       0,
+			slice:     []string{"a"},
+			width:     0,
+			expect:    "text",
+			expectErr: true,
+		},
+		{
+			name:  "embedded struct fields and order",
+			input: "",
+			pos:   0,
+			slice: []struct {
+				Extra string `rosed:",order=1"`
+				person
+			}{
+				{Extra: "x", person: person{Name: "John", Class: "Heir", Age: 13}},
+			},
+			width: 0,
+			expect: "NAME  TITLE  AGE  EXTRA\n" +
+				"-----------------------\n" +
+				"John  Heir    13  x    \n",
+		},
+		{
+			name:  "stringers, errors, and nil pointers",
+			input: "",
+			pos:   0,
+			slice: []struct {
+				Align Alignment
+				Err   error
+				Ptr   *int
+			}{
+				{Align: Right, Err: fmt.Errorf("failed"), Ptr: nil},
+			},
+			width: 0,
+			expect: "ALIGN  ERR     PTR\n" +
+				"------------------\n" +
+				"Right  failed     \n",
+		},
+		{
+			name:  "width tag",
+			input: "",
+			pos:   0,
+			slice: []struct {
+				A string `rosed:",width=6"`
+				B string
+			}{
+				{A: "ab", B: "cd"},
+			},
+			width: 0,
+			expect: "A       B \n" +
+				"----------\n" +
+				"ab      cd\n",
+		},
+		{
+			name:  "bad struct tag value",
+			input: "text",
+			pos:   0,
+			slice: []struct {
+				A int `rosed:"a,width=wide"`
+			}{{A: 1}},
+			width:     0,
+			expect:    "text",
+			expectErr: true,
+		},
+		{
+			name:  "bad struct tag",
+			input: "text",
+			pos:   0,
+			slice: []struct {
+				A int `rosed:"a,align=sideways"`
+			}{{A: 1}},
+			width:     0,
+			expect:    "text",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := Edit(tc.input).InsertStructTable(tc.pos, tc.slice, tc.width)
+			if tc.expectErr {
+				assert.Error(err)
+			} else {
+				assert.NoError(err)
+			}
+
+			assert.Equal(tc.expect, actual.String())
+		})
+	}
+}
+
+func Test_InsertStructTableOpts(t *testing.T) {
+	type item struct {
+		Name  string
+		Price float64 `rosed:",align=decimal,order=-1"`
+		Notes string  `rosed:",max=5"`
+	}
+
+	items := []item{
+		{Name: "apple", Price: 1.5, Notes: "crisp and red"},
+		{Name: "kiwi", Price: 10.25, Notes: "fuzzy"},
+	}
+
+	testCases := []struct {
+		name    string
+		input   string
+		pos     int
+		slice   interface{}
+		width   int
+		options Options
+		expect  string
+	}{
+		{
+			name:  "tags with borders",
+			input: "",
+			pos:   0,
+			slice: items,
+			width: 0,
+			options: Options{
+				TableBorders: true,
+			},
+			expect: "+-------+-------+-------+\n" +
+				"| PRICE |  NAME | NOTES |\n" +
+				"+-------+-------+-------+\n" +
+				"|  1.5  | apple | crisp |\n" +
+				"|       |       | and   |\n" +
+				"|       |       | red   |\n" +
+				"| 10.25 | kiwi  | fuzzy |\n" +
+				"+-------+-------+-------+\n",
+		},
+		{
+			name:  "tags override options only for their columns",
+			input: "",
+			pos:   0,
+			slice: items,
+			width: 0,
+			options: Options{
+				TableAlignments:   NewAlignments(Left, Right, Left),
+				TableColumnWidths: NewColumnWidths(ColumnWidth{}, ColumnWidth{Fixed: 6}, ColumnWidth{Fixed: 20}),
+			},
+			expect: "PRICE    NAME  NOTES\n" +
+				"--------------------\n" +
+				" 1.5    apple  crisp\n" +
+				"               and  \n" +
+				"               red  \n" +
+				"10.25    kiwi  fuzzy\n",
+		},
+		{
+			name:  "value formatter",
+			input: "",
+			pos:   0,
+			slice: items,
+			width: 0,
+			options: Options{}.WithTableValueFormatter(func(header string, value interface{}) string {
+				if f, ok := value.(float64); ok {
+					return fmt.Sprintf("$%.2f", f)
+				}
+				return fmt.Sprint(value)
+			}),
+			expect: " PRICE  NAME   NOTES\n" +
+				"--------------------\n" +
+				" $1.50  apple  crisp\n" +
+				"               and  \n" +
+				"               red  \n" +
+				"$10.25  kiwi   fuzzy\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualDirect, err := Edit(tc.input).InsertStructTableOpts(tc.pos, tc.slice, tc.width, tc.options)
+			if !assert.NoError(err) {
+				return
+			}
+			actualPreOpts, err := Edit(tc.input).WithOptions(tc.options).InsertStructTable(tc.pos, tc.slice, tc.width)
+			if !assert.NoError(err) {
+				return
+			}
+
+			assert.Equal(tc.expect, actualDirect.String(), "InsertStructTableOpts(opts) check failed")
+			assert.Equal(tc.expect, actualPreOpts.String(), "WithOptions(opts).InsertStructTable() check failed")
+		})
+	}
+}
+
+func Test_InsertTable(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		pos    int
+		data   [][]string
+		width  int
+		expect string
+	}{
+		{
+			name:   "empty table",
+			input:  "",
+			pos:    0,
 			data:   [][]string{},
 			width:  60,
 			expect: "",
@@ -2495,6 +4042,27 @@ func Test_InsertTable(t *testing.T) {
 	}
 }
 
+// rowsRenderer is a TableRenderer that draws each row of a table on a line of
+// its own, with the lines of each cell joined by "/".
+type rowsRenderer struct{}
+
+func (rowsRenderer) RenderTable(layout TableLayout, opts Options) string {
+	rows := make([][]string, layout.Rows)
+	for _, cell := range layout.Cells {
+		rows[cell.Row] = append(rows[cell.Row], strings.Join(cell.Lines, "/"))
+	}
+
+	lines := make([]string, len(rows))
+	for row := range rows {
+		label := fmt.Sprintf("row %d", row)
+		if layout.Header && row == 0 {
+			label = "header"
+		}
+		lines[row] = label + ": " + strings.Join(rows[row], "; ")
+	}
+	return strings.Join(lines, opts.LineSeparator)
+}
+
 func Test_InsertTableOpts(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -2716,17 +4284,1010 @@ func Test_InsertTableOpts(t *testing.T) {
 				"Dirk        Strider      Human<br/>\n" +
 				"Equius      Zahhak       Troll<br/>\n",
 		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			assert := assert.New(t)
-
-			actualDirect := Edit(tc.input).InsertTableOpts(tc.pos, tc.data, tc.width, tc.options).String()
-			actualPreOpts := Edit(tc.input).WithOptions(tc.options).InsertTable(tc.pos, tc.data, tc.width).String()
-
-			assert.Equal(tc.expect, actualDirect, "InsertTableOpts(opts) check failed")
-			assert.Equal(tc.expect, actualPreOpts, "WithOptions(opts).InsertTable() check failed")
+		{
+			name:  "cell contents are normalized",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"Name", "Word"},
+				{"Jade", "fiance\u0301e"},
+				{"Dave", "\ufb01nal"},
+			},
+			width: 0,
+			options: Options{
+				Normalization: NFKC,
+			},
+			expect: "Name  Word   \nJade  fianc\u00e9e\nDave  final  \n",
+		},
+		{
+			name:  "right and decimal aligned columns",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"Item", "Qty", "Cost"},
+				{"Pen", "3", "1.5"},
+				{"Notebook", "12", "10.25"},
+			},
+			width: 0,
+			options: Options{
+				TableHeaders:    true,
+				TableAlignments: NewAlignments(Left, Right, Decimal),
+			},
+			expect: "ITEM      QTY   COST\n" +
+				"--------------------\n" +
+				"Pen         3   1.5 \n" +
+				"Notebook   12  10.25\n",
+		},
+		{
+			name:  "headers as given with borders",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"id", "Name"},
+				{"1", "Jade"},
+			},
+			width: 0,
+			options: Options{
+				TableHeaders:    true,
+				TableBorders:    true,
+				TableHeaderCase: HeaderAsIs,
+				TableAlignments: NewAlignments(Right),
+			},
+			expect: "+----+------+\n" +
+				"| id | Name |\n" +
+				"+----+------+\n" +
+				"|  1 | Jade |\n" +
+				"+----+------+\n",
+		},
+		{
+			name:  "light table style",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"Name", "Class"},
+				{"John", "Heir"},
+			},
+			width: 0,
+			options: Options{
+				TableHeaders: true,
+				TableBorders: true,
+				TableStyle:   TableStyleLight,
+			},
+			expect: "┌──────┬───────┐\n" +
+				"│ NAME │ CLASS │\n" +
+				"├──────┼───────┤\n" +
+				"│ John │ Heir  │\n" +
+				"└──────┴───────┘\n",
+		},
+		{
+			name:  "markdown table style",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"Name", "Class"},
+				{"John", "Heir"},
+			},
+			width: 0,
+			options: Options{
+				TableHeaders:    true,
+				TableBorders:    true,
+				TableHeaderCase: HeaderAsIs,
+				TableStyle:      TableStyleMarkdown,
+			},
+			expect: "| Name | Class |\n" +
+				"|------|-------|\n" +
+				"| John | Heir  |\n",
+		},
+		{
+			name:  "table style is used instead of char set",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"John", "Heir"},
+			},
+			width: 0,
+			options: Options{
+				TableBorders: true,
+				TableCharSet: "#!~",
+				TableStyle:   TableStyleDouble,
+			},
+			expect: "╔══════╦══════╗\n" +
+				"║ John ║ Heir ║\n" +
+				"╚══════╩══════╝\n",
+		},
+		{
+			name:  "table style with empty members",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"Name", "Class"},
+				{"John", "Heir"},
+			},
+			width: 0,
+			options: Options{
+				TableHeaders: true,
+				TableBorders: true,
+				TableStyle: TableStyle{
+					Top:        "=",
+					Horizontal: "-",
+					Bottom:     "=",
+				},
+			},
+			expect: " ====== ======= \n" +
+				"  NAME   CLASS  \n" +
+				" ------ ------- \n" +
+				"  John   Heir   \n" +
+				" ====== ======= \n",
+		},
+		{
+			name:  "table fit to width",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"Name", "Description"},
+				{"Widget", "A small device used for holding things"},
+			},
+			width: 30,
+			options: Options{
+				TableBorders: true,
+				TableFit:     true,
+			},
+			expect: "+---------+------------------+\n" +
+				"| Name    | Description      |\n" +
+				"| Widget  | A small device   |\n" +
+				"|         | used for holding |\n" +
+				"|         | things           |\n" +
+				"+---------+------------------+\n",
+		},
+		{
+			name:  "table fit to width with minimum widths and no-wrap columns",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"Name", "Description", "Code"},
+				{"Widget", "A small device used for holding things", "W-1234"},
+			},
+			width: 34,
+			options: Options{
+				TableFit:           true,
+				TableMinWidths:     NewWidths(4, 20),
+				TableNoWrapColumns: NewColumnIndexes(2),
+			},
+			expect: "Name   Description          Code  \n" +
+				"Wid-   A small device used  W-1234\n" +
+				"get    for holding things         \n",
+		},
+		{
+			name:  "markdown table",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"Name", "Class", "Score"},
+				{"John", "Heir|Breath", "1.5"},
+				{"Rose", "Seer", "10"},
+			},
+			width: 0,
+			options: Options{
+				TableFormat:     TableFormatMarkdown,
+				TableHeaderCase: HeaderAsIs,
+				TableAlignments: NewAlignments(Left, Center, Right),
+			},
+			expect: "| Name |     Class    | Score |\n" +
+				"|:-----|:------------:|------:|\n" +
+				"| John | Heir\\|Breath |   1.5 |\n" +
+				"| Rose |     Seer     |    10 |\n",
+		},
+		{
+			name:  "markdown headers are as given and aligned like their columns",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"Name", "Class", "Age"},
+				{"John Egbert", "Heir of Breath", "13"},
+			},
+			width: 0,
+			options: Options{
+				TableFormat:     TableFormatMarkdown,
+				TableAlignments: NewAlignments(Left, Center, Right),
+			},
+			expect: "| Name        |      Class     | Age |\n" +
+				"|:------------|:--------------:|----:|\n" +
+				"| John Egbert | Heir of Breath |  13 |\n",
+		},
+		{
+			name:  "markdown headers with header case set",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"Name", "Class"},
+				{"John Egbert", "Heir of Breath"},
+			},
+			width: 0,
+			options: Options{
+				TableFormat:     TableFormatMarkdown,
+				TableHeaderCase: HeaderUpper,
+				TableAlignments: NewAlignments(Left, Right),
+			},
+			expect: "| NAME        |          CLASS |\n" +
+				"|:------------|---------------:|\n" +
+				"| John Egbert | Heir of Breath |\n",
+		},
+		{
+			name:  "markdown table padded to width",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"Name", "Class"},
+				{"John", "Heir"},
+			},
+			width: 20,
+			options: Options{
+				TableFormat:  TableFormatMarkdown,
+				TableBorders: false,
+			},
+			expect: "| Name   | Class   |\n" +
+				"|--------|---------|\n" +
+				"| John   | Heir    |\n",
+		},
+		{
+			name:  "fixed and maximum column widths",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"Name", "Class"},
+				{"John", "Heir of Breath"},
+			},
+			width: 30,
+			options: Options{
+				TableBorders:      true,
+				TableColumnWidths: NewColumnWidths(ColumnWidth{Fixed: 8}, ColumnWidth{Max: 6}),
+			},
+			expect: "+----------+--------+\n" +
+				"| Name     | Class  |\n" +
+				"| John     | Heir   |\n" +
+				"|          | of     |\n" +
+				"|          | Breath |\n" +
+				"+----------+--------+\n",
+		},
+		{
+			name:  "weighted column widths without borders",
+			input: "",
+			pos:   0,
+			data: [][]string{
+				{"a", "b", "c"},
+				{"1", "2", "3"},
+			},
+			width: 17,
+			options: Options{
+				TableColumnWidths: NewColumnWidths(ColumnWidth{Weight: 1}, ColumnWidth{Weight: 3}),
+			},
+			expect: "a     b         c\n" +
+				"1     2         3\n",
+		},
+		{
+			name:  "expanded",
+			input: "",
+			pos:   0,
+			data:  [][]string{{"Name", "Age"}, {"Rose", "13"}, {"Dave", "13"}},
+			width: 30,
+			options: Options{
+				TableHeaders: true,
+				TableExpand:  ExpandOn,
+			},
+			expect: "-[ RECORD 1 ]\nNAME | Rose\nAGE  | 13\n-[ RECORD 2 ]\nNAME | Dave\nAGE  | 13\n",
+		},
+		{
+			name:  "auto expand does not expand table that fits",
+			input: "",
+			pos:   0,
+			data:  [][]string{{"Name", "Age"}, {"Rose", "13"}},
+			width: 12,
+			options: Options{
+				TableHeaders: true,
+				TableExpand:  ExpandAuto,
+			},
+			expect: "NAME     AGE\n------------\nRose     13 \n",
+		},
+		{
+			name:  "expand is ignored for markdown",
+			input: "",
+			pos:   0,
+			data:  [][]string{{"Name", "Age"}, {"Rose", "13"}},
+			width: 0,
+			options: Options{
+				TableFormat: TableFormatMarkdown,
+				TableExpand: ExpandOn,
+			},
+			expect: "| Name | Age |\n|------|-----|\n| Rose | 13  |\n",
+		},
+		{
+			name:  "custom renderer",
+			input: "",
+			pos:   0,
+			data:  [][]string{{"Name", "Title"}, {"Rose", "Seer of Light"}},
+			width: 0,
+			options: Options{
+				TableHeaders:  true,
+				TableRenderer: rowsRenderer{},
+			},
+			expect: "header: Name; Title\nrow 1: Rose; Seer of Light\n",
+		},
+		{
+			name:  "custom renderer is given the wrapped lines of cells",
+			input: "",
+			pos:   0,
+			data:  [][]string{{"Name", "Title"}, {"Rose", "Seer of Light"}},
+			width: 12,
+			options: Options{
+				TableHeaders:  true,
+				TableFit:      true,
+				TableRenderer: rowsRenderer{},
+			},
+			expect: "header: Name; Title\nrow 1: Rose; Seer/of/Light\n",
+		},
+		{
+			name:  "custom renderer drawing a built-in format",
+			input: "",
+			pos:   0,
+			data:  [][]string{{"Name", "Age"}, {"Rose", "13"}},
+			width: 0,
+			options: Options{
+				TableFormat:   TableFormatMarkdown,
+				TableRenderer: TableFormatMarkdown,
+			},
+			expect: "| Name | Age |\n|------|-----|\n| Rose | 13  |\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualDirect := Edit(tc.input).InsertTableOpts(tc.pos, tc.data, tc.width, tc.options).String()
+			actualPreOpts := Edit(tc.input).WithOptions(tc.options).InsertTable(tc.pos, tc.data, tc.width).String()
+
+			assert.Equal(tc.expect, actualDirect, "InsertTableOpts(opts) check failed")
+			assert.Equal(tc.expect, actualPreOpts, "WithOptions(opts).InsertTable() check failed")
+		})
+	}
+}
+
+func Test_InsertTableCells(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		pos    int
+		data   [][]Cell
+		width  int
+		expect string
+	}{
+		{
+			name:   "empty table",
+			input:  "",
+			pos:    0,
+			data:   [][]Cell{},
+			width:  20,
+			expect: "",
+		},
+		{
+			name:  "no spans",
+			input: "",
+			pos:   0,
+			data: [][]Cell{
+				{{Text: "John"}, {Text: "Heir"}},
+				{{Text: "Rose"}, {Text: "Seer"}},
+			},
+			width: 0,
+			expect: "John  Heir\n" +
+				"Rose  Seer\n",
+		},
+		{
+			name:  "cells spanning columns and rows",
+			input: "--",
+			pos:   1,
+			data: [][]Cell{
+				{{Text: "Kids", RowSpan: 2}, {Text: "John"}, {Text: "Heir"}},
+				{{Text: "Rose"}, {Text: "Seer"}},
+				{{Text: "Trolls"}, {Text: "Karkat Vantas", ColSpan: 2}},
+			},
+			width: 0,
+			expect: "-Kids    John    Heir \n" +
+				"        Rose    Seer \n" +
+				"Trolls  Karkat Vantas\n" +
+				"-",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := Edit(tc.input).InsertTableCells(tc.pos, tc.data, tc.width).String()
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_InsertTableCellsOpts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		pos     int
+		data    [][]Cell
+		width   int
+		options Options
+		expect  string
+	}{
+		{
+			name:  "header spanning columns with borders",
+			input: "",
+			pos:   0,
+			data: [][]Cell{
+				{{Text: "Region"}, {Text: "Q1", ColSpan: 2}, {Text: "Q2", ColSpan: 2}},
+				{{Text: ""}, {Text: "Jan"}, {Text: "Feb"}, {Text: "Apr"}, {Text: "May"}},
+				{{Text: "North"}, {Text: "10"}, {Text: "20"}, {Text: "30"}, {Text: "40"}},
+			},
+			width: 0,
+			options: Options{
+				TableHeaders: true,
+				TableBorders: true,
+				TableStyle:   TableStyleLight,
+			},
+			expect: "┌────────┬───────────┬───────────┐\n" +
+				"│ REGION │     Q1    │     Q2    │\n" +
+				"├────────┼─────┬─────┼─────┬─────┤\n" +
+				"│        │ Jan │ Feb │ Apr │ May │\n" +
+				"│ North  │ 10  │ 20  │ 30  │ 40  │\n" +
+				"└────────┴─────┴─────┴─────┴─────┘\n",
+		},
+		{
+			name:  "row span with ascii borders and no trailing separator",
+			input: "",
+			pos:   0,
+			data: [][]Cell{
+				{{Text: "North", RowSpan: 2}, {Text: "10"}},
+				{{Text: "20"}},
+			},
+			width: 0,
+			options: Options{
+				TableBorders:             true,
+				NoTrailingLineSeparators: true,
+			},
+			expect: "+-------+----+\n" +
+				"| North | 10 |\n" +
+				"|       | 20 |\n" +
+				"+-------+----+",
+		},
+		{
+			name:  "html format",
+			input: "",
+			pos:   0,
+			data: [][]Cell{
+				{{Text: "Region"}, {Text: "Sales", ColSpan: 2}},
+				{{Text: "North"}, {Text: "10"}, {Text: "20"}},
+			},
+			width: 0,
+			options: Options{
+				TableHeaders:    true,
+				TableHeaderCase: HeaderAsIs,
+				TableFormat:     TableFormatHTML,
+				LineSeparator:   "\r\n",
+			},
+			expect: "<table>\r\n" +
+				"  <thead>\r\n" +
+				"    <tr>\r\n" +
+				"      <th>Region</th>\r\n" +
+				"      <th colspan=\"2\">Sales</th>\r\n" +
+				"    </tr>\r\n" +
+				"  </thead>\r\n" +
+				"  <tbody>\r\n" +
+				"    <tr>\r\n" +
+				"      <td>North</td>\r\n" +
+				"      <td>10</td>\r\n" +
+				"      <td>20</td>\r\n" +
+				"    </tr>\r\n" +
+				"  </tbody>\r\n" +
+				"</table>\r\n",
+		},
+		{
+			name:  "rst grid format",
+			input: "",
+			pos:   0,
+			data: [][]Cell{
+				{{Text: "Region"}, {Text: "Sales"}},
+				{{Text: "North", RowSpan: 2}, {Text: "10"}},
+				{{Text: "20"}},
+			},
+			width: 0,
+			options: Options{
+				TableHeaders: true,
+				TableFormat:  TableFormatRSTGrid,
+			},
+			expect: "+--------+-------+\n" +
+				"| REGION | SALES |\n" +
+				"+========+=======+\n" +
+				"| North  | 10    |\n" +
+				"|        +-------+\n" +
+				"|        | 20    |\n" +
+				"+--------+-------+\n",
+		},
+		{
+			name:  "rst simple format",
+			input: "",
+			pos:   0,
+			data: [][]Cell{
+				{{Text: "Region"}, {Text: "Sales"}},
+				{{Text: "North"}, {Text: "10"}},
+			},
+			width: 0,
+			options: Options{
+				TableHeaders: true,
+				TableFormat:  TableFormatRSTSimple,
+			},
+			expect: "======  =====\n" +
+				"REGION  SALES\n" +
+				"======  =====\n" +
+				"North   10   \n" +
+				"======  =====\n",
+		},
+		{
+			name:  "latex format",
+			input: "",
+			pos:   0,
+			data: [][]Cell{
+				{{Text: "Region"}, {Text: "Sales"}},
+				{{Text: "North & South"}, {Text: "10"}},
+			},
+			width: 0,
+			options: Options{
+				TableHeaders:    true,
+				TableBorders:    true,
+				TableAlignments: NewAlignments(Left, Right),
+				TableFormat:     TableFormatLaTeX,
+			},
+			expect: "\\begin{tabular}{|l|r|}\n" +
+				"\\hline\n" +
+				"REGION & SALES \\\\\n" +
+				"\\hline\n" +
+				"North \\& South & 10 \\\\\n" +
+				"\\hline\n" +
+				"\\end{tabular}\n",
+		},
+		{
+			name:  "markdown format",
+			input: "",
+			pos:   0,
+			data: [][]Cell{
+				{{Text: "a"}, {Text: "b"}},
+			},
+			width: 0,
+			options: Options{
+				TableFormat: TableFormatMarkdown,
+			},
+			expect: "| a | b |\n" +
+				"|---|---|\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualDirect := Edit(tc.input).InsertTableCellsOpts(tc.pos, tc.data, tc.width, tc.options).String()
+			actualPreOpts := Edit(tc.input).WithOptions(tc.options).InsertTableCells(tc.pos, tc.data, tc.width).String()
+
+			assert.Equal(tc.expect, actualDirect, "InsertTableCellsOpts(opts) check failed")
+			assert.Equal(tc.expect, actualPreOpts, "WithOptions(opts).InsertTableCells() check failed")
+		})
+	}
+}
+
+func Test_InsertTableFromCSV(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		pos       int
+		csv       string
+		width     int
+		expect    string
+		expectErr bool
+	}{
+		{
+			name:   "empty csv",
+			input:  "",
+			pos:    0,
+			csv:    "",
+			width:  20,
+			expect: "",
+		},
+		{
+			name:  "csv with quoted fields",
+			input: "",
+			pos:   0,
+			csv: "John,\"Heir, of Breath\"\n" +
+				"Rose,\"Seer of \"\"Light\"\"\"\n",
+			width: 0,
+			expect: "John  Heir, of Breath\n" +
+				"Rose  Seer of \"Light\"\n",
+		},
+		{
+			name:  "records with different numbers of fields",
+			input: "--",
+			pos:   1,
+			csv: "John,Heir,Breath\n" +
+				"Rose\n",
+			width: 0,
+			expect: "-John  Heir  Breath\n" +
+				"Rose              \n" +
+				"-",
+		},
+		{
+			name:      "invalid csv",
+			input:     "--",
+			pos:       1,
+			csv:       "John,\"Heir\n",
+			width:     0,
+			expect:    "--",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := Edit(tc.input).InsertTableFromCSV(tc.pos, strings.NewReader(tc.csv), tc.width)
+			if tc.expectErr {
+				assert.Error(err)
+			} else {
+				assert.NoError(err)
+			}
+
+			assert.Equal(tc.expect, actual.String())
+		})
+	}
+}
+
+func Test_InsertTableFromCSVOpts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		pos     int
+		csv     string
+		width   int
+		options Options
+		expect  string
+	}{
+		{
+			name:  "header with borders",
+			input: "",
+			pos:   0,
+			csv: "name,class\n" +
+				"John,Heir\n",
+			width: 0,
+			options: Options{
+				TableHeaders: true,
+				TableBorders: true,
+			},
+			expect: "+------+-------+\n" +
+				"| NAME | CLASS |\n" +
+				"+------+-------+\n" +
+				"| John | Heir  |\n" +
+				"+------+-------+\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualDirect, err := Edit(tc.input).InsertTableFromCSVOpts(tc.pos, strings.NewReader(tc.csv), tc.width, tc.options)
+			if !assert.NoError(err) {
+				return
+			}
+			actualPreOpts, err := Edit(tc.input).WithOptions(tc.options).InsertTableFromCSV(tc.pos, strings.NewReader(tc.csv), tc.width)
+			if !assert.NoError(err) {
+				return
+			}
+
+			assert.Equal(tc.expect, actualDirect.String(), "InsertTableFromCSVOpts(opts) check failed")
+			assert.Equal(tc.expect, actualPreOpts.String(), "WithOptions(opts).InsertTableFromCSV() check failed")
+		})
+	}
+}
+
+func Test_InsertTableFromTSV(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		pos       int
+		tsv       string
+		width     int
+		expect    string
+		expectErr bool
+	}{
+		{
+			name:  "tsv with commas and bare quotes",
+			input: "",
+			pos:   0,
+			tsv: "John\tHeir, of Breath\n" +
+				"Rose\t5\" tall\n",
+			width: 0,
+			expect: "John  Heir, of Breath\n" +
+				"Rose  5\" tall        \n",
+		},
+		{
+			name:   "quoted field with tab",
+			input:  "",
+			pos:    0,
+			tsv:    "John\t\"Heir\tBreath\"\n",
+			width:  0,
+			expect: "John  Heir\tBreath\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := Edit(tc.input).InsertTableFromTSV(tc.pos, strings.NewReader(tc.tsv), tc.width)
+			if tc.expectErr {
+				assert.Error(err)
+			} else {
+				assert.NoError(err)
+			}
+
+			assert.Equal(tc.expect, actual.String())
+		})
+	}
+}
+
+func Test_InsertTableFromTSVOpts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		pos     int
+		tsv     string
+		width   int
+		options Options
+		expect  string
+	}{
+		{
+			name:  "header with borders",
+			input: "",
+			pos:   0,
+			tsv: "name\tclass\n" +
+				"John\tHeir\n",
+			width: 0,
+			options: Options{
+				TableHeaders: true,
+				TableBorders: true,
+			},
+			expect: "+------+-------+\n" +
+				"| NAME | CLASS |\n" +
+				"+------+-------+\n" +
+				"| John | Heir  |\n" +
+				"+------+-------+\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualDirect, err := Edit(tc.input).InsertTableFromTSVOpts(tc.pos, strings.NewReader(tc.tsv), tc.width, tc.options)
+			if !assert.NoError(err) {
+				return
+			}
+			actualPreOpts, err := Edit(tc.input).WithOptions(tc.options).InsertTableFromTSV(tc.pos, strings.NewReader(tc.tsv), tc.width)
+			if !assert.NoError(err) {
+				return
+			}
+
+			assert.Equal(tc.expect, actualDirect.String(), "InsertTableFromTSVOpts(opts) check failed")
+			assert.Equal(tc.expect, actualPreOpts.String(), "WithOptions(opts).InsertTableFromTSV() check failed")
+		})
+	}
+}
+
+func Test_InsertTableObj(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		pos    int
+		table  Table
+		width  int
+		expect string
+	}{
+		{
+			name:   "empty table",
+			input:  "test",
+			pos:    0,
+			table:  Table{},
+			width:  10,
+			expect: "test",
+		},
+		{
+			name:  "rows only",
+			input: "",
+			pos:   0,
+			table: Table{}.AddRow("John", "Heir").AddRow("Rose", "Seer"),
+			width: 0,
+			expect: "John  Heir\n" +
+				"Rose  Seer\n",
+		},
+		{
+			name:  "header, separator, and footer",
+			input: "",
+			pos:   0,
+			table: Table{}.
+				SetHeader("name", "qty").
+				AddRow("apple", "10").
+				AddSeparator().
+				AddRow("pear", "3").
+				SetFooter("Total", "13"),
+			width: 0,
+			expect: "NAME   QTY\n" +
+				"----------\n" +
+				"apple  10 \n" +
+				"----------\n" +
+				"pear   3  \n" +
+				"----------\n" +
+				"Total  13 \n",
+		},
+		{
+			name:  "insert in middle of text",
+			input: "start\nend",
+			pos:   6,
+			table: Table{}.AddRow("a", "b"),
+			width: 0,
+			expect: "start\n" +
+				"a  b\n" +
+				"end",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := Edit(tc.input).InsertTableObj(tc.pos, tc.table, tc.width).String()
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_InsertTableObjOpts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		pos     int
+		table   Table
+		width   int
+		options Options
+		expect  string
+	}{
+		{
+			name:  "footer with borders",
+			input: "",
+			pos:   0,
+			table: Table{}.
+				SetHeader("name", "qty").
+				AddRow("apple", "10").
+				AddRow("pear", "3").
+				SetFooter("Total", "13"),
+			width: 0,
+			options: Options{
+				TableBorders: true,
+			},
+			expect: "+-------+-----+\n" +
+				"|  NAME | QTY |\n" +
+				"+-------+-----+\n" +
+				"| apple | 10  |\n" +
+				"| pear  | 3   |\n" +
+				"+-------+-----+\n" +
+				"| Total | 13  |\n" +
+				"+-------+-----+\n",
+		},
+		{
+			name:  "separators with borders",
+			input: "",
+			pos:   0,
+			table: Table{}.
+				AddRow("a", "1").
+				AddSeparator().
+				AddRow("b", "2").
+				AddSeparator().
+				AddRow("c", "3"),
+			width: 0,
+			options: Options{
+				TableBorders: true,
+				TableStyle:   TableStyleLight,
+			},
+			expect: "┌───┬───┐\n" +
+				"│ a │ 1 │\n" +
+				"├───┼───┤\n" +
+				"│ b │ 2 │\n" +
+				"├───┼───┤\n" +
+				"│ c │ 3 │\n" +
+				"└───┴───┘\n",
+		},
+		{
+			name:  "table headers option is ignored",
+			input: "",
+			pos:   0,
+			table: Table{}.AddRow("a", "b").AddRow("c", "d"),
+			width: 0,
+			options: Options{
+				TableHeaders: true,
+			},
+			expect: "a  b\n" +
+				"c  d\n",
+		},
+		{
+			name:  "footer and separators in html",
+			input: "",
+			pos:   0,
+			table: Table{}.
+				AddRow("a").
+				AddSeparator().
+				AddRow("b").
+				SetFooter("c"),
+			width: 0,
+			options: Options{
+				TableFormat: TableFormatHTML,
+			},
+			expect: "<table>\n" +
+				"  <tbody>\n" +
+				"    <tr>\n" +
+				"      <td>a</td>\n" +
+				"    </tr>\n" +
+				"  </tbody>\n" +
+				"  <tbody>\n" +
+				"    <tr>\n" +
+				"      <td>b</td>\n" +
+				"    </tr>\n" +
+				"  </tbody>\n" +
+				"  <tfoot>\n" +
+				"    <tr>\n" +
+				"      <td>c</td>\n" +
+				"    </tr>\n" +
+				"  </tfoot>\n" +
+				"</table>\n",
+		},
+		{
+			name:  "footer and separators in markdown are plain rows",
+			input: "",
+			pos:   0,
+			table: Table{}.
+				SetHeader("x").
+				AddRow("a").
+				AddSeparator().
+				AddRow("b").
+				SetFooter("c"),
+			width: 0,
+			options: Options{
+				TableFormat: TableFormatMarkdown,
+			},
+			expect: "| x |\n" +
+				"|---|\n" +
+				"| a |\n" +
+				"| b |\n" +
+				"| c |\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actualDirect := Edit(tc.input).InsertTableObjOpts(tc.pos, tc.table, tc.width, tc.options).String()
+			actualPreOpts := Edit(tc.input).WithOptions(tc.options).InsertTableObj(tc.pos, tc.table, tc.width).String()
+
+			assert.Equal(tc.expect, actualDirect, "InsertTableObjOpts(opts) check failed")
+			assert.Equal(tc.expect, actualPreOpts, "WithOptions(opts).InsertTableObj() check failed")
 		})
 	}
 }
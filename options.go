@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/dekarrin/rosed/internal/gem"
+	"github.com/dekarrin/rosed/internal/manip"
 )
 
 const (
@@ -20,17 +21,22 @@ const (
 
 	// DefaultTableCharSet is the default characters used to draw table borders.
 	DefaultTableCharSet = "+|-"
+
+	// DefaultEllipsis is the default sequence that replaces the text that is
+	// removed when text is truncated.
+	DefaultEllipsis = "\u2026"
 )
 
 // Options control the behavior of an [Editor]. The zero-value is an Options
 // with all members set to defaults.
 //
-// IndentStr, LineSeparator, ParagraphSeparator, and TableChars have special
-// behavior if not set manually. In a zero-valued Options, each one will be the
-// empty string. When interpreting the options in the course of performing an
-// operation, functions that use those values will treat an empty string as
-// [DefaultIndentString], [DefaultLineSeparator], [DefaultParagraphSeparator],
-// or [DefaultTableChars] respectively.
+// IndentStr, LineSeparator, ParagraphSeparator, TableChars, and Ellipsis have
+// special behavior if not set manually. In a zero-valued Options, each one will
+// be the empty string. When interpreting the options in the course of
+// performing an operation, functions that use those values will treat an empty
+// string as [DefaultIndentString], [DefaultLineSeparator],
+// [DefaultParagraphSeparator], [DefaultTableChars], or [DefaultEllipsis]
+// respectively.
 type Options struct {
 	// IndentStr is the string that is used for a single horizontal indent. If
 	// this is set to "", it will be interpreted as though it were set to
@@ -78,6 +84,114 @@ type Options struct {
 	// behavior.
 	JustifyLastLine bool
 
+	// DisplayWidth sets whether text is measured by the number of cells it
+	// takes up when displayed in a terminal rather than by the number of
+	// grapheme clusters in it. If set, characters that are Wide or Fullwidth
+	// according to their East Asian Width property, such as CJK ideographs, and
+	// emoji that are displayed as emoji are considered to be 2 cells wide, and
+	// characters that do not take up any horizontal space on their own, such as
+	// control characters, are considered to be 0 cells wide. All other
+	// characters are considered to be 1 cell wide.
+	//
+	// This affects every operation that lays out text to fit a width, such as
+	// wrapping, alignment, justification, columns, and tables. It does not
+	// affect operations that index text by character position.
+	DisplayWidth bool
+
+	// ANSIEscapes sets whether ANSI escape sequences (such as the SGR sequences
+	// used to color terminal output and the OSC sequences used for hyperlinks)
+	// are treated as zero-width units that are never split apart. If this is
+	// not set, the characters in an escape sequence are treated the same as any
+	// others.
+	//
+	// Additionally, when this is set and an operation breaks a line in the
+	// middle of styled text, the SGR styling that is active at the break is
+	// closed at the end of the line and reopened at the start of the next line,
+	// and styling within a table cell is closed before the end of the cell.
+	ANSIEscapes bool
+
+	// LineBreaking is the way that text is broken into lines by operations
+	// that wrap it. If set to LineBreakSpaces, lines are only broken at spaces.
+	// If set to LineBreakUAX14, lines are broken at the line break
+	// opportunities given by the Unicode Line Breaking Algorithm, and no-break
+	// spaces are not treated as whitespace to be collapsed.
+	LineBreaking LineBreakMode
+
+	// WrapAlgorithm is the way that operations which wrap text choose where
+	// lines are broken. If set to WrapGreedy, each line is filled with as many
+	// words as will fit before moving on to the next. If set to
+	// WrapMinimumRaggedness or WrapKnuthPlass, the line breaks are instead
+	// chosen for each paragraph as a whole to make the lines more even.
+	WrapAlgorithm WrapAlgorithm
+
+	// Hyphenate sets whether operations that wrap text hyphenate words at the
+	// points given by Hyphenator. If set, a word is hyphenated at the end of a
+	// line whenever doing so lets the line hold more of the text, and a word
+	// that is too long to fit on a line by itself is split at those points
+	// where possible instead of at an arbitrary character.
+	//
+	// Soft hyphens (U+00AD) are honored as hyphenation points as well; a word
+	// that contains any is hyphenated only at its soft hyphens. When this is
+	// set, soft hyphens are removed from wrapped text, and a "-" is shown in
+	// place of any that a line is broken at.
+	Hyphenate bool
+
+	// Hyphenator is used to find the points at which words can be hyphenated
+	// when Hyphenate is set. If this is the zero value, the built-in American
+	// English hyphenation patterns are used.
+	Hyphenator Hyphenator
+
+	// PreserveWhitespace sets whether operations that wrap text keep the
+	// existing line breaks and whitespace in it instead of collapsing all
+	// whitespace, including line separators, into single spaces first. If set,
+	// each existing line is wrapped on its own, lines are only broken at
+	// whitespace, and the whitespace that a line is broken at is removed; all
+	// other whitespace, including the indentation at the start of each line,
+	// is left as it is. This is useful for wrapping text such as source code,
+	// ASCII art, or log output.
+	//
+	// When this is set, each line is filled with as many words as will fit as
+	// though WrapAlgorithm were set to WrapGreedy, lines are not broken at the
+	// opportunities given by LineBreaking, and words are not hyphenated.
+	PreserveWhitespace bool
+
+	// RepeatIndent sets whether the leading whitespace of a line is repeated
+	// at the start of each line that it is wrapped onto when
+	// PreserveWhitespace is set. If the leading whitespace is too wide to
+	// leave room for at least two characters after it, it is not repeated.
+	RepeatIndent bool
+
+	// LinePrefix is the prefix that begins each line of text given to reflow
+	// operations, such as "// " or "> ". If this is set to "", reflow
+	// operations find the prefix that is shared by the lines automatically.
+	LinePrefix string
+
+	// RecognizeLists sets whether operations that wrap text recognize the
+	// items of bulleted and numbered lists in it. If set, each line that
+	// begins with a bullet ("-", "*", "+", or "•") or a number followed by "."
+	// or ")", after any leading whitespace, starts a new list item. Each item
+	// is wrapped on its own instead of being joined with the text around it,
+	// and the lines it is wrapped onto are indented to line up with the text
+	// after its marker. The leading whitespace before each marker is kept, so
+	// nested lists keep their structure. Blank lines between items are kept as
+	// well, and indented text after a blank line is wrapped as a paragraph of
+	// its own that lines up with the text of the item above it.
+	//
+	// This has no effect if PreserveWhitespace is set.
+	RecognizeLists bool
+
+	// Ellipsis is the sequence that replaces the text that is removed by
+	// truncate operations. If this is set to "", it will be interpreted as
+	// though it were set to DefaultEllipsis.
+	Ellipsis string
+
+	// Normalization is the Unicode normalization form that text added to the
+	// Editor is converted to. This applies to text given to insert and
+	// overtype operations as well as to the data given to table operations. If
+	// set to NoNormalization, added text is left in whatever form it is given
+	// in.
+	Normalization NormalizationForm
+
 	// TableBorders sets whether created tables draw cell and table borders on
 	// them.
 	TableBorders bool
@@ -99,28 +213,167 @@ type Options struct {
 	// missing characters up to 3 will be filled in from the remaining chars in
 	// DefaultTableCharSet; e.g. setting TableCharSet to "#" will result in an
 	// interpreted TableCharSet of "#|-".
+	//
+	// TableCharSet is ignored if TableStyle is set.
 	TableCharSet string
+
+	// TableStyle is the full set of characters used to draw tables, with a
+	// separate character for each kind of corner and junction. This allows
+	// tables to be drawn with box-drawing characters, such as with one of the
+	// built-in styles TableStyleASCII, TableStyleLight, TableStyleHeavy,
+	// TableStyleDouble, TableStyleRounded, or TableStyleMarkdown. If it is set
+	// to the zero value, tables are drawn with TableCharSet.
+	TableStyle TableStyle
+
+	// TableAlignments is the alignment of the content of each column of
+	// created tables, in order from the leftmost column. Columns that do not
+	// have an entry, or whose entry is None, are aligned to the left. A column
+	// with Decimal alignment has its numbers lined up on their decimal points.
+	//
+	// Headers are aligned the same way as the rest of their column unless
+	// TableBorders is set, in which case they are centered. Headers of tables
+	// created with TableFormatMarkdown are always aligned like their column.
+	TableAlignments Alignments
+
+	// TableHeaderCase is the way that the letters in table headers are cased.
+	// It defaults to HeaderDefault, which converts headers to upper case in
+	// every format except TableFormatMarkdown, where they are left as they
+	// are given.
+	TableHeaderCase HeaderCase
+
+	// TableFit sets whether created tables that would be wider than the width
+	// they are made for are narrowed to fit within it. If set, the widest
+	// columns are narrowed one character at a time until the table fits, and
+	// the content of each cell that no longer fits in its column is wrapped
+	// onto as many lines as it needs, as by [Editor.Wrap]. Columns are not
+	// narrowed past their minimum width in TableMinWidths, columns listed in
+	// TableNoWrapColumns are not narrowed at all, and neither are columns with
+	// Decimal alignment. Columns with Right alignment and columns of numbers
+	// are not narrowed past their widest word, so numbers are never split
+	// across lines. If the table cannot be narrowed enough to fit, it is left
+	// as it is.
+	TableFit bool
+
+	// TableMinWidths is the narrowest that the content of each column of
+	// created tables is made when TableFit is set, in order from the leftmost
+	// column. Columns that do not have an entry, or whose entry is less than
+	// 2, can be narrowed to 2 characters wide.
+	TableMinWidths Widths
+
+	// TableNoWrapColumns are the indexes of the columns of created tables
+	// that are never narrowed or wrapped when TableFit is set.
+	TableNoWrapColumns ColumnIndexes
+
+	// TableColumnWidths is the width constraint on each column of created
+	// tables, in order from the leftmost column. Columns that do not have an
+	// entry have no constraint. See [ColumnWidth] for how each constraint is
+	// met, and [CheckColumnWidths] to find out whether they can all be met.
+	//
+	// When any column has a constraint, the width left over in a table is
+	// only given to the columns that can take it, so the table can be
+	// narrower than the width it is made for.
+	TableColumnWidths ColumnWidths
+
+	// TableValueFormatter gives the text that each value is shown as in
+	// tables created by [Editor.InsertStructTable]. If it or the function it
+	// points to is nil, a value that implements fmt.Stringer or error is
+	// shown as the string that it gives, a nil pointer or interface is shown
+	// as the empty string, and any other value is shown as formatted by
+	// fmt.Sprint. It is a pointer so that Options can be compared with ==;
+	// [Options.WithTableValueFormatter] sets it from a ValueFormatter.
+	TableValueFormatter *ValueFormatter
+
+	// TableExpand sets when created tables are drawn in expanded mode, like
+	// the expanded display of psql. In expanded mode, each row other than the
+	// header is drawn as a record that starts with a line giving its number,
+	// followed by a line for each column with the header of the column and
+	// the content of the row's cell in it. Columns are labeled with their
+	// numbers if the table has no header, and content that does not fit in
+	// the width of the table is wrapped, as by [Editor.Wrap]. Cells do not
+	// span more than one column or row, and rules and the footer are not
+	// drawn; the footer is drawn as a record like the other rows.
+	//
+	// It defaults to ExpandOff, which never expands tables. ExpandAuto only
+	// expands a table that is wider than the width it is made for, which is
+	// checked after it is narrowed by TableFit. Tables are only expanded when
+	// they are created as text, so TableExpand is ignored if TableFormat is
+	// anything other than TableFormatText or if TableRenderer is set.
+	TableExpand ExpandMode
+
+	// TableFormat is the format that tables are created in. It defaults to
+	// TableFormatText, which draws tables as fixed-width text. The other
+	// formats create tables for documentation, such as Markdown, HTML,
+	// reStructuredText, or LaTeX tables, with the same layout.
+	TableFormat TableFormat
+
+	// TableRenderer draws created tables in place of TableFormat. Tables are
+	// laid out as they are for TableFormat, and each layout is then given to
+	// TableRenderer to draw. If it is nil, tables are drawn by TableFormat.
+	TableRenderer TableRenderer
 }
 
 // String gets the string representation of the Options.
 func (opts Options) String() string {
+	var vf ValueFormatter
+	if opts.TableValueFormatter != nil {
+		vf = *opts.TableValueFormatter
+	}
+
 	fmtStr := "Options{ParagraphSeparator: %q,"
 	fmtStr += " LineSeparator: %q,"
 	fmtStr += " IndentStr: %q,"
 	fmtStr += " NoTrailingLineSeparators: %v,"
 	fmtStr += " PreserveParagraphs: %v,"
 	fmtStr += " JustifyLastLine: %v,"
+	fmtStr += " DisplayWidth: %v,"
+	fmtStr += " ANSIEscapes: %v,"
+	fmtStr += " LineBreaking: %v,"
+	fmtStr += " WrapAlgorithm: %v,"
+	fmtStr += " Hyphenate: %v,"
+	fmtStr += " Hyphenator: %v,"
+	fmtStr += " PreserveWhitespace: %v,"
+	fmtStr += " RepeatIndent: %v,"
+	fmtStr += " LinePrefix: %q,"
+	fmtStr += " RecognizeLists: %v,"
+	fmtStr += " Ellipsis: %q,"
+	fmtStr += " Normalization: %v,"
 	fmtStr += " TableBorders: %v,"
 	fmtStr += " TableHeaders: %v,"
-	fmtStr += " TableCharSet: %q}"
+	fmtStr += " TableCharSet: %q,"
+	fmtStr += " TableStyle: %v,"
+	fmtStr += " TableAlignments: %v,"
+	fmtStr += " TableHeaderCase: %v,"
+	fmtStr += " TableFit: %v,"
+	fmtStr += " TableMinWidths: %v,"
+	fmtStr += " TableNoWrapColumns: %v,"
+	fmtStr += " TableColumnWidths: %v,"
+	fmtStr += " TableValueFormatter: %v,"
+	fmtStr += " TableExpand: %v,"
+	fmtStr += " TableFormat: %v,"
+	fmtStr += " TableRenderer: %v}"
 	return fmt.Sprintf(
 		fmtStr, opts.ParagraphSeparator, opts.LineSeparator, opts.IndentStr,
 		opts.NoTrailingLineSeparators, opts.PreserveParagraphs,
-		opts.JustifyLastLine, opts.TableBorders, opts.TableHeaders,
-		opts.TableCharSet,
+		opts.JustifyLastLine, opts.DisplayWidth, opts.ANSIEscapes,
+		opts.LineBreaking, opts.WrapAlgorithm, opts.Hyphenate, opts.Hyphenator,
+		opts.PreserveWhitespace, opts.RepeatIndent, opts.LinePrefix,
+		opts.RecognizeLists, opts.Ellipsis, opts.Normalization,
+		opts.TableBorders, opts.TableHeaders, opts.TableCharSet,
+		opts.TableStyle, opts.TableAlignments, opts.TableHeaderCase, opts.TableFit,
+		opts.TableMinWidths, opts.TableNoWrapColumns, opts.TableColumnWidths,
+		vf, opts.TableExpand, opts.TableFormat, opts.TableRenderer,
 	)
 }
 
+// WithANSIEscapes returns a new Options identical to this one but with
+// ANSIEscapes set to ansiEscapes.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithANSIEscapes(ansiEscapes bool) Options {
+	opts.ANSIEscapes = ansiEscapes
+	return opts
+}
+
 // WithDefaults returns a copy of the options with all blank members filled with
 // their defaults. Internally, this function is used on user-provided Options
 // structs in order to get ready-to-use copies.
@@ -136,6 +389,9 @@ func (opts Options) WithDefaults() Options {
 	if opts.ParagraphSeparator == "" {
 		opts.ParagraphSeparator = DefaultParagraphSeparator
 	}
+	if opts.Ellipsis == "" {
+		opts.Ellipsis = DefaultEllipsis
+	}
 
 	gemTableCharSet := gem.New(opts.TableCharSet)
 	gemDefaultTableCharSet := gem.New(DefaultTableCharSet)
@@ -160,6 +416,42 @@ func (opts Options) WithDefaults() Options {
 	return opts
 }
 
+// WithDisplayWidth returns a new Options identical to this one but with
+// DisplayWidth set to displayWidth.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithDisplayWidth(displayWidth bool) Options {
+	opts.DisplayWidth = displayWidth
+	return opts
+}
+
+// WithEllipsis returns a new Options identical to this one but with Ellipsis
+// set to ellipsis.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithEllipsis(ellipsis string) Options {
+	opts.Ellipsis = ellipsis
+	return opts
+}
+
+// WithHyphenate returns a new Options identical to this one but with Hyphenate
+// set to hyphenate.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithHyphenate(hyphenate bool) Options {
+	opts.Hyphenate = hyphenate
+	return opts
+}
+
+// WithHyphenator returns a new Options identical to this one but with
+// Hyphenator set to h.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithHyphenator(h Hyphenator) Options {
+	opts.Hyphenator = h
+	return opts
+}
+
 // WithIndentStr returns a new Options identical to this one but with IndentStr
 // set to str. If str is the empty string, the indent str is interpreted as
 // [DefaultIndentString].
@@ -179,6 +471,24 @@ func (opts Options) WithJustifyLastLine(justifyLastLine bool) Options {
 	return opts
 }
 
+// WithLineBreaking returns a new Options identical to this one but with
+// LineBreaking set to mode.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithLineBreaking(mode LineBreakMode) Options {
+	opts.LineBreaking = mode
+	return opts
+}
+
+// WithLinePrefix returns a new Options identical to this one but with
+// LinePrefix set to prefix.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithLinePrefix(prefix string) Options {
+	opts.LinePrefix = prefix
+	return opts
+}
+
 // WithLineSeparator returns a new Options identical to this one but with the
 // LineSeparator member set to sep. If sep is the empty string, the line
 // separator is interpreted as [DefaultLineSeparator].
@@ -198,6 +508,15 @@ func (opts Options) WithNoTrailingLineSeparators(noTrailingLineSeps bool) Option
 	return opts
 }
 
+// WithNormalization returns a new Options identical to this one but with
+// Normalization set to form.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithNormalization(form NormalizationForm) Options {
+	opts.Normalization = form
+	return opts
+}
+
 // WithParagraphSeparator returns a new Options identical to this one but with
 // ParagraphSeparator set to sep. If sep is the empty string, the paragraph
 // separator is interpreted as [DefaultParagraphSeparator].
@@ -217,6 +536,33 @@ func (opts Options) WithPreserveParagraphs(preserve bool) Options {
 	return opts
 }
 
+// WithPreserveWhitespace returns a new Options identical to this one but with
+// PreserveWhitespace set to preserve.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithPreserveWhitespace(preserve bool) Options {
+	opts.PreserveWhitespace = preserve
+	return opts
+}
+
+// WithRecognizeLists returns a new Options identical to this one but with
+// RecognizeLists set to recognize.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithRecognizeLists(recognize bool) Options {
+	opts.RecognizeLists = recognize
+	return opts
+}
+
+// WithRepeatIndent returns a new Options identical to this one but with
+// RepeatIndent set to repeat.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithRepeatIndent(repeat bool) Options {
+	opts.RepeatIndent = repeat
+	return opts
+}
+
 // WithTableBorders returns a new Options identical to this one but with
 // TableBorders set to borders.
 //
@@ -243,3 +589,190 @@ func (opts Options) WithTableCharSet(charSet string) Options {
 	opts.TableCharSet = charSet
 	return opts
 }
+
+// WithTableAlignments returns a new Options identical to this one but with
+// TableAlignments set to the given alignments, in order from the leftmost
+// column.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithTableAlignments(aligns ...Alignment) Options {
+	opts.TableAlignments = NewAlignments(aligns...)
+	return opts
+}
+
+// WithTableHeaderCase returns a new Options identical to this one but with
+// TableHeaderCase set to hc.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithTableHeaderCase(hc HeaderCase) Options {
+	opts.TableHeaderCase = hc
+	return opts
+}
+
+// WithTableStyle returns a new Options identical to this one but with
+// TableStyle set to style.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithTableStyle(style TableStyle) Options {
+	opts.TableStyle = style
+	return opts
+}
+
+// WithTableFit returns a new Options identical to this one but with TableFit
+// set to fit.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithTableFit(fit bool) Options {
+	opts.TableFit = fit
+	return opts
+}
+
+// WithTableMinWidths returns a new Options identical to this one but with
+// TableMinWidths set to the given widths, in order from the leftmost column.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithTableMinWidths(widths ...int) Options {
+	opts.TableMinWidths = NewWidths(widths...)
+	return opts
+}
+
+// WithTableNoWrapColumns returns a new Options identical to this one but with
+// TableNoWrapColumns set to the given column indexes.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithTableNoWrapColumns(cols ...int) Options {
+	opts.TableNoWrapColumns = NewColumnIndexes(cols...)
+	return opts
+}
+
+// WithTableColumnWidths returns a new Options identical to this one but with
+// TableColumnWidths set to the given constraints, in order from the leftmost
+// column.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithTableColumnWidths(widths ...ColumnWidth) Options {
+	opts.TableColumnWidths = NewColumnWidths(widths...)
+	return opts
+}
+
+// WithTableValueFormatter returns a new Options identical to this one but with
+// TableValueFormatter set to point to vf, or to nil if vf is nil. Each call
+// makes a new pointer, so Options given the same function by two calls are not
+// equal with ==.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithTableValueFormatter(vf ValueFormatter) Options {
+	opts.TableValueFormatter = nil
+	if vf != nil {
+		opts.TableValueFormatter = &vf
+	}
+	return opts
+}
+
+// WithTableExpand returns a new Options identical to this one but with
+// TableExpand set to mode.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithTableExpand(mode ExpandMode) Options {
+	opts.TableExpand = mode
+	return opts
+}
+
+// WithTableFormat returns a new Options identical to this one but with
+// TableFormat set to format.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithTableFormat(format TableFormat) Options {
+	opts.TableFormat = format
+	return opts
+}
+
+// WithTableRenderer returns a new Options identical to this one but with
+// TableRenderer set to r.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithTableRenderer(r TableRenderer) Options {
+	opts.TableRenderer = r
+	return opts
+}
+
+// WithWrapAlgorithm returns a new Options identical to this one but with
+// WrapAlgorithm set to alg.
+//
+// This function does not modify the Options it is called on.
+func (opts Options) WithWrapAlgorithm(alg WrapAlgorithm) Options {
+	opts.WrapAlgorithm = alg
+	return opts
+}
+
+// layout returns the options that control how manip functions measure and
+// break text.
+func (opts Options) layout() manip.Options {
+	layoutOpts := manip.Options{
+		DisplayWidth:       opts.DisplayWidth,
+		ANSIEscapes:        opts.ANSIEscapes,
+		UnicodeLineBreaks:  opts.LineBreaking == LineBreakUAX14,
+		WrapAlgorithm:      opts.WrapAlgorithm.layout(),
+		PreserveWhitespace: opts.PreserveWhitespace,
+		RepeatIndent:       opts.RepeatIndent,
+		RecognizeLists:     opts.RecognizeLists,
+		TableHeaderCase:    opts.TableHeaderCase.layout(opts.TableFormat),
+		TableFit:           opts.TableFit,
+		TableMinWidths:     opts.TableMinWidths.Slice(),
+		TableNoWrapColumns: opts.TableNoWrapColumns.Slice(),
+		TableExpand:        opts.TableExpand.layout(),
+		TableRenderer:      opts.tableRenderer(),
+	}
+	if aligns := opts.TableAlignments.Slice(); len(aligns) > 0 {
+		layoutOpts.TableAlignments = make([]manip.ColumnAlignment, len(aligns))
+		for i := range aligns {
+			layoutOpts.TableAlignments[i] = aligns[i].column()
+		}
+	}
+	if widths := opts.TableColumnWidths.Slice(); len(widths) > 0 {
+		layoutOpts.TableColumnWidths = make([]manip.ColumnWidth, len(widths))
+		for i := range widths {
+			layoutOpts.TableColumnWidths[i] = widths[i].layout()
+		}
+	}
+	if opts.Hyphenate {
+		layoutOpts.Hyphenator = opts.Hyphenator.patterns()
+	}
+	return layoutOpts
+}
+
+// tableRenderer returns the manip TableRenderer that tables are drawn with,
+// which is given by TableRenderer if it is set and by TableFormat otherwise.
+func (opts Options) tableRenderer() manip.TableRenderer {
+	if opts.TableRenderer == nil {
+		return opts.TableFormat.renderer()
+	}
+	return customRenderer{opts: opts}
+}
+
+// tableStyle returns the manip TableStyle that tables are drawn with, which is
+// given by TableStyle if it is set and by TableCharSet otherwise. The opts must
+// already have had WithDefaults called on them.
+func (opts Options) tableStyle() manip.TableStyle {
+	if opts.TableStyle == (TableStyle{}) {
+		return manip.StyleFromCharSet(gem.New(opts.TableCharSet))
+	}
+	return opts.TableStyle.layout()
+}
+
+// tableCells returns the cells of data as they are given to manip functions,
+// with the text of each put in the Normalization form of opts.
+func (opts Options) tableCells(data [][]Cell) [][]manip.TableCell {
+	cells := make([][]manip.TableCell, len(data))
+	for row := range data {
+		cells[row] = make([]manip.TableCell, len(data[row]))
+		for col := range data[row] {
+			cells[row][col] = manip.TableCell{
+				Text:    gem.New(opts.Normalization.normalize(data[row][col].Text)),
+				ColSpan: data[row][col].ColSpan,
+				RowSpan: data[row][col].RowSpan,
+			}
+		}
+	}
+	return cells
+}
@@ -1,6 +1,8 @@
 package rosed
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -62,6 +64,126 @@ func Test_Options_WithLineSeparator(t *testing.T) {
 	}
 }
 
+func Test_Options_WithANSIEscapes(t *testing.T) {
+	testCases := []struct {
+		name           string
+		input          Options
+		newANSIEscapes bool
+		expected       Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				ANSIEscapes:              false,
+			},
+			newANSIEscapes: true,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				ANSIEscapes:              true,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				ANSIEscapes:              false,
+			},
+			newANSIEscapes: true,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				ANSIEscapes:              true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithANSIEscapes(tc.newANSIEscapes)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithDisplayWidth(t *testing.T) {
+	testCases := []struct {
+		name            string
+		input           Options
+		newDisplayWidth bool
+		expected        Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				DisplayWidth:             false,
+			},
+			newDisplayWidth: true,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				DisplayWidth:             true,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				DisplayWidth:             false,
+			},
+			newDisplayWidth: true,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				DisplayWidth:             true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithDisplayWidth(tc.newDisplayWidth)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
 func Test_Options_WithIndentStr(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -178,6 +300,186 @@ func Test_Options_WithJustifyLastLine(t *testing.T) {
 	}
 }
 
+func Test_Options_WithNormalization(t *testing.T) {
+	testCases := []struct {
+		name             string
+		input            Options
+		newNormalization NormalizationForm
+		expected         Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Normalization:            NoNormalization,
+			},
+			newNormalization: NFC,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Normalization:            NFC,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Normalization:            NoNormalization,
+			},
+			newNormalization: NFKD,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Normalization:            NFKD,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithNormalization(tc.newNormalization)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithLineBreaking(t *testing.T) {
+	testCases := []struct {
+		name            string
+		input           Options
+		newLineBreaking LineBreakMode
+		expected        Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				LineBreaking:             LineBreakSpaces,
+			},
+			newLineBreaking: LineBreakUAX14,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				LineBreaking:             LineBreakUAX14,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				LineBreaking:             LineBreakSpaces,
+			},
+			newLineBreaking: LineBreakUAX14,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				LineBreaking:             LineBreakUAX14,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithLineBreaking(tc.newLineBreaking)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithLinePrefix(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Options
+		prefix   string
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				LinePrefix:               "",
+			},
+			prefix: "// ",
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				LinePrefix:               "// ",
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				LinePrefix:               "# ",
+			},
+			prefix: "> ",
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				LinePrefix:               "> ",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithLinePrefix(tc.prefix)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
 func Test_Options_WithParagraphSeparator(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -364,6 +666,7 @@ func Test_Options_WithDefaults(t *testing.T) {
 				TableBorders:             true,
 				TableHeaders:             true,
 				TableCharSet:             "#!=",
+				Ellipsis:                 "...",
 			},
 			expected: Options{
 				ParagraphSeparator:       "\n\n--\n\n",
@@ -375,6 +678,7 @@ func Test_Options_WithDefaults(t *testing.T) {
 				TableBorders:             true,
 				TableHeaders:             true,
 				TableCharSet:             "#!=",
+				Ellipsis:                 "...",
 			},
 		},
 		{
@@ -399,6 +703,7 @@ func Test_Options_WithDefaults(t *testing.T) {
 				TableBorders:             true,
 				TableHeaders:             true,
 				TableCharSet:             " |-",
+				Ellipsis:                 DefaultEllipsis,
 			},
 		},
 		{
@@ -423,6 +728,7 @@ func Test_Options_WithDefaults(t *testing.T) {
 				TableBorders:             true,
 				TableHeaders:             true,
 				TableCharSet:             "XY-",
+				Ellipsis:                 DefaultEllipsis,
 			},
 		},
 		{
@@ -438,6 +744,7 @@ func Test_Options_WithDefaults(t *testing.T) {
 				TableBorders:             false,
 				TableHeaders:             false,
 				TableCharSet:             DefaultTableCharSet,
+				Ellipsis:                 DefaultEllipsis,
 			},
 		},
 		{
@@ -453,6 +760,7 @@ func Test_Options_WithDefaults(t *testing.T) {
 				PreserveParagraphs:       false,
 				JustifyLastLine:          true,
 				TableCharSet:             DefaultTableCharSet,
+				Ellipsis:                 DefaultEllipsis,
 			},
 		},
 		{
@@ -468,6 +776,7 @@ func Test_Options_WithDefaults(t *testing.T) {
 				PreserveParagraphs:       false,
 				TableBorders:             true,
 				TableCharSet:             DefaultTableCharSet,
+				Ellipsis:                 DefaultEllipsis,
 			},
 		},
 	}
@@ -477,28 +786,86 @@ func Test_Options_WithDefaults(t *testing.T) {
 
 			actual := tc.input.WithDefaults()
 
-			if actual != tc.expected {
-				t.Fatalf("expected %v but was %v", tc.expected, actual)
-			}
+			assert.Equal(t, tc.expected, actual)
 		})
 	}
 }
 
-func Test_Options_WithTableBorders(t *testing.T) {
+func Test_Options_comparable(t *testing.T) {
 	testCases := []struct {
-		name       string
-		input      Options
-		newBorders bool
-		expected   Options
+		name   string
+		a      Options
+		b      Options
+		expect bool
 	}{
 		{
-			name: "from defaults",
-			input: Options{
-				ParagraphSeparator:       DefaultParagraphSeparator,
-				LineSeparator:            DefaultLineSeparator,
-				IndentStr:                DefaultIndentString,
-				NoTrailingLineSeparators: false,
-				PreserveParagraphs:       false,
+			name:   "zero values",
+			a:      Options{},
+			b:      Options{},
+			expect: true,
+		},
+		{
+			name:   "same column options",
+			a:      Options{}.WithTableAlignments(Left, Right).WithTableMinWidths(4).WithTableNoWrapColumns(1).WithTableColumnWidths(ColumnWidth{Fixed: 3}),
+			b:      Options{}.WithTableAlignments(Left, Right).WithTableMinWidths(4).WithTableNoWrapColumns(1).WithTableColumnWidths(ColumnWidth{Fixed: 3}),
+			expect: true,
+		},
+		{
+			name:   "different alignments",
+			a:      Options{}.WithTableAlignments(Left, Right),
+			b:      Options{}.WithTableAlignments(Left, Center),
+			expect: false,
+		},
+		{
+			name:   "same table renderer",
+			a:      Options{}.WithTableRenderer(rowsRenderer{}),
+			b:      Options{}.WithTableRenderer(rowsRenderer{}),
+			expect: true,
+		},
+		{
+			name:   "different table renderers",
+			a:      Options{}.WithTableRenderer(rowsRenderer{}),
+			b:      Options{}.WithTableRenderer(TableFormatHTML),
+			expect: false,
+		},
+		{
+			name:   "empty column options are the zero value",
+			a:      Options{}.WithTableAlignments().WithTableMinWidths().WithTableNoWrapColumns().WithTableColumnWidths(),
+			b:      Options{},
+			expect: true,
+		},
+		{
+			name:   "different column widths",
+			a:      Options{}.WithTableColumnWidths(ColumnWidth{Fixed: 3}, ColumnWidth{}),
+			b:      Options{}.WithTableColumnWidths(ColumnWidth{Fixed: 3}),
+			expect: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(tc.expect, tc.a == tc.b)
+		})
+	}
+}
+
+func Test_Options_WithTableBorders(t *testing.T) {
+	testCases := []struct {
+		name       string
+		input      Options
+		newBorders bool
+		expected   Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
 				JustifyLastLine:          false,
 				TableBorders:             false,
 				TableHeaders:             false,
@@ -699,3 +1066,1121 @@ func Test_Options_WithTableCharSet(t *testing.T) {
 		})
 	}
 }
+
+func Test_Options_WithTableAlignments(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Options
+		aligns   []Alignment
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableAlignments:          Alignments{},
+			},
+			aligns: []Alignment{Left, Right, Decimal},
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableAlignments:          NewAlignments(Left, Right, Decimal),
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableAlignments:          Alignments{},
+			},
+			aligns: []Alignment{Center},
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableAlignments:          NewAlignments(Center),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithTableAlignments(tc.aligns...)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithTableHeaderCase(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Options
+		hc       HeaderCase
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableHeaderCase:          HeaderUpper,
+			},
+			hc: HeaderTitle,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableHeaderCase:          HeaderTitle,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableHeaderCase:          HeaderUpper,
+			},
+			hc: HeaderAsIs,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableHeaderCase:          HeaderAsIs,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithTableHeaderCase(tc.hc)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithTableStyle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Options
+		style    TableStyle
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableStyle:               TableStyle{},
+			},
+			style: TableStyleLight,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableStyle:               TableStyleLight,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableStyle:               TableStyleASCII,
+			},
+			style: TableStyleMarkdown,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableStyle:               TableStyleMarkdown,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithTableStyle(tc.style)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithTableFit(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Options
+		fit      bool
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableFit:                 false,
+			},
+			fit: true,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableFit:                 true,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableFit:                 false,
+			},
+			fit: true,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableFit:                 true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithTableFit(tc.fit)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithTableMinWidths(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Options
+		widths   []int
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableMinWidths:           Widths{},
+			},
+			widths: []int{10, 0, 4},
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableMinWidths:           NewWidths(10, 0, 4),
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableMinWidths:           Widths{},
+			},
+			widths: []int{3},
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableMinWidths:           NewWidths(3),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithTableMinWidths(tc.widths...)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithTableNoWrapColumns(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Options
+		cols     []int
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableNoWrapColumns:       ColumnIndexes{},
+			},
+			cols: []int{0, 2},
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableNoWrapColumns:       NewColumnIndexes(0, 2),
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableNoWrapColumns:       ColumnIndexes{},
+			},
+			cols: []int{1},
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableNoWrapColumns:       NewColumnIndexes(1),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithTableNoWrapColumns(tc.cols...)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithTableColumnWidths(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Options
+		widths   []ColumnWidth
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableColumnWidths:        ColumnWidths{},
+			},
+			widths: []ColumnWidth{{Fixed: 12}, {Percent: 40}},
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableColumnWidths:        NewColumnWidths(ColumnWidth{Fixed: 12}, ColumnWidth{Percent: 40}),
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableColumnWidths:        ColumnWidths{},
+			},
+			widths: []ColumnWidth{{Max: 20}},
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableColumnWidths:        NewColumnWidths(ColumnWidth{Max: 20}),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithTableColumnWidths(tc.widths...)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithTableValueFormatter(t *testing.T) {
+	var upper ValueFormatter = func(header string, value interface{}) string {
+		return strings.ToUpper(fmt.Sprint(value))
+	}
+
+	testCases := []struct {
+		name      string
+		input     Options
+		vf        ValueFormatter
+		expectNil bool
+	}{
+		{
+			name: "set from nil",
+			input: Options{
+				ParagraphSeparator:  DefaultParagraphSeparator,
+				LineSeparator:       DefaultLineSeparator,
+				IndentStr:           DefaultIndentString,
+				TableValueFormatter: nil,
+			},
+			vf:        upper,
+			expectNil: false,
+		},
+		{
+			name: "clear",
+			input: Options{
+				ParagraphSeparator:  DefaultParagraphSeparator,
+				LineSeparator:       DefaultLineSeparator,
+				IndentStr:           DefaultIndentString,
+				TableValueFormatter: &upper,
+			},
+			vf:        nil,
+			expectNil: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithTableValueFormatter(tc.vf)
+
+			assert.Equal(tc.input.ParagraphSeparator, actual.ParagraphSeparator)
+			assert.Equal(tc.input.LineSeparator, actual.LineSeparator)
+			assert.Equal(tc.input.IndentStr, actual.IndentStr)
+			if tc.expectNil {
+				assert.Nil(actual.TableValueFormatter)
+			} else if assert.NotNil(actual.TableValueFormatter) {
+				assert.Equal("ABC", (*actual.TableValueFormatter)("h", "abc"))
+			}
+		})
+	}
+}
+
+func Test_Options_WithTableExpand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Options
+		mode     ExpandMode
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableExpand:              ExpandOff,
+			},
+			mode: ExpandAuto,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableExpand:              ExpandAuto,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableExpand:              ExpandOff,
+			},
+			mode: ExpandOn,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableExpand:              ExpandOn,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithTableExpand(tc.mode)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithTableFormat(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Options
+		format   TableFormat
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableFormat:              TableFormatText,
+			},
+			format: TableFormatHTML,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableFormat:              TableFormatHTML,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableFormat:              TableFormatText,
+			},
+			format: TableFormatLaTeX,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableFormat:              TableFormatLaTeX,
+			},
+		},
+		{
+			name: "from another format",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableFormat:              TableFormatHTML,
+			},
+			format: TableFormatMarkdown,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableFormat:              TableFormatMarkdown,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithTableFormat(tc.format)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithTableRenderer(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Options
+		renderer TableRenderer
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableRenderer:            nil,
+			},
+			renderer: rowsRenderer{},
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableRenderer:            rowsRenderer{},
+			},
+		},
+		{
+			name: "to a table format",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableRenderer:            rowsRenderer{},
+			},
+			renderer: TableFormatHTML,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableRenderer:            TableFormatHTML,
+			},
+		},
+		{
+			name: "to nil",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableRenderer:            rowsRenderer{},
+			},
+			renderer: nil,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				TableRenderer:            nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithTableRenderer(tc.renderer)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithWrapAlgorithm(t *testing.T) {
+	testCases := []struct {
+		name             string
+		input            Options
+		newWrapAlgorithm WrapAlgorithm
+		expected         Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				WrapAlgorithm:            WrapGreedy,
+			},
+			newWrapAlgorithm: WrapKnuthPlass,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				WrapAlgorithm:            WrapKnuthPlass,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				WrapAlgorithm:            WrapGreedy,
+			},
+			newWrapAlgorithm: WrapKnuthPlass,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				WrapAlgorithm:            WrapKnuthPlass,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithWrapAlgorithm(tc.newWrapAlgorithm)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithEllipsis(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Options
+		ellipsis string
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Ellipsis:                 DefaultEllipsis,
+			},
+			ellipsis: "...",
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Ellipsis:                 "...",
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Ellipsis:                 "",
+			},
+			ellipsis: "...",
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Ellipsis:                 "...",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithEllipsis(tc.ellipsis)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithHyphenate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     Options
+		hyphenate bool
+		expected  Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Hyphenate:                false,
+			},
+			hyphenate: true,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Hyphenate:                true,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Hyphenate:                false,
+			},
+			hyphenate: true,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Hyphenate:                true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithHyphenate(tc.hyphenate)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithHyphenator(t *testing.T) {
+	h, err := NewHyphenator("o1s", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		input    Options
+		h        Hyphenator
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Hyphenator:               Hyphenator{},
+			},
+			h: h,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Hyphenator:               h,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Hyphenator:               Hyphenator{},
+			},
+			h: h,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				Hyphenator:               h,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithHyphenator(tc.h)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithPreserveWhitespace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Options
+		preserve bool
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				PreserveWhitespace:       false,
+			},
+			preserve: true,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				PreserveWhitespace:       true,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				PreserveWhitespace:       false,
+			},
+			preserve: true,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				PreserveWhitespace:       true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithPreserveWhitespace(tc.preserve)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithRecognizeLists(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     Options
+		recognize bool
+		expected  Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				RecognizeLists:           false,
+			},
+			recognize: true,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				RecognizeLists:           true,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				RecognizeLists:           true,
+			},
+			recognize: false,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				RecognizeLists:           false,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithRecognizeLists(tc.recognize)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func Test_Options_WithRepeatIndent(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    Options
+		repeat   bool
+		expected Options
+	}{
+		{
+			name: "from defaults",
+			input: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				RepeatIndent:             false,
+			},
+			repeat: true,
+			expected: Options{
+				ParagraphSeparator:       DefaultParagraphSeparator,
+				LineSeparator:            DefaultLineSeparator,
+				IndentStr:                DefaultIndentString,
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				RepeatIndent:             true,
+			},
+		},
+		{
+			name: "from empty",
+			input: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				RepeatIndent:             false,
+			},
+			repeat: true,
+			expected: Options{
+				ParagraphSeparator:       "",
+				LineSeparator:            "",
+				IndentStr:                "",
+				NoTrailingLineSeparators: false,
+				PreserveParagraphs:       false,
+				RepeatIndent:             true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.WithRepeatIndent(tc.repeat)
+
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
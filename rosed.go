@@ -1,9 +1,17 @@
 package rosed
 
 import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
 	"strings"
 
 	"github.com/dekarrin/rosed/internal/gem"
+	"github.com/dekarrin/rosed/internal/hyph"
+	"github.com/dekarrin/rosed/internal/manip"
+	"github.com/dekarrin/rosed/internal/tb"
 )
 
 // End is a constant that if passed to a position argument, represents a
@@ -12,7 +20,7 @@ import (
 const End = -int(^uint(0)>>1) - 1
 
 // Alignment is the type of alignment to apply to text. It is used in the
-// [Editor.Align] function.
+// [Editor.Align] function and in the TableAlignments member of [Options].
 type Alignment int
 
 const (
@@ -27,8 +35,1278 @@ const (
 
 	// Center is alignment to the center of the text.
 	Center
+
+	// Decimal is alignment of numbers such that their decimal points line up,
+	// with the numbers as a whole aligned to the right. A number without a
+	// decimal point is treated as though it had one at its end. It is only
+	// used for the columns of tables; [Editor.Align] does not apply it.
+	Decimal
+)
+
+// String gets the name of the Alignment.
+func (align Alignment) String() string {
+	switch align {
+	case None:
+		return "None"
+	case Left:
+		return "Left"
+	case Right:
+		return "Right"
+	case Center:
+		return "Center"
+	case Decimal:
+		return "Decimal"
+	default:
+		return fmt.Sprintf("Alignment(%d)", int(align))
+	}
+}
+
+// column returns the manip ColumnAlignment that implements align. If align is
+// None or is not a valid Alignment, content is aligned to the left.
+func (align Alignment) column() manip.ColumnAlignment {
+	switch align {
+	case Right:
+		return manip.ColumnRight
+	case Center:
+		return manip.ColumnCenter
+	case Decimal:
+		return manip.ColumnDecimal
+	default:
+		return manip.ColumnLeft
+	}
+}
+
+// Alignments is the alignment of each column of a table, in order from the
+// leftmost column. It is used in the TableAlignments member of [Options].
+//
+// An Alignments cannot be changed once it is made, and two Alignments are
+// equal with == if they hold the same alignments in the same order. The zero
+// value holds no alignments.
+type Alignments struct {
+	list intList
+}
+
+// NewAlignments creates an Alignments that holds the given alignments, in
+// order from the leftmost column.
+func NewAlignments(aligns ...Alignment) Alignments {
+	vals := make([]int, len(aligns))
+	for i := range aligns {
+		vals[i] = int(aligns[i])
+	}
+	return Alignments{list: newIntList(vals)}
+}
+
+// Len returns the number of alignments in the Alignments.
+func (a Alignments) Len() int {
+	return len(a.list.ints())
+}
+
+// Slice returns the alignments in the Alignments, in order from the leftmost
+// column. Changing the returned slice does not change the Alignments.
+func (a Alignments) Slice() []Alignment {
+	vals := a.list.ints()
+	aligns := make([]Alignment, len(vals))
+	for i := range vals {
+		aligns[i] = Alignment(vals[i])
+	}
+	return aligns
+}
+
+// String gets the string representation of the Alignments.
+func (a Alignments) String() string {
+	return fmt.Sprint(a.Slice())
+}
+
+// HeaderCase is the way that the letters in the headers of a table are cased.
+// It is used in the TableHeaderCase member of [Options].
+type HeaderCase int
+
+const (
+	// HeaderDefault cases headers in the way that is usual for the format
+	// that the table is created in. Headers of tables created with
+	// TableFormatMarkdown are left exactly as they are given, and those of
+	// tables in every other format are converted to upper case. It is the
+	// zero value of a HeaderCase.
+	HeaderDefault HeaderCase = iota
+
+	// HeaderUpper converts headers to upper case.
+	HeaderUpper
+
+	// HeaderAsIs leaves headers exactly as they are given.
+	HeaderAsIs
+
+	// HeaderLower converts headers to lower case.
+	HeaderLower
+
+	// HeaderTitle converts the first letter of each word in headers to upper
+	// case and leaves the rest as they are given.
+	HeaderTitle
+)
+
+// String gets the name of the HeaderCase.
+func (hc HeaderCase) String() string {
+	switch hc {
+	case HeaderDefault:
+		return "HeaderDefault"
+	case HeaderUpper:
+		return "HeaderUpper"
+	case HeaderAsIs:
+		return "HeaderAsIs"
+	case HeaderLower:
+		return "HeaderLower"
+	case HeaderTitle:
+		return "HeaderTitle"
+	default:
+		return fmt.Sprintf("HeaderCase(%d)", int(hc))
+	}
+}
+
+// layout returns the manip HeaderCase that implements hc for tables created in
+// format. If hc is not a valid HeaderCase, headers are converted to upper case.
+func (hc HeaderCase) layout(format TableFormat) manip.HeaderCase {
+	if hc == HeaderDefault && format == TableFormatMarkdown {
+		return manip.HeaderAsIs
+	}
+
+	switch hc {
+	case HeaderAsIs:
+		return manip.HeaderAsIs
+	case HeaderLower:
+		return manip.HeaderLower
+	case HeaderTitle:
+		return manip.HeaderTitle
+	default:
+		return manip.HeaderUpper
+	}
+}
+
+// ExpandMode is when a table is drawn in expanded mode, in which each of its
+// rows is drawn as a record of its own with a line for each column. It is used
+// in the TableExpand member of [Options].
+type ExpandMode int
+
+const (
+	// ExpandOff never draws tables in expanded mode. It is the zero value of
+	// an ExpandMode.
+	ExpandOff ExpandMode = iota
+
+	// ExpandOn always draws tables in expanded mode.
+	ExpandOn
+
+	// ExpandAuto draws a table in expanded mode only if it would otherwise be
+	// wider than the width it is made for.
+	ExpandAuto
+)
+
+// String gets the name of the ExpandMode.
+func (mode ExpandMode) String() string {
+	switch mode {
+	case ExpandOff:
+		return "ExpandOff"
+	case ExpandOn:
+		return "ExpandOn"
+	case ExpandAuto:
+		return "ExpandAuto"
+	default:
+		return fmt.Sprintf("ExpandMode(%d)", int(mode))
+	}
+}
+
+// layout returns the manip ExpandMode that implements mode. If mode is not a
+// valid ExpandMode, tables are never expanded.
+func (mode ExpandMode) layout() manip.ExpandMode {
+	switch mode {
+	case ExpandOn:
+		return manip.ExpandOn
+	case ExpandAuto:
+		return manip.ExpandAuto
+	default:
+		return manip.ExpandOff
+	}
+}
+
+// LineBreakMode is the way that text is broken into lines when it is wrapped. It
+// is used in the LineBreaking member of [Options].
+type LineBreakMode int
+
+const (
+	// LineBreakSpaces breaks lines only at space characters. It is the zero
+	// value of a LineBreakMode.
+	LineBreakSpaces LineBreakMode = iota
+
+	// LineBreakUAX14 breaks lines at the line break opportunities given by
+	// [UAX #14: Unicode Line Breaking Algorithm]. This allows breaks after
+	// hyphens and slashes and between most CJK ideographs in addition to
+	// breaks at spaces, and prevents breaks where they are not allowed, such as
+	// at a no-break space (U+00A0) or before closing punctuation.
+	//
+	// [UAX #14: Unicode Line Breaking Algorithm]: https://www.unicode.org/reports/tr14/
+	LineBreakUAX14
+)
+
+// String gets the name of the LineBreakMode.
+func (mode LineBreakMode) String() string {
+	switch mode {
+	case LineBreakSpaces:
+		return "LineBreakSpaces"
+	case LineBreakUAX14:
+		return "LineBreakUAX14"
+	default:
+		return fmt.Sprintf("LineBreakMode(%d)", int(mode))
+	}
+}
+
+// NormalizationForm is one of the Unicode normalization forms defined in
+// [UAX #15: Unicode Normalization Forms]. It is used in the [Editor.Normalize]
+// function and in the Normalization member of [Options].
+//
+// [UAX #15: Unicode Normalization Forms]: https://www.unicode.org/reports/tr15/
+type NormalizationForm int
+
+const (
+	// NoNormalization is no normalization and is the zero value of a
+	// NormalizationForm.
+	NoNormalization NormalizationForm = iota
+
+	// NFC is Normalization Form C, canonical decomposition followed by
+	// canonical composition. Characters are represented by their precomposed
+	// forms wherever possible.
+	NFC
+
+	// NFD is Normalization Form D, canonical decomposition. Characters are
+	// represented by a base character followed by combining marks wherever
+	// possible.
+	NFD
+
+	// NFKC is Normalization Form KC, compatibility decomposition followed by
+	// canonical composition. It is the same as NFC but additionally replaces
+	// compatibility characters such as ligatures and fullwidth forms with their
+	// plain equivalents.
+	NFKC
+
+	// NFKD is Normalization Form KD, compatibility decomposition. It is the
+	// same as NFD but additionally replaces compatibility characters such as
+	// ligatures and fullwidth forms with their plain equivalents.
+	NFKD
+)
+
+// String gets the name of the NormalizationForm.
+func (form NormalizationForm) String() string {
+	switch form {
+	case NoNormalization:
+		return "NoNormalization"
+	case NFC:
+		return "NFC"
+	case NFD:
+		return "NFD"
+	case NFKC:
+		return "NFKC"
+	case NFKD:
+		return "NFKD"
+	default:
+		return fmt.Sprintf("NormalizationForm(%d)", int(form))
+	}
+}
+
+// normalize converts text to the given normalization form. If form is
+// NoNormalization or is not a valid NormalizationForm, text is returned
+// unchanged.
+func (form NormalizationForm) normalize(text string) string {
+	switch form {
+	case NFC:
+		return string(gem.Compose(gem.Decompose([]rune(text), false)))
+	case NFD:
+		return string(gem.Decompose([]rune(text), false))
+	case NFKC:
+		return string(gem.Compose(gem.Decompose([]rune(text), true)))
+	case NFKD:
+		return string(gem.Decompose([]rune(text), true))
+	default:
+		return text
+	}
+}
+
+// TruncationMode is the part of text that is removed when it is shortened to
+// fit within a width. It is used in the [Editor.Truncate] and
+// [Editor.TruncateLines] functions.
+type TruncationMode int
+
+const (
+	// TruncateTail removes text from the end, keeping the start. It is the
+	// zero value of a TruncationMode.
+	TruncateTail TruncationMode = iota
+
+	// TruncateHead removes text from the start, keeping the end.
+	TruncateHead
+
+	// TruncateMiddle removes text from the middle, keeping both the start and
+	// the end. This is useful for text such as file paths, where both ends are
+	// often of interest.
+	TruncateMiddle
+)
+
+// String gets the name of the TruncationMode.
+func (mode TruncationMode) String() string {
+	switch mode {
+	case TruncateTail:
+		return "TruncateTail"
+	case TruncateHead:
+		return "TruncateHead"
+	case TruncateMiddle:
+		return "TruncateMiddle"
+	default:
+		return fmt.Sprintf("TruncationMode(%d)", int(mode))
+	}
+}
+
+// truncate shortens text to fit within width, removing the part of it given by
+// mode. If mode is not a valid TruncationMode, the end of text is removed. The
+// opts must already have had WithDefaults called on them.
+func (mode TruncationMode) truncate(text gem.String, width int, opts Options) gem.String {
+	if width < 0 {
+		width = 0
+	}
+	ellipsis := gem.New(opts.Ellipsis)
+
+	switch mode {
+	case TruncateHead:
+		return manip.TruncateHead(text, width, ellipsis, opts.layout())
+	case TruncateMiddle:
+		return manip.TruncateMiddle(text, width, ellipsis, opts.layout())
+	default:
+		return manip.TruncateTail(text, width, ellipsis, opts.layout())
+	}
+}
+
+// Cell is a cell of table data that can span more than one column or row of
+// the table. It is used in the [Editor.InsertTableCells] function.
+type Cell struct {
+	// Text is the content of the cell.
+	Text string
+
+	// ColSpan is the number of columns that the cell takes up. If it is less
+	// than 1, the cell takes up a single column.
+	ColSpan int
+
+	// RowSpan is the number of rows that the cell takes up. If it is less than
+	// 1, the cell takes up a single row.
+	RowSpan int
+}
+
+// ColumnWidth is a constraint on the width of one column of a table. It is used
+// in the TableColumnWidths member of [Options]. Every width is the width of the
+// content of the column, not counting the padding and lines around it. The
+// zero value places no constraint on the column.
+//
+// A column with a Fixed or Percent width is always that wide. Any other column
+// is as wide as its content, but no narrower than Min and no wider than Max,
+// and it takes a share of whatever width is left over in the table. Content
+// that is wider than its column is wrapped onto as many lines as it needs, as
+// by [Editor.Wrap].
+type ColumnWidth struct {
+	// Fixed is the exact width of the column. If it is 0, the column does not
+	// have a fixed width.
+	Fixed int
+
+	// Min is the least width of the column.
+	Min int
+
+	// Max is the greatest width of the column. If it is 0, the column has no
+	// maximum width.
+	Max int
+
+	// Percent is the percentage of the width of the table that the column
+	// takes up, not counting the space taken up by padding and borders. If it
+	// is 0, the column does not have a percentage width.
+	Percent int
+
+	// Weight is the share of the width left over in the table that the column
+	// is given relative to the other columns with a Weight. If any column has
+	// a Weight, the left over width is only given to columns with a Weight
+	// until they reach their Max.
+	Weight int
+}
+
+// String gets the string representation of the ColumnWidth.
+func (cw ColumnWidth) String() string {
+	return fmt.Sprintf("ColumnWidth{Fixed: %d, Min: %d, Max: %d, Percent: %d, Weight: %d}", cw.Fixed, cw.Min, cw.Max, cw.Percent, cw.Weight)
+}
+
+// layout returns the manip.ColumnWidth that cw represents.
+func (cw ColumnWidth) layout() manip.ColumnWidth {
+	return manip.ColumnWidth{
+		Fixed:   cw.Fixed,
+		Min:     cw.Min,
+		Max:     cw.Max,
+		Percent: cw.Percent,
+		Weight:  cw.Weight,
+	}
+}
+
+// ColumnWidths is the width constraint on each column of a table, in order
+// from the leftmost column. It is used in the TableColumnWidths member of
+// [Options].
+//
+// A ColumnWidths cannot be changed once it is made, and two ColumnWidths are
+// equal with == if they hold the same constraints in the same order. The zero
+// value holds no constraints.
+type ColumnWidths struct {
+	list intList
+}
+
+// NewColumnWidths creates a ColumnWidths that holds the given constraints, in
+// order from the leftmost column.
+func NewColumnWidths(widths ...ColumnWidth) ColumnWidths {
+	vals := make([]int, 0, len(widths)*5)
+	for _, cw := range widths {
+		vals = append(vals, cw.Fixed, cw.Min, cw.Max, cw.Percent, cw.Weight)
+	}
+	return ColumnWidths{list: newIntList(vals)}
+}
+
+// Len returns the number of constraints in the ColumnWidths.
+func (cws ColumnWidths) Len() int {
+	return len(cws.list.ints()) / 5
+}
+
+// Slice returns the constraints in the ColumnWidths, in order from the
+// leftmost column. Changing the returned slice does not change the
+// ColumnWidths.
+func (cws ColumnWidths) Slice() []ColumnWidth {
+	vals := cws.list.ints()
+	widths := make([]ColumnWidth, len(vals)/5)
+	for i := range widths {
+		v := vals[i*5 : i*5+5]
+		widths[i] = ColumnWidth{Fixed: v[0], Min: v[1], Max: v[2], Percent: v[3], Weight: v[4]}
+	}
+	return widths
+}
+
+// String gets the string representation of the ColumnWidths.
+func (cws ColumnWidths) String() string {
+	return fmt.Sprint(cws.Slice())
+}
+
+// Widths is a width for each column of a table, in order from the leftmost
+// column. It is used in the TableMinWidths member of [Options].
+//
+// A Widths cannot be changed once it is made, and two Widths are equal with ==
+// if they hold the same widths in the same order. The zero value holds no
+// widths.
+type Widths struct {
+	list intList
+}
+
+// NewWidths creates a Widths that holds the given widths, in order from the
+// leftmost column.
+func NewWidths(widths ...int) Widths {
+	return Widths{list: newIntList(widths)}
+}
+
+// Len returns the number of widths in the Widths.
+func (w Widths) Len() int {
+	return len(w.list.ints())
+}
+
+// Slice returns the widths in the Widths, in order from the leftmost column.
+// Changing the returned slice does not change the Widths.
+func (w Widths) Slice() []int {
+	return w.list.ints()
+}
+
+// String gets the string representation of the Widths.
+func (w Widths) String() string {
+	return fmt.Sprint(w.Slice())
+}
+
+// ColumnIndexes is a set of the indexes of columns of a table. It is used in
+// the TableNoWrapColumns member of [Options].
+//
+// A ColumnIndexes cannot be changed once it is made, and two ColumnIndexes are
+// equal with == if they hold the same indexes in the same order. The zero
+// value holds no indexes.
+type ColumnIndexes struct {
+	list intList
+}
+
+// NewColumnIndexes creates a ColumnIndexes that holds the given column
+// indexes.
+func NewColumnIndexes(cols ...int) ColumnIndexes {
+	return ColumnIndexes{list: newIntList(cols)}
+}
+
+// Len returns the number of indexes in the ColumnIndexes.
+func (ci ColumnIndexes) Len() int {
+	return len(ci.list.ints())
+}
+
+// Slice returns the indexes in the ColumnIndexes. Changing the returned slice
+// does not change the ColumnIndexes.
+func (ci ColumnIndexes) Slice() []int {
+	return ci.list.ints()
+}
+
+// String gets the string representation of the ColumnIndexes.
+func (ci ColumnIndexes) String() string {
+	return fmt.Sprint(ci.Slice())
+}
+
+// intList is a list of ints that is kept in a string so that the types that
+// hold one, and the Options that holds those, can be compared with ==.
+type intList string
+
+// newIntList returns the intList that holds vals.
+func newIntList(vals []int) intList {
+	strs := make([]string, len(vals))
+	for i := range vals {
+		strs[i] = strconv.Itoa(vals[i])
+	}
+	return intList(strings.Join(strs, ","))
+}
+
+// ints returns the ints that l holds.
+func (l intList) ints() []int {
+	if l == "" {
+		return []int{}
+	}
+	strs := strings.Split(string(l), ",")
+	vals := make([]int, len(strs))
+	for i := range strs {
+		vals[i], _ = strconv.Atoi(strs[i])
+	}
+	return vals
+}
+
+// TableStyle is the set of characters used to draw the borders of a table and
+// the rule that separates its header row from the rest of it. It is used in
+// the TableStyle member of [Options], and allows tables to be drawn with
+// box-drawing characters that need a different character for each corner and
+// junction.
+//
+// Each member is a single character; any characters past the first are
+// ignored. If every member of the top border is empty, the table is drawn
+// without one, and likewise for the bottom border. If every member of the
+// header rule is empty, the header rule is drawn with the members used for
+// rules between rows. Any other member that is empty is drawn as a space.
+type TableStyle struct {
+	// TopLeft, Top, TopJunction, and TopRight draw the border at the top of
+	// the table. Top is the horizontal line and TopJunction is where it meets
+	// the line between two columns.
+	TopLeft     string
+	Top         string
+	TopJunction string
+	TopRight    string
+
+	// Vertical draws the borders at the sides of the table and the lines
+	// between its columns.
+	Vertical string
+
+	// LeftJunction, Horizontal, Cross, and RightJunction draw the rules that
+	// go across the table between its rows. Cross is where a rule crosses the
+	// line between two columns.
+	LeftJunction  string
+	Horizontal    string
+	Cross         string
+	RightJunction string
+
+	// HeaderLeft, HeaderHorizontal, HeaderCross, and HeaderRight draw the rule
+	// that separates the header row from the rest of the table.
+	// HeaderHorizontal is also used to draw that rule in tables that do not
+	// have borders.
+	HeaderLeft       string
+	HeaderHorizontal string
+	HeaderCross      string
+	HeaderRight      string
+
+	// BottomLeft, Bottom, BottomJunction, and BottomRight draw the border at
+	// the bottom of the table. Bottom is the horizontal line and
+	// BottomJunction is where it meets the line between two columns.
+	BottomLeft     string
+	Bottom         string
+	BottomJunction string
+	BottomRight    string
+}
+
+var (
+	// TableStyleASCII draws tables with "+", "|", and "-". It draws tables the
+	// same way as the DefaultTableCharSet.
+	TableStyleASCII = TableStyle{
+		TopLeft: "+", Top: "-", TopJunction: "+", TopRight: "+",
+		Vertical:     "|",
+		LeftJunction: "+", Horizontal: "-", Cross: "+", RightJunction: "+",
+		BottomLeft: "+", Bottom: "-", BottomJunction: "+", BottomRight: "+",
+	}
+
+	// TableStyleLight draws tables with light box-drawing lines.
+	TableStyleLight = TableStyle{
+		TopLeft: "┌", Top: "─", TopJunction: "┬", TopRight: "┐",
+		Vertical:     "│",
+		LeftJunction: "├", Horizontal: "─", Cross: "┼", RightJunction: "┤",
+		BottomLeft: "└", Bottom: "─", BottomJunction: "┴", BottomRight: "┘",
+	}
+
+	// TableStyleHeavy draws tables with heavy box-drawing lines.
+	TableStyleHeavy = TableStyle{
+		TopLeft: "┏", Top: "━", TopJunction: "┳", TopRight: "┓",
+		Vertical:     "┃",
+		LeftJunction: "┣", Horizontal: "━", Cross: "╋", RightJunction: "┫",
+		BottomLeft: "┗", Bottom: "━", BottomJunction: "┻", BottomRight: "┛",
+	}
+
+	// TableStyleDouble draws tables with double box-drawing lines.
+	TableStyleDouble = TableStyle{
+		TopLeft: "╔", Top: "═", TopJunction: "╦", TopRight: "╗",
+		Vertical:     "║",
+		LeftJunction: "╠", Horizontal: "═", Cross: "╬", RightJunction: "╣",
+		BottomLeft: "╚", Bottom: "═", BottomJunction: "╩", BottomRight: "╝",
+	}
+
+	// TableStyleRounded draws tables with light box-drawing lines and rounded
+	// corners.
+	TableStyleRounded = TableStyle{
+		TopLeft: "╭", Top: "─", TopJunction: "┬", TopRight: "╮",
+		Vertical:     "│",
+		LeftJunction: "├", Horizontal: "─", Cross: "┼", RightJunction: "┤",
+		BottomLeft: "╰", Bottom: "─", BottomJunction: "┴", BottomRight: "╯",
+	}
+
+	// TableStyleMarkdown draws tables with "|" and "-" and no top or bottom
+	// border, in the form of a Markdown pipe table. It only sets the
+	// characters that text tables are drawn with; use TableFormatMarkdown to
+	// create tables that are always valid Markdown.
+	TableStyleMarkdown = TableStyle{
+		Vertical:     "|",
+		LeftJunction: "|", Horizontal: "-", Cross: "|", RightJunction: "|",
+	}
+)
+
+// String gets the string representation of the TableStyle. It lists the
+// characters of the top border, the vertical lines, the rules between rows, the
+// header rule, and the bottom border, in that order.
+func (style TableStyle) String() string {
+	return fmt.Sprintf(
+		"TableStyle{%q, %q, %q, %q, %q}",
+		style.TopLeft+style.Top+style.TopJunction+style.TopRight,
+		style.Vertical,
+		style.LeftJunction+style.Horizontal+style.Cross+style.RightJunction,
+		style.HeaderLeft+style.HeaderHorizontal+style.HeaderCross+style.HeaderRight,
+		style.BottomLeft+style.Bottom+style.BottomJunction+style.BottomRight,
+	)
+}
+
+// layout returns the manip TableStyle that draws tables as given by style.
+func (style TableStyle) layout() manip.TableStyle {
+	ls := manip.TableStyle{
+		Vertical:      styleChar(style.Vertical),
+		LeftJunction:  styleChar(style.LeftJunction),
+		Horizontal:    styleChar(style.Horizontal),
+		Cross:         styleChar(style.Cross),
+		RightJunction: styleChar(style.RightJunction),
+	}
+
+	if style.TopLeft+style.Top+style.TopJunction+style.TopRight != "" {
+		ls.TopLeft = styleChar(style.TopLeft)
+		ls.Top = styleChar(style.Top)
+		ls.TopJunction = styleChar(style.TopJunction)
+		ls.TopRight = styleChar(style.TopRight)
+	}
+
+	if style.HeaderLeft+style.HeaderHorizontal+style.HeaderCross+style.HeaderRight != "" {
+		ls.HeaderLeft = styleChar(style.HeaderLeft)
+		ls.HeaderHorizontal = styleChar(style.HeaderHorizontal)
+		ls.HeaderCross = styleChar(style.HeaderCross)
+		ls.HeaderRight = styleChar(style.HeaderRight)
+	} else {
+		ls.HeaderLeft = ls.LeftJunction
+		ls.HeaderHorizontal = ls.Horizontal
+		ls.HeaderCross = ls.Cross
+		ls.HeaderRight = ls.RightJunction
+	}
+
+	if style.BottomLeft+style.Bottom+style.BottomJunction+style.BottomRight != "" {
+		ls.BottomLeft = styleChar(style.BottomLeft)
+		ls.Bottom = styleChar(style.Bottom)
+		ls.BottomJunction = styleChar(style.BottomJunction)
+		ls.BottomRight = styleChar(style.BottomRight)
+	}
+
+	return ls
+}
+
+// newTableStyle returns the TableStyle that draws tables as given by the manip
+// TableStyle ls.
+func newTableStyle(ls manip.TableStyle) TableStyle {
+	return TableStyle{
+		TopLeft: ls.TopLeft.String(), Top: ls.Top.String(), TopJunction: ls.TopJunction.String(), TopRight: ls.TopRight.String(),
+		Vertical:     ls.Vertical.String(),
+		LeftJunction: ls.LeftJunction.String(), Horizontal: ls.Horizontal.String(), Cross: ls.Cross.String(), RightJunction: ls.RightJunction.String(),
+		HeaderLeft: ls.HeaderLeft.String(), HeaderHorizontal: ls.HeaderHorizontal.String(), HeaderCross: ls.HeaderCross.String(), HeaderRight: ls.HeaderRight.String(),
+		BottomLeft: ls.BottomLeft.String(), Bottom: ls.Bottom.String(), BottomJunction: ls.BottomJunction.String(), BottomRight: ls.BottomRight.String(),
+	}
+}
+
+// styleChar returns the first character of s for drawing a part of a table, or
+// a space if s is empty.
+func styleChar(s string) gem.String {
+	gs := gem.New(s)
+	if gs.IsEmpty() {
+		return gem.New(" ")
+	}
+	return gs.Sub(0, 1)
+}
+
+// TableFormat is the format that tables are created in. It is used in the
+// TableFormat member of [Options].
+//
+// Every format lays out the table in the same way, so the same data and
+// Options can be used to create a table in any of them. Each TableFormat is
+// also a [TableRenderer], so tables can be drawn in it from a [TableLayout].
+type TableFormat int
+
+const (
+	// TableFormatText creates tables as fixed-width text drawn with the
+	// characters of the table style. It is the zero value of a TableFormat.
+	TableFormatText TableFormat = iota
+
+	// TableFormatHTML creates tables as HTML table elements, with the header
+	// in a thead element and the rest of the rows in a tbody element. The
+	// text of each cell is escaped, and each LineSeparator in it is replaced
+	// with a "<br>" tag. Columns that are not aligned to the left are given a
+	// text-align style, and cells that span are given colspan and rowspan
+	// attributes. TableBorders, TableCharSet, TableStyle, and TableFit are
+	// ignored.
+	TableFormatHTML
+
+	// TableFormatRSTGrid creates tables as reStructuredText grid tables. They
+	// always have borders, are drawn with "+", "-", "|", and "=", and have a
+	// rule between every row. Each LineSeparator in a cell starts a new line
+	// of the cell. TableBorders, TableCharSet, and TableStyle are ignored.
+	TableFormatRSTGrid
+
+	// TableFormatRSTSimple creates tables as reStructuredText simple tables.
+	// They never have borders and have rules of "=" above and below the table
+	// and under the header. Cells do not span more than one column or row,
+	// and each LineSeparator in a cell starts a new line of the cell. As a
+	// line whose first column is empty continues the row above it, each
+	// LineSeparator in the first column is replaced with a space instead, and
+	// an empty cell in the first column is given an empty comment, "..".
+	// TableBorders is ignored.
+	TableFormatRSTSimple
+
+	// TableFormatLaTeX creates tables as LaTeX tabular environments. The
+	// special characters in each cell are escaped, and each LineSeparator in
+	// it is replaced with a space. If TableBorders is set, there are vertical
+	// lines between the columns and rules at the top and bottom of the table.
+	// Cells that span more than one row are put in a \multirow, which needs
+	// the multirow package. TableCharSet, TableStyle, and TableFit are
+	// ignored.
+	TableFormatLaTeX
+
+	// TableFormatMarkdown creates tables as GitHub-flavored Markdown pipe
+	// tables. The first row of data is always the header, the table is drawn
+	// with "|" and "-" with no top or bottom border, and the rule under the
+	// header has ":" markers giving the alignment of each column in
+	// TableAlignments. Each header is aligned the same way as the rest of its
+	// column, and it is left as it is given unless TableHeaderCase is set.
+	// Each "|" in a cell is escaped as "\|" and each LineSeparator is
+	// replaced with "<br>". TableHeaders, TableBorders, TableCharSet,
+	// TableStyle, and TableFit are ignored, and cells do not span more than
+	// one column or row.
+	TableFormatMarkdown
+)
+
+// String gets the name of the TableFormat.
+func (format TableFormat) String() string {
+	switch format {
+	case TableFormatText:
+		return "TableFormatText"
+	case TableFormatHTML:
+		return "TableFormatHTML"
+	case TableFormatRSTGrid:
+		return "TableFormatRSTGrid"
+	case TableFormatRSTSimple:
+		return "TableFormatRSTSimple"
+	case TableFormatLaTeX:
+		return "TableFormatLaTeX"
+	case TableFormatMarkdown:
+		return "TableFormatMarkdown"
+	default:
+		return fmt.Sprintf("TableFormat(%d)", int(format))
+	}
+}
+
+// renderer returns the manip TableRenderer that draws tables in format. If
+// format is not a valid TableFormat, tables are drawn as text.
+func (format TableFormat) renderer() manip.TableRenderer {
+	switch format {
+	case TableFormatHTML:
+		return manip.HTMLRenderer
+	case TableFormatRSTGrid:
+		return manip.RSTGridRenderer
+	case TableFormatRSTSimple:
+		return manip.RSTSimpleRenderer
+	case TableFormatLaTeX:
+		return manip.LaTeXRenderer
+	case TableFormatMarkdown:
+		return manip.MarkdownRenderer
+	default:
+		return manip.TextRenderer
+	}
+}
+
+// RenderTable draws the table given by layout in format, with each line
+// separated by the LineSeparator of opts. This allows a [TableRenderer] to draw
+// a table in one of the built-in formats and then change it. The TableFormat
+// and TableRenderer of opts are ignored.
+func (format TableFormat) RenderTable(layout TableLayout, opts Options) string {
+	opts = opts.WithDefaults()
+	opts.TableFormat = format
+	tableBlock := format.renderer().Render(layout.layout(), gem.New(opts.LineSeparator), opts.layout())
+	return tableBlock.Join().String()
+}
+
+// TableRenderer draws tables from their layout. It is used in the
+// TableRenderer member of [Options] to create tables in formats other than
+// those given by [TableFormat]. Every TableFormat is itself a TableRenderer,
+// so a TableRenderer can draw a table in one of them and then change it.
+//
+// A TableRenderer is compared when the Options it is in are compared, so it
+// must be of a comparable type, such as a struct of comparable members or a
+// pointer.
+type TableRenderer interface {
+	// RenderTable draws the table given by layout, with each line separated
+	// by the LineSeparator of opts.
+	RenderTable(layout TableLayout, opts Options) string
+}
+
+// TableLayout is a table whose cells have been placed in its rows and columns
+// and whose columns have been given their widths. It is what a [TableRenderer]
+// draws a table from.
+type TableLayout struct {
+	// Cells is every cell in the table, in order from the top row to the
+	// bottom and from the leftmost column to the rightmost within each row.
+	Cells []LayoutCell
+
+	// Rows is the number of rows in the table.
+	Rows int
+
+	// ColumnWidths is the width of each column of the table when it is drawn
+	// as text, including the padding around its content.
+	ColumnWidths []int
+
+	// Width is the width of the whole table when it is drawn as text.
+	Width int
+
+	// Header is whether the first row of the table is its header.
+	Header bool
+
+	// Footer is whether the last row of the table is its footer.
+	Footer bool
+
+	// Rules are the indexes of the rows that have a rule drawn under them to
+	// separate them from the rows below.
+	Rules []int
+
+	// Border is whether the table has a border.
+	Border bool
+
+	// Style is the characters that the table is drawn with when it is drawn
+	// as text.
+	Style TableStyle
+}
+
+// LayoutCell is a cell of a [TableLayout].
+type LayoutCell struct {
+	// Row and Column are the indexes of the row and column that the cell
+	// starts in.
+	Row    int
+	Column int
+
+	// RowSpan and ColumnSpan are the number of rows and columns that the cell
+	// takes up.
+	RowSpan    int
+	ColumnSpan int
+
+	// Text is the content of the cell as it was given.
+	Text string
+
+	// Lines is the content of the cell split into the lines that it is laid
+	// out on, which may be more than one if it was wrapped to fit its column.
+	Lines []string
+}
+
+// newTableLayout returns the TableLayout of the manip TableLayout ml.
+func newTableLayout(ml manip.TableLayout) TableLayout {
+	layout := TableLayout{
+		Rows:         ml.Rows(),
+		ColumnWidths: ml.ColWidths,
+		Width:        ml.Width,
+		Header:       ml.Header,
+		Footer:       ml.Footer,
+		Rules:        ml.Rules,
+		Border:       ml.Border,
+		Style:        newTableStyle(ml.Style),
+	}
+	for _, c := range ml.Cells() {
+		lc := LayoutCell{
+			Row: c.Row, Column: c.Col, RowSpan: c.RowSpan, ColumnSpan: c.ColSpan,
+			Text:  c.Text.String(),
+			Lines: make([]string, len(c.Lines)),
+		}
+		for i := range c.Lines {
+			lc.Lines[i] = c.Lines[i].String()
+		}
+		layout.Cells = append(layout.Cells, lc)
+	}
+	return layout
+}
+
+// layout returns the manip TableLayout that draws the table given by layout.
+func (layout TableLayout) layout() manip.TableLayout {
+	ml := manip.TableLayout{
+		ColWidths: layout.ColumnWidths,
+		Width:     layout.Width,
+		Header:    layout.Header,
+		Footer:    layout.Footer,
+		Rules:     layout.Rules,
+		Border:    layout.Border,
+		Style:     layout.Style.layout(),
+	}
+
+	cells := make([]manip.LayoutCell, len(layout.Cells))
+	for i, c := range layout.Cells {
+		cells[i] = manip.LayoutCell{
+			Row: c.Row, Col: c.Column, RowSpan: c.RowSpan, ColSpan: c.ColumnSpan,
+			Text:  gem.New(c.Text),
+			Lines: make([]gem.String, len(c.Lines)),
+		}
+		for j := range c.Lines {
+			cells[i].Lines[j] = gem.New(c.Lines[j])
+		}
+	}
+	return ml.WithCells(cells, layout.Rows)
+}
+
+// customRenderer is the manip TableRenderer that lays out tables as the
+// TableFormat of opts does and then draws them with the TableRenderer of opts.
+type customRenderer struct {
+	opts Options
+}
+
+// Prepare returns spec as the TableFormat of the options of cr prepares it.
+func (cr customRenderer) Prepare(spec manip.TableSpec, lineSep gem.String) manip.TableSpec {
+	return cr.opts.TableFormat.renderer().Prepare(spec, lineSep)
+}
+
+// Render draws the table given by layout with the TableRenderer of the options
+// of cr. A LineSeparator at the end of what it draws is removed.
+func (cr customRenderer) Render(layout manip.TableLayout, lineSep gem.String, opts manip.Options) tb.Block {
+	text := cr.opts.TableRenderer.RenderTable(newTableLayout(layout), cr.opts)
+	tableBlock := tb.New(gem.New(text), lineSep)
+	tableBlock.TrailingSeparator = false
+	return tableBlock
+}
+
+// CheckColumnWidths checks whether the constraints in the TableColumnWidths of
+// opts can all be met by a table with cols columns that is created with a width
+// of width, such as by [Editor.InsertTable]. When checking whether the columns
+// fit within width, each column without a Fixed, Percent, or Min width is
+// counted as being one character wide.
+//
+// An error describing the first constraint that cannot be met is returned if
+// there is one. This is the case when a column has a minimum width greater
+// than its maximum, has both a Fixed and a Percent width, or has a Fixed or
+// Percent width that is outside of its minimum and maximum; when the Percent
+// widths add up to more than 100; and when the columns together with their
+// padding and borders need more than width. A table created from constraints
+// that cannot be met is made as close to them as it can be.
+//
+// This function is affected by the following [Options]:
+//
+//   - TableBorders sets whether the width taken up by borders is counted.
+//   - TableCharSet is used to find the width of the borders if TableStyle is
+//     not set.
+//   - TableColumnWidths is the constraints that are checked.
+//   - TableFormat sets whether the table is created in a format that always
+//     has borders or never has them.
+//   - TableStyle is used to find the width of the borders.
+func CheckColumnWidths(cols, width int, opts Options) error {
+	opts = opts.WithDefaults()
+	return manip.CheckColumnWidths(cols, width, opts.TableBorders, opts.tableStyle(), opts.layout())
+}
+
+// ParseTable reads the cells of a table that is drawn in text, such as one that
+// was created with [Editor.InsertTable] or that was printed by another program.
+// Each row of the returned data is a row of the table, with the header row
+// first if the table has one.
+//
+// Lines that are made up of only the characters of the table style, "-", "=",
+// "+", "|", ":", and box-drawing characters are read as rules and are not
+// included in the data. The table is read from the lines around the first
+// rule, and the lines before and after it are ignored. This leaves out the
+// text that programs such as psql and MySQL print around a table, like the
+// query before it and the count of rows after it.
+//
+// If the rule has junctions in it, such as the "+" characters of
+// "+----+----+", the table has vertical lines at the same columns as the
+// junctions, drawn with the Vertical character of the table style, "|", or a
+// box-drawing character. The table is then made up of the rules and the lines
+// that have vertical lines at the first and last of those columns, and the
+// cells of each line are the parts between its vertical lines at those
+// columns, so a "|" in the text of a cell is kept as part of it. If a line has
+// no vertical line at one of the columns, as it does where a cell spans more
+// than one column, the text of the cell is given for the first column it spans
+// and the others are empty. Each "\|" in a cell, as in a Markdown table, is
+// read as "|". If no lines of the table line up with the rule, as in a
+// Markdown table written by hand, the cells of each line are instead the parts
+// between all of its vertical lines.
+//
+// If the rule has no junctions in it, the table has no vertical lines and is
+// made up of the lines that are not blank around the rule. If there are no
+// rules, the table is all of text, and it has vertical lines only where every
+// line has one in the same column. The columns of a table with no vertical
+// lines are found from the runs of whitespace at least two characters wide
+// that go down every line. Whitespace around each cell is removed, and blank
+// lines are ignored.
+//
+// If there is a rule between every row of the table, the lines between each
+// pair of rules are read as a single row, with the text of each cell joined
+// with a space. Otherwise, if there is a rule after the first lines of the
+// table, such as the one below the header of a table made by
+// [Editor.InsertTable], those lines are read as the header row in the same way
+// and each line after the rule is its own row unless it continues the row
+// above it. A line continues the row above it if a cell in the line above ends
+// with the "+" that psql uses to mark text that goes on in the next line, or
+// if TableFit is set and the line holds the rest of cells that were wrapped to
+// fit their columns. Cells are only found to be wrapped in columns that have
+// text in a line that starts with an empty cell, so a table whose first column
+// was wrapped is not read back exactly; TableNoWrapColumns can be used to keep
+// the first column from being wrapped.
+//
+// An error is returned if text does not have a table in it, which is the case
+// when it has no rules, no vertical lines, and no columns lined up with
+// whitespace between them.
+//
+// This function is grapheme-aware and indexes text by human-readable
+// characters, not by the bytes or runes that make it up. See the note on
+// Grapheme-Awareness in the [rosed] package docs for more info.
+//
+// This function is affected by the following [Options]:
+//
+//   - ANSIEscapes sets whether ANSI escape sequences are treated as
+//     zero-width units that are never split.
+//   - DisplayWidth sets whether the columns of a table with no vertical lines
+//     are found by the number of terminal cells that each character takes up
+//     instead of by the number of characters.
+//   - LineSeparator is used to separate the lines of text.
+//   - Normalization sets the Unicode normalization form that text is put in
+//     before it is read.
+//   - NoTrailingLineSeparators specifies whether the function should consider
+//     a final instance of LineSeparator to be ending the prior line or giving
+//     the start of a new line.
+//   - TableCharSet is used to recognize the rules and vertical lines of the
+//     table if TableStyle is not set.
+//   - TableFit sets whether lines that hold the rest of wrapped cells are read
+//     as part of the row above them.
+//   - TableStyle is used to recognize the rules and vertical lines of the
+//     table.
+func ParseTable(text string, opts Options) ([][]string, error) {
+	opts = opts.WithDefaults()
+
+	ed := Edit(opts.Normalization.normalize(text)).WithOptions(opts)
+	lines := ed.lines()
+	gemLines := make([]gem.String, len(lines))
+	for i := range lines {
+		gemLines[i] = gem.New(lines[i])
+	}
+
+	gemData, err := manip.ParseTable(gemLines, opts.tableStyle(), opts.layout())
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([][]string, len(gemData))
+	for row := range gemData {
+		data[row] = make([]string, len(gemData[row]))
+		for col := range gemData[row] {
+			data[row][col] = gemData[row][col].String()
+		}
+	}
+	return data, nil
+}
+
+// WriteTableCSV writes table data to w in CSV format. Each row of data is
+// written as one record, in the format that is given in RFC 4180, as it is by
+// [csv.Writer]. Data that is written this way can be read back into a table
+// with [Editor.InsertTableFromCSV], and data read from a table with
+// [ParseTable] can be written this way.
+//
+// An error is returned if the data cannot be written to w.
+func WriteTableCSV(w io.Writer, data [][]string) error {
+	return csv.NewWriter(w).WriteAll(data)
+}
+
+// WriteTableTSV writes table data to w in TSV format. It is identical to
+// [WriteTableCSV] except that fields are separated by tabs instead of commas.
+// Data that is written this way can be read back into a table with
+// [Editor.InsertTableFromTSV].
+//
+// An error is returned if the data cannot be written to w.
+func WriteTableTSV(w io.Writer, data [][]string) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = '\t'
+	return cw.WriteAll(data)
+}
+
+// WrapAlgorithm is the way that lines are chosen when text is wrapped. It is
+// used in the WrapAlgorithm member of [Options].
+type WrapAlgorithm int
+
+const (
+	// WrapGreedy fills each line with as many words as will fit before moving
+	// on to the next line. It is the zero value of a WrapAlgorithm.
+	WrapGreedy WrapAlgorithm = iota
+
+	// WrapMinimumRaggedness chooses the line breaks for each paragraph as a
+	// whole such that the sum of the squares of the space left at the end of
+	// each line, other than the last line, is as small as possible. This
+	// produces lines that are closer to the same length than WrapGreedy does.
+	WrapMinimumRaggedness
+
+	// WrapKnuthPlass chooses the line breaks for each paragraph as a whole
+	// using the total-fit line breaking algorithm of Knuth and Plass. Each line
+	// is penalized by how much the spaces in it would need to be stretched for
+	// it to fill the full width, as they are when the text is justified with
+	// [Editor.Justify], and lines whose spacing differs greatly from that of
+	// the line before them are penalized further. This avoids lines with very
+	// wide gaps between words once the text is justified. [Editor.Justify]
+	// wraps text this way before justifying it when it is set.
+	WrapKnuthPlass
 )
 
+// String gets the name of the WrapAlgorithm.
+func (alg WrapAlgorithm) String() string {
+	switch alg {
+	case WrapGreedy:
+		return "WrapGreedy"
+	case WrapMinimumRaggedness:
+		return "WrapMinimumRaggedness"
+	case WrapKnuthPlass:
+		return "WrapKnuthPlass"
+	default:
+		return fmt.Sprintf("WrapAlgorithm(%d)", int(alg))
+	}
+}
+
+// layout returns the manip WrapAlgorithm that implements alg. If alg is not a
+// valid WrapAlgorithm, the greedy algorithm is used.
+func (alg WrapAlgorithm) layout() manip.WrapAlgorithm {
+	switch alg {
+	case WrapMinimumRaggedness:
+		return manip.MinimumRaggedness
+	case WrapKnuthPlass:
+		return manip.KnuthPlass
+	default:
+		return manip.Greedy
+	}
+}
+
+// Hyphenator finds the points at which words can be hyphenated using the
+// pattern-based algorithm that TeX uses. It is used in the Hyphenator member of
+// [Options].
+//
+// The zero value is a Hyphenator that uses the built-in American English
+// patterns. To hyphenate another language, create a Hyphenator from the
+// patterns for that language with [NewHyphenator] or [LoadHyphenator].
+type Hyphenator struct {
+	h *hyph.Hyphenator
+}
+
+// NewHyphenator creates a Hyphenator from lists of TeX-style hyphenation
+// patterns and hyphenation exceptions, such as those in the plain-text pattern
+// files that the hyph-utf8 project provides for many languages.
+//
+// Each list is separated by whitespace, and anything from a "%" to the end of
+// a line is ignored. Patterns are given in TeX's format, such as "a1b" or
+// ".ex5". Exceptions are words with a "-" at each point where they can be
+// hyphenated, such as "as-so-ciate"; an exception is hyphenated at only those
+// points regardless of the patterns. Either list may be empty.
+//
+// An error is returned if a pattern or exception is malformed.
+//
+// The returned Hyphenator requires at least 2 letters before a hyphen and 3
+// after it; use [Hyphenator.WithMinimums] to change this.
+func NewHyphenator(patterns, exceptions string) (Hyphenator, error) {
+	h, err := hyph.New(patterns, exceptions)
+	if err != nil {
+		return Hyphenator{}, err
+	}
+	return Hyphenator{h: h}, nil
+}
+
+// LoadHyphenator creates a Hyphenator from a TeX hyphenation file read from r,
+// such as hyph-de-1996.tex. The patterns are read from the \patterns{...}
+// group in the file and the exceptions are read from the \hyphenation{...}
+// group, if there is one. Everything else in the file is ignored.
+//
+// An error is returned if r cannot be read, if there is no \patterns{...}
+// group, or if a pattern or exception is malformed.
+//
+// The returned Hyphenator requires at least 2 letters before a hyphen and 3
+// after it; use [Hyphenator.WithMinimums] to change this.
+func LoadHyphenator(r io.Reader) (Hyphenator, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return Hyphenator{}, err
+	}
+	h, err := hyph.Parse(string(data))
+	if err != nil {
+		return Hyphenator{}, err
+	}
+	return Hyphenator{h: h}, nil
+}
+
+// Hyphenate splits word into the parts between each point at which it can be
+// hyphenated. If word cannot be hyphenated, a slice containing only word is
+// returned.
+func (hy Hyphenator) Hyphenate(word string) []string {
+	r := []rune(word)
+	var parts []string
+	last := 0
+	for _, p := range hy.patterns().Points(r) {
+		parts = append(parts, string(r[last:p]))
+		last = p
+	}
+	return append(parts, string(r[last:]))
+}
+
+// String gets the string representation of the Hyphenator.
+func (hy Hyphenator) String() string {
+	h := hy.patterns()
+	patterns, exceptions := h.Len()
+	return fmt.Sprintf("Hyphenator{Patterns: %d, Exceptions: %d, LeftMin: %d, RightMin: %d}", patterns, exceptions, h.LeftMin, h.RightMin)
+}
+
+// WithMinimums returns a new Hyphenator identical to this one but that
+// requires at least left letters before a hyphen and at least right letters
+// after it.
+//
+// This function does not modify the Hyphenator it is called on.
+func (hy Hyphenator) WithMinimums(left, right int) Hyphenator {
+	h := *hy.patterns()
+	h.LeftMin = left
+	h.RightMin = right
+	return Hyphenator{h: &h}
+}
+
+// patterns returns the hyph.Hyphenator that hy uses.
+func (hy Hyphenator) patterns() *hyph.Hyphenator {
+	if hy.h == nil {
+		return hyph.English()
+	}
+	return hy.h
+}
+
 // LineOperation is a function that accepts a zero-indexed line number and the
 // contents of that line and performs some operation to produce zero or more new
 // lines to replace the contents of the line with.
@@ -71,6 +1349,42 @@ type LineOperation func(idx int, line string) []string
 // paragraph(s).
 type ParagraphOperation func(idx int, para, sepPrefix, sepSuffix string) []string
 
+// SentenceOperation is a function that accepts the zero-indexed number of a
+// paragraph, the zero-indexed number of a sentence within that paragraph, and
+// the contents of that sentence, and performs some operation to produce zero
+// or more new sentences to replace the contents of the sentence with.
+//
+// The return value for a SentenceOperation is a slice of sentences to insert at
+// the old sentence position. This can be used to delete the sentence or insert
+// additional new ones; to insert, include the new sentences in the returned
+// slice in the proper position relative to the old sentence in the slice, and
+// to delete the original sentence, a slice with len < 1 can be returned.
+//
+// The parameters paraIdx and idx will always be the indexes of the paragraph
+// and sentence before any transformations were applied. As idx restarts at 0
+// for each paragraph, the first sentence of every paragraph is the one called
+// with idx = 0.
+type SentenceOperation func(paraIdx, idx int, sentence string) []string
+
+// ValueFormatter is a function that accepts the header of a column of a table
+// created by [Editor.InsertStructTable] and a value that is shown in that
+// column, and returns the text that the value is shown as. A pointer to one is
+// used in the TableValueFormatter member of [Options]; see
+// [Options.WithTableValueFormatter].
+//
+// The value is the value of a field of a struct or of a key of a map as it is,
+// without any pointers followed, so it can be nil.
+type ValueFormatter func(header string, value interface{}) string
+
+// String gets the string representation of the ValueFormatter. As the function
+// itself cannot be shown, this only gives whether it is nil.
+func (vf ValueFormatter) String() string {
+	if vf == nil {
+		return "<nil>"
+	}
+	return "ValueFormatter"
+}
+
 type gParagraphOperation func(idx int, para, sepPrefix, sepSuffix gem.String) []gem.String
 
 func (ed Editor) applyGParagraphsOpts(op gParagraphOperation, opts Options) Editor {
@@ -0,0 +1,561 @@
+package rosed
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewHyphenator(t *testing.T) {
+	testCases := []struct {
+		name       string
+		patterns   string
+		exceptions string
+		word       string
+		expect     []string
+		expectErr  bool
+	}{
+		{
+			name:   "no patterns",
+			word:   "balloon",
+			expect: []string{"balloon"},
+		},
+		{
+			name:     "patterns",
+			patterns: "l1l",
+			word:     "balloon",
+			expect:   []string{"bal", "loon"},
+		},
+		{
+			name:       "exceptions",
+			patterns:   "l1l",
+			exceptions: "ball-oon",
+			word:       "balloon",
+			expect:     []string{"ball", "oon"},
+		},
+		{
+			name:      "bad pattern",
+			patterns:  "l12l",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			h, err := NewHyphenator(tc.patterns, tc.exceptions)
+			if tc.expectErr {
+				assert.Error(err)
+				return
+			}
+			if !assert.NoError(err) {
+				return
+			}
+
+			actual := h.Hyphenate(tc.word)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_LoadHyphenator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		word      string
+		expect    []string
+		expectErr bool
+	}{
+		{
+			name:   "patterns",
+			input:  "\\patterns{l1l}",
+			word:   "balloon",
+			expect: []string{"bal", "loon"},
+		},
+		{
+			name:   "patterns and exceptions",
+			input:  "\\patterns{l1l}\n\\hyphenation{ball-oon}",
+			word:   "balloon",
+			expect: []string{"ball", "oon"},
+		},
+		{
+			name:      "no patterns",
+			input:     "\\hyphenation{ball-oon}",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			h, err := LoadHyphenator(strings.NewReader(tc.input))
+			if tc.expectErr {
+				assert.Error(err)
+				return
+			}
+			if !assert.NoError(err) {
+				return
+			}
+
+			actual := h.Hyphenate(tc.word)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_Hyphenator_Hyphenate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		hy     Hyphenator
+		word   string
+		expect []string
+	}{
+		{"zero value uses English", Hyphenator{}, "hyphenation", []string{"hy", "phen", "a", "tion"}},
+		{"empty word", Hyphenator{}, "", []string{""}},
+		{"word with no points", Hyphenator{}, "rose", []string{"rose"}},
+		{"larger minimums", Hyphenator{}.WithMinimums(3, 5), "hyphenation", []string{"hyphen", "ation"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.hy.Hyphenate(tc.word)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_NewColumnWidths(t *testing.T) {
+	testCases := []struct {
+		name   string
+		widths []ColumnWidth
+	}{
+		{name: "none", widths: []ColumnWidth{}},
+		{name: "zero value", widths: []ColumnWidth{{}}},
+		{name: "several", widths: []ColumnWidth{{Fixed: 12}, {Min: 3, Max: 20}, {Percent: 40, Weight: -1}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := NewColumnWidths(tc.widths...)
+
+			assert.Equal(len(tc.widths), actual.Len())
+			assert.Equal(tc.widths, actual.Slice())
+		})
+	}
+}
+
+func Test_NewAlignments(t *testing.T) {
+	testCases := []struct {
+		name   string
+		aligns []Alignment
+	}{
+		{name: "none", aligns: []Alignment{}},
+		{name: "several", aligns: []Alignment{None, Left, Right, Center, Decimal}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := NewAlignments(tc.aligns...)
+
+			assert.Equal(len(tc.aligns), actual.Len())
+			assert.Equal(tc.aligns, actual.Slice())
+		})
+	}
+}
+
+func Test_TableFormat_RenderTable(t *testing.T) {
+	layout := TableLayout{
+		Cells: []LayoutCell{
+			{Row: 0, Column: 0, RowSpan: 1, ColumnSpan: 2, Text: "name", Lines: []string{"name"}},
+			{Row: 1, Column: 0, RowSpan: 1, ColumnSpan: 1, Text: "Rose Lalonde", Lines: []string{"Rose", "Lalonde"}},
+			{Row: 1, Column: 1, RowSpan: 1, ColumnSpan: 1, Text: "Seer", Lines: []string{"Seer"}},
+		},
+		Rows:         3,
+		ColumnWidths: []int{9, 6},
+		Width:        16,
+		Header:       true,
+		Border:       true,
+		Style:        TableStyleASCII,
+	}
+
+	testCases := []struct {
+		name   string
+		format TableFormat
+		expect string
+	}{
+		{
+			name:   "text",
+			format: TableFormatText,
+			expect: "+----------------+\n" +
+				"|      NAME      |\n" +
+				"+---------+------+\n" +
+				"| Rose    | Seer |\n" +
+				"| Lalonde |      |\n" +
+				"|         |      |\n" +
+				"+---------+------+",
+		},
+		{
+			name:   "html",
+			format: TableFormatHTML,
+			expect: "<table>\n" +
+				"  <thead>\n" +
+				"    <tr>\n" +
+				"      <th colspan=\"2\">NAME</th>\n" +
+				"    </tr>\n" +
+				"  </thead>\n" +
+				"  <tbody>\n" +
+				"    <tr>\n" +
+				"      <td>Rose Lalonde</td>\n" +
+				"      <td>Seer</td>\n" +
+				"    </tr>\n" +
+				"    <tr>\n" +
+				"      <td></td>\n" +
+				"      <td></td>\n" +
+				"    </tr>\n" +
+				"  </tbody>\n" +
+				"</table>",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.format.RenderTable(layout, Options{})
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_ParseTable(t *testing.T) {
+	testCases := []struct {
+		name      string
+		text      string
+		opts      Options
+		expect    [][]string
+		expectErr bool
+	}{
+		{
+			name:      "empty text",
+			text:      "",
+			expectErr: true,
+		},
+		{
+			name:      "text with no table",
+			text:      "just some text",
+			expectErr: true,
+		},
+		{
+			name: "psql output",
+			text: " id | name  | note\n" +
+				"----+-------+------\n" +
+				"  1 | alice | hi\n" +
+				"  2 | bob   | \n" +
+				"(2 rows)\n" +
+				"\n",
+			expect: [][]string{
+				{"id", "name", "note"},
+				{"1", "alice", "hi"},
+				{"2", "bob", ""},
+			},
+		},
+		{
+			name: "mysql output",
+			text: "+----+-------+\n" +
+				"| id | name  |\n" +
+				"+----+-------+\n" +
+				"|  1 | alice |\n" +
+				"|  2 | bob   |\n" +
+				"+----+-------+\n" +
+				"2 rows in set (0.00 sec)\n",
+			expect: [][]string{
+				{"id", "name"},
+				{"1", "alice"},
+				{"2", "bob"},
+			},
+		},
+		{
+			name: "table from InsertTable",
+			text: "+------+-------+\n" +
+				"| NAME | CLASS |\n" +
+				"+------+-------+\n" +
+				"| John | Heir  |\n" +
+				"| Rose | Seer  |\n" +
+				"+------+-------+\n",
+			expect: [][]string{
+				{"NAME", "CLASS"},
+				{"John", "Heir"},
+				{"Rose", "Seer"},
+			},
+		},
+		{
+			name: "custom char set",
+			text: "#======#=======#\n" +
+				"! NAME ! CLASS !\n" +
+				"#======#=======#\n" +
+				"! John ! Heir  !\n" +
+				"#======#=======#\n",
+			opts: Options{TableCharSet: "#!="},
+			expect: [][]string{
+				{"NAME", "CLASS"},
+				{"John", "Heir"},
+			},
+		},
+		{
+			name: "custom line separator",
+			text: "John  Heir<br>Rose  Seer<br>",
+			opts: Options{LineSeparator: "<br>"},
+			expect: [][]string{
+				{"John", "Heir"},
+				{"Rose", "Seer"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := ParseTable(tc.text, tc.opts)
+			if tc.expectErr {
+				assert.Error(err)
+				return
+			}
+			if !assert.NoError(err) {
+				return
+			}
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_ParseTable_roundTrip(t *testing.T) {
+	data := [][]string{
+		{"NAME", "CLASS", "LAND"},
+		{"John Egbert", "Heir of Breath", "Land of Wind and Shade"},
+		{"Rose Lalonde", "Seer of Light", "Land of Light and Rain"},
+		{"Dave Strider", "", "Land of Heat and Clockwork"},
+	}
+	pipeData := [][]string{
+		{"NAME", "VALUE"},
+		{"alpha", "one"},
+		{"beta", "two | pipe"},
+		{"gamma | delta", "|"},
+	}
+
+	testCases := []struct {
+		name  string
+		data  [][]string
+		width int
+		opts  Options
+	}{
+		{name: "no borders", width: 80, opts: Options{TableHeaders: true}},
+		{name: "borders", width: 80, opts: Options{TableHeaders: true, TableBorders: true}},
+		{name: "char set", width: 80, opts: Options{TableHeaders: true, TableBorders: true, TableCharSet: "#!="}},
+		{name: "light style", width: 80, opts: Options{TableHeaders: true, TableBorders: true, TableStyle: TableStyleLight}},
+		{name: "double style", width: 80, opts: Options{TableHeaders: true, TableBorders: true, TableStyle: TableStyleDouble}},
+		{name: "markdown style", width: 80, opts: Options{TableHeaders: true, TableBorders: true, TableStyle: TableStyleMarkdown}},
+		{name: "no header", width: 80, opts: Options{TableBorders: true}},
+		{name: "fit without wrapping", width: 80, opts: Options{TableHeaders: true, TableBorders: true, TableFit: true}},
+		{name: "fit with wrapped cells", width: 50, opts: Options{TableHeaders: true, TableBorders: true, TableFit: true}},
+		{name: "fit with wrapped cells and no borders", width: 50, opts: Options{TableHeaders: true, TableFit: true}},
+		{name: "markdown", width: 80, opts: Options{TableFormat: TableFormatMarkdown}},
+		{name: "pipes in cells, no borders", data: pipeData, width: 40, opts: Options{TableHeaders: true}},
+		{name: "pipes in cells, borders", data: pipeData, width: 40, opts: Options{TableHeaders: true, TableBorders: true}},
+		{name: "pipes in cells, light style", data: pipeData, width: 40, opts: Options{TableHeaders: true, TableBorders: true, TableStyle: TableStyleLight}},
+		{name: "pipes in cells, no header", data: pipeData, width: 40, opts: Options{TableBorders: true}},
+		{name: "pipes in cells, markdown", data: pipeData, width: 40, opts: Options{TableFormat: TableFormatMarkdown}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			expect := data
+			if tc.data != nil {
+				expect = tc.data
+			}
+
+			table := Edit("").InsertTableOpts(0, expect, tc.width, tc.opts).String()
+
+			actual, err := ParseTable(table, tc.opts)
+			if !assert.NoError(err) {
+				return
+			}
+
+			assert.Equal(expect, actual)
+		})
+	}
+}
+
+func Test_WriteTableCSV(t *testing.T) {
+	testCases := []struct {
+		name   string
+		data   [][]string
+		expect string
+	}{
+		{
+			name:   "no data",
+			data:   nil,
+			expect: "",
+		},
+		{
+			name: "plain fields",
+			data: [][]string{
+				{"John", "Heir"},
+				{"Rose", "Seer"},
+			},
+			expect: "John,Heir\nRose,Seer\n",
+		},
+		{
+			name: "fields that need quotes",
+			data: [][]string{
+				{"John", "Heir, of Breath"},
+				{"Rose", "Seer of \"Light\""},
+				{"Dave", "Knight\nof Time"},
+			},
+			expect: "John,\"Heir, of Breath\"\nRose,\"Seer of \"\"Light\"\"\"\nDave,\"Knight\nof Time\"\n",
+		},
+		{
+			name: "rows with different numbers of fields",
+			data: [][]string{
+				{"John", "Heir", "Breath"},
+				{"Rose"},
+			},
+			expect: "John,Heir,Breath\nRose\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var sb strings.Builder
+			err := WriteTableCSV(&sb, tc.data)
+			if !assert.NoError(err) {
+				return
+			}
+
+			assert.Equal(tc.expect, sb.String())
+		})
+	}
+}
+
+func Test_WriteTableTSV(t *testing.T) {
+	testCases := []struct {
+		name   string
+		data   [][]string
+		expect string
+	}{
+		{
+			name: "plain fields",
+			data: [][]string{
+				{"John", "Heir, of Breath"},
+				{"Rose", "Seer"},
+			},
+			expect: "John\tHeir, of Breath\nRose\tSeer\n",
+		},
+		{
+			name: "field with tab",
+			data: [][]string{
+				{"John", "Heir\tBreath"},
+			},
+			expect: "John\t\"Heir\tBreath\"\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var sb strings.Builder
+			err := WriteTableTSV(&sb, tc.data)
+			if !assert.NoError(err) {
+				return
+			}
+
+			assert.Equal(tc.expect, sb.String())
+		})
+	}
+}
+
+func Test_CheckColumnWidths(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cols      int
+		width     int
+		opts      Options
+		expectErr bool
+	}{
+		{
+			name:  "no constraints",
+			cols:  2,
+			width: 0,
+			opts:  Options{},
+		},
+		{
+			name:  "constraints that can be met",
+			cols:  3,
+			width: 40,
+			opts: Options{
+				TableBorders:      true,
+				TableColumnWidths: NewColumnWidths(ColumnWidth{Fixed: 12}, ColumnWidth{Percent: 40}, ColumnWidth{Max: 20}),
+			},
+		},
+		{
+			name:  "percentages over 100",
+			cols:  2,
+			width: 40,
+			opts: Options{
+				TableColumnWidths: NewColumnWidths(ColumnWidth{Percent: 50}, ColumnWidth{Percent: 51}),
+			},
+			expectErr: true,
+		},
+		{
+			name:  "fixed widths wider than table",
+			cols:  2,
+			width: 20,
+			opts: Options{
+				TableColumnWidths: NewColumnWidths(ColumnWidth{Fixed: 10}, ColumnWidth{Fixed: 10}),
+			},
+			expectErr: true,
+		},
+		{
+			name:  "borders counted for markdown tables",
+			cols:  2,
+			width: 20,
+			opts: Options{
+				TableFormat:       TableFormatMarkdown,
+				TableColumnWidths: NewColumnWidths(ColumnWidth{Fixed: 8}, ColumnWidth{Fixed: 8}),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			err := CheckColumnWidths(tc.cols, tc.width, tc.opts)
+			if tc.expectErr {
+				assert.Error(err)
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
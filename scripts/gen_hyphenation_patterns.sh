@@ -0,0 +1,20 @@
+#!/bin/bash
+
+# regenerates the built-in hyphenation patterns used by hyph from the plain-text
+# pattern files of the hyph-utf8 project, along with the copyright and license
+# notice of the TeX pattern file that they come from. Takes the path to the
+# patterns directory of hyph-utf8, which contains txt/hyph-en-us.pat.txt,
+# txt/hyph-en-us.hyp.txt, and tex/hyph-en-us.tex.
+
+set -eo pipefail
+
+if [ "$#" -ne 1 ]
+then
+  echo "usage: $0 PATTERNS_DIR" >&2
+  exit 1
+fi
+
+repo_path="$(dirname "$0")/.."
+patterns_path="$1"
+
+python "$repo_path/scripts/get_hyphenation_patterns.py" "$patterns_path/txt/hyph-en-us.pat.txt" "$patterns_path/txt/hyph-en-us.hyp.txt" "$patterns_path/tex/hyph-en-us.tex" | gofmt > "$repo_path/internal/hyph/englishdata.go"
@@ -0,0 +1,14 @@
+#!/bin/bash
+
+# regenerates the Unicode property data tables used by gem from the Unicode
+# Character Database that ships with the python standard library.
+
+set -eo pipefail
+
+repo_path="$(dirname "$0")/.."
+
+python "$repo_path/scripts/get_unicode_east_asian_width.py" | gofmt > "$repo_path/internal/gem/eastasianwidth.go"
+python "$repo_path/scripts/get_unicode_normalization.py" | gofmt > "$repo_path/internal/gem/normalizationdata.go"
+python "$repo_path/scripts/get_unicode_line_break.py" | gofmt > "$repo_path/internal/gem/linebreakdata.go"
+python "$repo_path/scripts/get_unicode_word_break.py" | gofmt > "$repo_path/internal/gem/wordbreakdata.go"
+python "$repo_path/scripts/get_unicode_sentence_break.py" | gofmt > "$repo_path/internal/gem/sentencebreakdata.go"
@@ -0,0 +1,91 @@
+# generates the built-in American English hyphenation patterns used by hyph.
+# Output is go source code.
+#
+# Takes the paths to the plain-text pattern and exception files for US English
+# from the hyph-utf8 project, hyph-en-us.pat.txt and hyph-en-us.hyp.txt, which
+# list one pattern or hyphenated word per line, and the path to hyph-en-us.tex,
+# whose leading comment block gives the copyright and license of the patterns.
+# They can be found in the tex/generic/hyph-utf8/patterns/txt and
+# tex/generic/hyph-utf8/patterns/tex directories of a hyph-utf8 distribution.
+
+import re
+import sys
+
+# number of patterns or words that are put on each line of output.
+_per_line = 8
+
+
+def read_entries(path, valid):
+    entries = list()
+    with open(path, encoding="utf-8") as f:
+        for line in f:
+            line = line.split("%", 1)[0]
+            for entry in line.split():
+                if not valid.fullmatch(entry):
+                    raise ValueError("invalid entry in %s: %r" % (path, entry))
+                entries.append(entry)
+    return entries
+
+
+def read_notice(path):
+    """Return the lines of the comment block at the start of the file at path,
+    which holds its copyright and license notice, without their comment
+    markers."""
+    notice = list()
+    with open(path, encoding="utf-8") as f:
+        for line in f:
+            line = line.rstrip("\r\n")
+            if not line.startswith("%"):
+                break
+            line = line[1:]
+            if line.startswith(" "):
+                line = line[1:]
+            notice.append(line)
+    if len(notice) < 1:
+        raise ValueError("no notice found at start of %s" % path)
+    return notice
+
+
+def print_const(name, entries):
+    print("const " + name + " = `")
+    for i in range(0, len(entries), _per_line):
+        print(" ".join(entries[i:i + _per_line]))
+    print("`")
+
+
+def main():
+    if len(sys.argv) != 4:
+        print("usage: get_hyphenation_patterns.py PAT_FILE HYP_FILE TEX_FILE", file=sys.stderr)
+        sys.exit(1)
+
+    patterns = read_entries(sys.argv[1], re.compile(r"[.a-z0-9]+"))
+    exceptions = read_entries(sys.argv[2], re.compile(r"[a-z-]+"))
+    notice = read_notice(sys.argv[3])
+
+    print("Read %d patterns and %d exceptions..." % (len(patterns), len(exceptions)), file=sys.stderr)
+
+    print("// Code generated by scripts/get_hyphenation_patterns.py. DO NOT EDIT.")
+    print("")
+    print("package hyph")
+    print("")
+    print("// englishPatterns and englishExceptions are the American English hyphenation")
+    print("// patterns and hyphenation exceptions from the hyph-utf8 project, which are the")
+    print("// patterns that Frank Liang generated for TeX along with the list of words")
+    print("// that they do not hyphenate correctly. Each is a whitespace-separated list.")
+    print("//")
+    print("// Data for englishPatterns and englishExceptions was generated from")
+    print("// hyph-en-us.pat.txt and hyph-en-us.hyp.txt by")
+    print("// scripts/get_hyphenation_patterns.py, and is used under the terms of the")
+    print("// license given in hyph-en-us.tex in the hyph-utf8 project. Its copyright")
+    print("// and license notice is reproduced below as it is given there.")
+    print("//")
+    for line in notice:
+        print(("// " + line).rstrip())
+    print("")
+    print_const("englishPatterns", patterns)
+    print("")
+    print_const("englishExceptions", exceptions)
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,78 @@
+# generates the east asian width property functions used by gem for measuring
+# the display width of text. Output is go source code.
+#
+# Data is taken from the unicodedata module in the python standard library,
+# which is built from the UCD file EastAsianWidth.txt for the version of
+# Unicode that the running python supports.
+
+import sys
+import unicodedata
+
+_max_codepoint = 0x10FFFF
+
+
+def ranges_where(pred):
+    ranges = list()
+    start = None
+    for cp in range(_max_codepoint + 1):
+        if pred(cp):
+            if start is None:
+                start = cp
+        elif start is not None:
+            ranges.append((start, cp - 1))
+            start = None
+    if start is not None:
+        ranges.append((start, _max_codepoint))
+    return ranges
+
+
+def go_range_func(name, prop_value, ranges):
+    output = "// " + name + " returns whether the test of East_Asian_Width = " + prop_value + "\n"
+    output += "// evaluates to true.\n"
+    output += "//\n"
+    output += "// Data for this function was generated from the East_Asian_Width property\n"
+    output += "// in version " + unicodedata.unidata_version + " of the Unicode Character Database by\n"
+    output += "// scripts/get_unicode_east_asian_width.py, and is used under the terms of the\n"
+    output += "// Unicode License, available at the following address:\n"
+    output += "// https://www.unicode.org/license.html\n"
+    output += "func " + name + "(r rune) bool {\n"
+    output += "\treturn "
+    conds = list()
+    for start, end in ranges:
+        if start == end:
+            conds.append("(r == 0x%04X)" % start)
+        else:
+            conds.append("(0x%04X <= r && r <= 0x%04X)" % (start, end))
+    output += " ||\n\t\t".join(conds)
+    output += "\n}\n"
+    return output
+
+
+def main():
+    print("Analyzing East_Asian_Width for Unicode " + unicodedata.unidata_version + "...", file=sys.stderr)
+
+    def is_wide(cp):
+        ch = chr(cp)
+        if unicodedata.category(ch) == 'Cn':
+            # unassigned in this version; only the reserved CJK blocks are
+            # treated as wide, as specified in EastAsianWidth.txt.
+            return (0x20000 <= cp <= 0x2FFFD) or (0x30000 <= cp <= 0x3FFFD) or (0x3400 <= cp <= 0x4DBF) or (0x4E00 <= cp <= 0x9FFF) or (0xF900 <= cp <= 0xFAFF)
+        return unicodedata.east_asian_width(ch) == 'W'
+
+    def is_fullwidth(cp):
+        return unicodedata.east_asian_width(chr(cp)) == 'F'
+
+    def is_halfwidth(cp):
+        return unicodedata.east_asian_width(chr(cp)) == 'H'
+
+    print("// Code generated by scripts/get_unicode_east_asian_width.py. DO NOT EDIT.")
+    print("")
+    print("package gem")
+    print("")
+    print(go_range_func("isEawWide", "Wide", ranges_where(is_wide)))
+    print(go_range_func("isEawFullwidth", "Fullwidth", ranges_where(is_fullwidth)))
+    print(go_range_func("isEawHalfwidth", "Halfwidth", ranges_where(is_halfwidth)))
+
+
+if __name__ == "__main__":
+    main()
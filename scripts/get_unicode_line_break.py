@@ -0,0 +1,76 @@
+# generates the Line_Break property table used by gem for finding line break
+# opportunities. Output is go source code.
+#
+# The unicodedata module in the python standard library does not include the
+# Line_Break property, so it is instead read from the copy of the UCD file
+# LineBreak.txt that ships with perl, by way of the Unicode::UCD module. Default
+# values for unassigned code points are included in the data it provides.
+
+import subprocess
+import sys
+
+_perl_prog = '''
+use Unicode::UCD qw(prop_invmap);
+my ($list, $map) = prop_invmap("Line_Break");
+print Unicode::UCD::UnicodeVersion(), "\\n";
+for my $i (0 .. $#$list) {
+    printf "%d %s\\n", $list->[$i], $map->[$i];
+}
+'''
+
+# classes from UAX #14 Table 1 that are given a name in the go output. the
+# order here is the order they are declared in.
+_classes = [
+    "XX", "BK", "CR", "LF", "NL", "CM", "ZWJ", "SG", "WJ", "ZW", "GL", "SP",
+    "B2", "BA", "BB", "HY", "CB", "CL", "CP", "EX", "IN", "NS", "OP", "QU",
+    "IS", "NU", "PO", "PR", "SY", "AI", "AL", "CJ", "EB", "EM", "H2", "H3",
+    "HL", "ID", "JL", "JV", "JT", "RI", "SA",
+]
+
+_perl_names = {
+    "Unknown": "XX",
+}
+
+
+def main():
+    out = subprocess.run(["perl", "-e", _perl_prog], check=True, capture_output=True, text=True).stdout
+    lines = out.splitlines()
+    version = lines[0]
+
+    print("Analyzing Line_Break for Unicode " + version + "...", file=sys.stderr)
+
+    starts = list()
+    for line in lines[1:]:
+        start, value = line.split(" ")
+        value = _perl_names.get(value, value)
+        if value not in _classes:
+            raise ValueError("unknown Line_Break value: " + value)
+        starts.append((int(start), value))
+
+    ranges = list()
+    for i, (start, value) in enumerate(starts):
+        end = starts[i + 1][0] - 1 if i + 1 < len(starts) else 0x10FFFF
+        if value == "XX":
+            continue
+        ranges.append((start, end, value))
+
+    print("// Code generated by scripts/get_unicode_line_break.py. DO NOT EDIT.")
+    print("")
+    print("package gem")
+    print("")
+    print("// lineBreakRanges gives the Line_Break property value of every code point")
+    print("// that does not have the value XX (Unknown). It is sorted by start of range.")
+    print("//")
+    print("// Data for lineBreakRanges was generated from the Line_Break property in")
+    print("// version " + version + " of the Unicode Character Database by")
+    print("// scripts/get_unicode_line_break.py, and is used under the terms of the")
+    print("// Unicode License, available at the following address:")
+    print("// https://www.unicode.org/license.html")
+    print("var lineBreakRanges = []lbRange{")
+    for start, end, value in ranges:
+        print("\t{0x%04X, 0x%04X, lb%s}," % (start, end, value))
+    print("}")
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,118 @@
+# generates the decomposition, composition, and canonical combining class data
+# used by gem for Unicode normalization. Output is go source code.
+#
+# Data is taken from the unicodedata module in the python standard library,
+# which is built from the UCD file UnicodeData.txt (and CompositionExclusions.txt
+# for determining primary composites) for the version of Unicode that the
+# running python supports.
+
+import sys
+import unicodedata
+
+_max_codepoint = 0x10FFFF
+
+# Hangul syllables are decomposed and composed algorithmically and are not
+# included in the tables.
+_hangul_s_base = 0xAC00
+_hangul_s_count = 11172
+
+
+def license_comment(name, prop):
+    output = "// Data for " + name + " was generated from the " + prop + "\n"
+    output += "// in version " + unicodedata.unidata_version + " of the Unicode Character Database by\n"
+    output += "// scripts/get_unicode_normalization.py, and is used under the terms of the\n"
+    output += "// Unicode License, available at the following address:\n"
+    output += "// https://www.unicode.org/license.html\n"
+    return output
+
+
+def go_rune_list(runes):
+    return "{" + ", ".join("0x%04X" % r for r in runes) + "}"
+
+
+def main():
+    print("Analyzing normalization data for Unicode " + unicodedata.unidata_version + "...", file=sys.stderr)
+
+    canonical = list()
+    compat = list()
+    classes = list()
+    compositions = list()
+
+    for cp in range(_max_codepoint + 1):
+        if _hangul_s_base <= cp < _hangul_s_base + _hangul_s_count:
+            continue
+
+        ch = chr(cp)
+
+        ccc = unicodedata.combining(ch)
+        if ccc != 0:
+            classes.append((cp, ccc))
+
+        decomp = unicodedata.decomposition(ch)
+        if decomp == "":
+            continue
+
+        parts = decomp.split(" ")
+        if parts[0].startswith("<"):
+            compat.append((cp, [int(p, 16) for p in parts[1:]]))
+            continue
+
+        mapping = [int(p, 16) for p in parts]
+        canonical.append((cp, mapping))
+
+        # a character is a primary composite if it has a canonical
+        # decomposition of two characters and is not excluded from
+        # composition, which is exactly when NFC leaves it unchanged.
+        if len(mapping) == 2 and unicodedata.normalize("NFC", ch) == ch:
+            compositions.append((mapping, cp))
+
+    print("// Code generated by scripts/get_unicode_normalization.py. DO NOT EDIT.")
+    print("")
+    print("package gem")
+    print("")
+
+    print("// canonicalDecompositions maps characters to their canonical decomposition")
+    print("// mapping. Mappings are not fully decomposed; the mapped characters may")
+    print("// themselves have a mapping.")
+    print("//")
+    print(license_comment("canonicalDecompositions", "Decomposition_Mapping property"), end="")
+    print("var canonicalDecompositions = map[rune][]rune{")
+    for cp, mapping in canonical:
+        print("\t0x%04X: %s," % (cp, go_rune_list(mapping)))
+    print("}")
+    print("")
+
+    print("// compatibilityDecompositions maps characters to their compatibility")
+    print("// decomposition mapping. Characters that only have a canonical decomposition")
+    print("// are not included. Mappings are not fully decomposed; the mapped characters")
+    print("// may themselves have a mapping.")
+    print("//")
+    print(license_comment("compatibilityDecompositions", "Decomposition_Mapping property"), end="")
+    print("var compatibilityDecompositions = map[rune][]rune{")
+    for cp, mapping in compat:
+        print("\t0x%04X: %s," % (cp, go_rune_list(mapping)))
+    print("}")
+    print("")
+
+    print("// primaryComposites maps pairs of characters to the primary composite that")
+    print("// they canonically compose to.")
+    print("//")
+    print(license_comment("primaryComposites", "Decomposition_Mapping property"), end="")
+    print("var primaryComposites = map[[2]rune]rune{")
+    for mapping, cp in compositions:
+        print("\t{0x%04X, 0x%04X}: 0x%04X," % (mapping[0], mapping[1], cp))
+    print("}")
+    print("")
+
+    print("// combiningClasses maps characters to their canonical combining class. Only")
+    print("// characters with a non-zero class are included.")
+    print("//")
+    print(license_comment("combiningClasses", "Canonical_Combining_Class property"), end="")
+    print("var combiningClasses = map[rune]uint8{")
+    for cp, ccc in classes:
+        print("\t0x%04X: %d," % (cp, ccc))
+    print("}")
+
+
+if __name__ == "__main__":
+    main()
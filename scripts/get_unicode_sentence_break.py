@@ -0,0 +1,84 @@
+# generates the Sentence_Break property table used by gem for finding sentence
+# boundaries. Output is go source code.
+#
+# The unicodedata module in the python standard library does not include the
+# Sentence_Break property, so it is instead read from the copy of the UCD file
+# SentenceBreakProperty.txt that ships with perl, by way of the Unicode::UCD
+# module. Each value is requested individually in the same way as for
+# Word_Break in get_unicode_word_break.py.
+
+import subprocess
+import sys
+
+_perl_prog = '''
+use Unicode::UCD qw(prop_invlist);
+print Unicode::UCD::UnicodeVersion(), "\\n";
+for my $value (@ARGV) {
+    my @list = prop_invlist("Sentence_Break=$value");
+    for (my $i = 0; $i < @list; $i += 2) {
+        my $end = $i + 1 < @list ? $list[$i + 1] - 1 : 0x10FFFF;
+        printf "%d %d %s\\n", $list[$i], $end, $value;
+    }
+}
+'''
+
+# values from UAX #29 Table 4, "Sentence_Break Property Values", mapped to the name
+# they are given in the go output. the order here is the order they are
+# declared in. Other is not requested, as it is the default value.
+_values = [
+    ("CR", "CR"),
+    ("LF", "LF"),
+    ("Extend", "Extend"),
+    ("Sep", "Sep"),
+    ("Format", "Format"),
+    ("Sp", "Sp"),
+    ("Lower", "Lower"),
+    ("Upper", "Upper"),
+    ("OLetter", "OLetter"),
+    ("Numeric", "Numeric"),
+    ("ATerm", "ATerm"),
+    ("SContinue", "SContinue"),
+    ("STerm", "STerm"),
+    ("Close", "Close"),
+]
+
+
+def main():
+    names = dict(_values)
+    args = [v for v, _ in _values]
+    out = subprocess.run(["perl", "-e", _perl_prog] + args, check=True, capture_output=True, text=True).stdout
+    lines = out.splitlines()
+    version = lines[0]
+
+    print("Analyzing Sentence_Break for Unicode " + version + "...", file=sys.stderr)
+
+    ranges = list()
+    for line in lines[1:]:
+        start, end, value = line.split(" ")
+        ranges.append((int(start), int(end), names[value]))
+    ranges.sort()
+
+    for i in range(1, len(ranges)):
+        if ranges[i][0] <= ranges[i - 1][1]:
+            raise ValueError("overlapping Sentence_Break ranges at 0x%04X" % ranges[i][0])
+
+    print("// Code generated by scripts/get_unicode_sentence_break.py. DO NOT EDIT.")
+    print("")
+    print("package gem")
+    print("")
+    print("// sentenceBreakRanges gives the Sentence_Break property value of every code")
+    print("// point that does not have the value Other. It is sorted by start of range.")
+    print("//")
+    print("// Data for sentenceBreakRanges was generated from the Sentence_Break property")
+    print("// in version " + version + " of the Unicode Character Database by")
+    print("// scripts/get_unicode_sentence_break.py, and is used under the terms of the")
+    print("// Unicode License, available at the following address:")
+    print("// https://www.unicode.org/license.html")
+    print("var sentenceBreakRanges = []sbRange{")
+    for start, end, value in ranges:
+        print("\t{0x%04X, 0x%04X, sb%s}," % (start, end, value))
+    print("}")
+
+
+if __name__ == "__main__":
+    main()
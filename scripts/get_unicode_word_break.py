@@ -0,0 +1,88 @@
+# generates the Word_Break property table used by gem for finding word
+# boundaries. Output is go source code.
+#
+# The unicodedata module in the python standard library does not include the
+# Word_Break property, so it is instead read from the copy of the UCD file
+# WordBreakProperty.txt that ships with perl, by way of the Unicode::UCD module.
+# Each value is requested individually, as the full property map that perl
+# provides for Word_Break includes perl-specific tailorings.
+
+import subprocess
+import sys
+
+_perl_prog = '''
+use Unicode::UCD qw(prop_invlist);
+print Unicode::UCD::UnicodeVersion(), "\\n";
+for my $value (@ARGV) {
+    my @list = prop_invlist("Word_Break=$value");
+    for (my $i = 0; $i < @list; $i += 2) {
+        my $end = $i + 1 < @list ? $list[$i + 1] - 1 : 0x10FFFF;
+        printf "%d %d %s\\n", $list[$i], $end, $value;
+    }
+}
+'''
+
+# values from UAX #29 Table 3, "Word_Break Property Values", mapped to the name
+# they are given in the go output. the order here is the order they are
+# declared in. Other is not requested, as it is the default value.
+_values = [
+    ("CR", "CR"),
+    ("LF", "LF"),
+    ("Newline", "Newline"),
+    ("Extend", "Extend"),
+    ("ZWJ", "ZWJ"),
+    ("Regional_Indicator", "RegionalIndicator"),
+    ("Format", "Format"),
+    ("Katakana", "Katakana"),
+    ("Hebrew_Letter", "HebrewLetter"),
+    ("ALetter", "ALetter"),
+    ("Single_Quote", "SingleQuote"),
+    ("Double_Quote", "DoubleQuote"),
+    ("MidNumLet", "MidNumLet"),
+    ("MidLetter", "MidLetter"),
+    ("MidNum", "MidNum"),
+    ("Numeric", "Numeric"),
+    ("ExtendNumLet", "ExtendNumLet"),
+    ("WSegSpace", "WSegSpace"),
+]
+
+
+def main():
+    names = dict(_values)
+    args = [v for v, _ in _values]
+    out = subprocess.run(["perl", "-e", _perl_prog] + args, check=True, capture_output=True, text=True).stdout
+    lines = out.splitlines()
+    version = lines[0]
+
+    print("Analyzing Word_Break for Unicode " + version + "...", file=sys.stderr)
+
+    ranges = list()
+    for line in lines[1:]:
+        start, end, value = line.split(" ")
+        ranges.append((int(start), int(end), names[value]))
+    ranges.sort()
+
+    for i in range(1, len(ranges)):
+        if ranges[i][0] <= ranges[i - 1][1]:
+            raise ValueError("overlapping Word_Break ranges at 0x%04X" % ranges[i][0])
+
+    print("// Code generated by scripts/get_unicode_word_break.py. DO NOT EDIT.")
+    print("")
+    print("package gem")
+    print("")
+    print("// wordBreakRanges gives the Word_Break property value of every code point")
+    print("// that does not have the value Other. It is sorted by start of range.")
+    print("//")
+    print("// Data for wordBreakRanges was generated from the Word_Break property in")
+    print("// version " + version + " of the Unicode Character Database by")
+    print("// scripts/get_unicode_word_break.py, and is used under the terms of the")
+    print("// Unicode License, available at the following address:")
+    print("// https://www.unicode.org/license.html")
+    print("var wordBreakRanges = []wbRange{")
+    for start, end, value in ranges:
+        print("\t{0x%04X, 0x%04X, wb%s}," % (start, end, value))
+    print("}")
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,349 @@
+package rosed
+
+// this file contains the functions for reading the data of a table out of a
+// slice of structs or maps by reflection.
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// structTag is the key of the struct tag that sets how a field is shown in a
+// table created by InsertStructTable.
+const structTag = "rosed"
+
+// structColumn is a column of a table that is created from a slice of structs
+// or maps.
+type structColumn struct {
+	// header is the text of the header of the column.
+	header string
+
+	// index is the index sequence of the field that the column shows for
+	// structs, as given to reflect.Value.FieldByIndex.
+	index []int
+
+	// key is the key that the column shows for maps.
+	key reflect.Value
+
+	// align is the alignment that the column is given by its struct tag, or
+	// None if it is not given one.
+	align Alignment
+
+	// width is the width constraint that the column is given by its struct
+	// tag.
+	width ColumnWidth
+
+	// order is the place of the column relative to the others as given by its
+	// struct tag.
+	order int
+}
+
+// structTableData reads the rows of a table out of slice, which must be a slice
+// or array of structs, of pointers to structs, or of maps. The first row of the
+// returned data is the header. The returned Options are opts with the
+// alignments and column widths given by struct tags set in them.
+func structTableData(slice interface{}, opts Options) ([][]string, Options, error) {
+	sv := reflect.ValueOf(slice)
+	if sv.Kind() == reflect.Ptr && !sv.IsNil() {
+		sv = sv.Elem()
+	}
+	if sv.Kind() != reflect.Slice && sv.Kind() != reflect.Array {
+		return nil, opts, fmt.Errorf("cannot create table from %T: not a slice or array", slice)
+	}
+
+	elemType := sv.Type().Elem()
+	for elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+
+	var cols []structColumn
+	var err error
+	switch elemType.Kind() {
+	case reflect.Struct:
+		cols, err = structColumns(elemType, nil)
+		if err != nil {
+			return nil, opts, err
+		}
+		sort.SliceStable(cols, func(i, j int) bool {
+			return cols[i].order < cols[j].order
+		})
+	case reflect.Map:
+		cols = mapColumns(sv)
+	default:
+		return nil, opts, fmt.Errorf("cannot create table from %T: elements are not structs or maps", slice)
+	}
+
+	if len(cols) < 1 {
+		return nil, opts, nil
+	}
+
+	header := make([]string, len(cols))
+	for i := range cols {
+		header[i] = cols[i].header
+	}
+	data := [][]string{header}
+
+	for i := 0; i < sv.Len(); i++ {
+		elem := sv.Index(i)
+		for elem.Kind() == reflect.Ptr || elem.Kind() == reflect.Interface {
+			elem = elem.Elem()
+		}
+
+		row := make([]string, len(cols))
+		for c := range cols {
+			if v, ok := cols[c].value(elem); ok {
+				row[c] = formatValue(cols[c].header, v, opts.TableValueFormatter)
+			}
+		}
+		data = append(data, row)
+	}
+
+	opts.TableAlignments = NewAlignments(structAlignments(cols, opts.TableAlignments.Slice())...)
+	opts.TableColumnWidths = NewColumnWidths(structColumnWidths(cols, opts.TableColumnWidths.Slice())...)
+
+	return data, opts, nil
+}
+
+// structColumns returns the columns for the exported fields of the struct type
+// t. index is the index sequence of t within the struct that the fields are
+// read from, or nil if it is that struct. The fields of embedded structs that
+// are not given a name by their struct tag are included as though they were
+// fields of t.
+func structColumns(t reflect.Type, index []int) ([]structColumn, error) {
+	var cols []structColumn
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldIndex := make([]int, len(index)+1)
+		copy(fieldIndex, index)
+		fieldIndex[len(index)] = i
+
+		tag, hasTag := field.Tag.Lookup(structTag)
+		if tag == "-" {
+			continue
+		}
+
+		col := structColumn{header: field.Name, index: fieldIndex}
+		parts := strings.Split(tag, ",")
+		if hasTag && parts[0] != "" {
+			col.header = parts[0]
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && fieldType.Kind() == reflect.Struct && (!hasTag || parts[0] == "") {
+			embedded, err := structColumns(fieldType, fieldIndex)
+			if err != nil {
+				return nil, err
+			}
+			cols = append(cols, embedded...)
+			continue
+		}
+
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+
+		for _, opt := range parts[1:] {
+			if err := col.setTagOption(opt); err != nil {
+				return nil, fmt.Errorf("field %s: %w", field.Name, err)
+			}
+		}
+		cols = append(cols, col)
+	}
+
+	return cols, nil
+}
+
+// setTagOption sets the property of col that is given by opt, an option from a
+// struct tag in the form "name=value".
+func (col *structColumn) setTagOption(opt string) error {
+	opt = strings.TrimSpace(opt)
+	if opt == "" {
+		return nil
+	}
+
+	eq := strings.Index(opt, "=")
+	if eq < 0 {
+		return fmt.Errorf("tag option %q is not in the form name=value", opt)
+	}
+	name, value := opt[:eq], opt[eq+1:]
+
+	if name == "align" {
+		switch strings.ToLower(value) {
+		case "left":
+			col.align = Left
+		case "right":
+			col.align = Right
+		case "center":
+			col.align = Center
+		case "decimal":
+			col.align = Decimal
+		default:
+			return fmt.Errorf("unknown alignment %q", value)
+		}
+		return nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("tag option %s: %q is not an integer", name, value)
+	}
+	switch name {
+	case "width":
+		col.width.Fixed = n
+	case "min":
+		col.width.Min = n
+	case "max":
+		col.width.Max = n
+	case "order":
+		col.order = n
+	default:
+		return fmt.Errorf("unknown tag option %q", name)
+	}
+	return nil
+}
+
+// mapColumns returns the columns for the keys of the maps in sv, which is a
+// slice or array of maps. There is a column for each key that is in any of the
+// maps, in order of the keys as formatted with fmt.Sprint.
+func mapColumns(sv reflect.Value) []structColumn {
+	seen := map[string]bool{}
+	var cols []structColumn
+
+	for i := 0; i < sv.Len(); i++ {
+		elem := sv.Index(i)
+		for elem.Kind() == reflect.Ptr || elem.Kind() == reflect.Interface {
+			elem = elem.Elem()
+		}
+		if elem.Kind() != reflect.Map {
+			continue
+		}
+
+		for _, key := range elem.MapKeys() {
+			header := fmt.Sprint(key.Interface())
+			if seen[header] {
+				continue
+			}
+			seen[header] = true
+			cols = append(cols, structColumn{header: header, key: key})
+		}
+	}
+
+	sort.Slice(cols, func(i, j int) bool {
+		return cols[i].header < cols[j].header
+	})
+	return cols
+}
+
+// value returns the value that col shows for elem, which is a struct or a map.
+// If elem does not have a value for col, such as when the field is in an
+// embedded struct pointer that is nil or the key is not in the map, the
+// returned bool is false.
+func (col structColumn) value(elem reflect.Value) (reflect.Value, bool) {
+	switch elem.Kind() {
+	case reflect.Struct:
+		v := elem
+		for _, i := range col.index {
+			for v.Kind() == reflect.Ptr {
+				if v.IsNil() {
+					return reflect.Value{}, false
+				}
+				v = v.Elem()
+			}
+			v = v.Field(i)
+		}
+		return v, true
+	case reflect.Map:
+		if !col.key.IsValid() || !col.key.Type().AssignableTo(elem.Type().Key()) {
+			return reflect.Value{}, false
+		}
+		v := elem.MapIndex(col.key)
+		return v, v.IsValid()
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// formatValue returns the text that v is shown as in the column with the given
+// header. If vf points to a function, it gives the text. Otherwise, a value
+// that implements fmt.Stringer or error is shown as the string that it gives,
+// a nil pointer or interface is shown as the empty string, and any other value
+// is shown as formatted by fmt.Sprint.
+func formatValue(header string, v reflect.Value, vf *ValueFormatter) string {
+	if vf != nil && *vf != nil {
+		var value interface{}
+		if v.CanInterface() {
+			value = v.Interface()
+		}
+		return (*vf)(header, value)
+	}
+
+	for {
+		if !v.IsValid() || !v.CanInterface() {
+			return ""
+		}
+		if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+			return ""
+		}
+
+		switch value := v.Interface().(type) {
+		case fmt.Stringer:
+			return value.String()
+		case error:
+			return value.Error()
+		}
+		if v.CanAddr() {
+			if s, ok := v.Addr().Interface().(fmt.Stringer); ok {
+				return s.String()
+			}
+		}
+
+		if v.Kind() != reflect.Ptr && v.Kind() != reflect.Interface {
+			return fmt.Sprint(v.Interface())
+		}
+		v = v.Elem()
+	}
+}
+
+// structAlignments returns aligns with the alignment of each of cols that is
+// given by its struct tag set in it.
+func structAlignments(cols []structColumn, aligns []Alignment) []Alignment {
+	merged := make([]Alignment, len(aligns))
+	copy(merged, aligns)
+
+	for i := range cols {
+		if cols[i].align == None {
+			continue
+		}
+		for len(merged) <= i {
+			merged = append(merged, None)
+		}
+		merged[i] = cols[i].align
+	}
+	return merged
+}
+
+// structColumnWidths returns widths with the width constraint of each of cols
+// that is given by its struct tag set in it.
+func structColumnWidths(cols []structColumn, widths []ColumnWidth) []ColumnWidth {
+	merged := make([]ColumnWidth, len(widths))
+	copy(merged, widths)
+
+	for i := range cols {
+		if cols[i].width == (ColumnWidth{}) {
+			continue
+		}
+		for len(merged) <= i {
+			merged = append(merged, ColumnWidth{})
+		}
+		merged[i] = cols[i].width
+	}
+	return merged
+}
@@ -167,8 +167,10 @@ func (ed Editor) CommitAll() Editor {
 
 // IsSubEditor returns whether the Editor was created to edit a sub-set of the
 // text in some parent editor. Calls to [Editor.Lines], [Editor.LinesFrom],
-// [Editor.LinesTo], [Editor.Chars], [Editor.CharsFrom], and [Editor.CharsTo]
-// will result in such an Editor.
+// [Editor.LinesTo], [Editor.Chars], [Editor.CharsFrom], [Editor.CharsTo],
+// [Editor.Sentences], [Editor.SentencesFrom], [Editor.SentencesTo],
+// [Editor.Words], [Editor.WordsFrom], and [Editor.WordsTo] will result in such
+// an Editor.
 //
 // If IsSubEditor returns true, then Editor.Text may be set to an incomplete
 // subset of the original text. To get the full text from a sub-editor, use
@@ -291,6 +293,70 @@ func (ed Editor) LinesTo(end int) Editor {
 	return ed.Lines(0, end)
 }
 
+// Sentences produces an Editor to operate on a subset of the sentences in the
+// Editor's text. The returned Editor operates on text from the start of the nth
+// sentence up to the end of the (i-1)th sentence, where n is start and i is
+// end. Any whitespace between those sentences is included, but any that comes
+// before the first sentence or after the last sentence is not.
+//
+// Sentences are found using the sentence boundaries given by the Unicode Text
+// Segmentation algorithm in UAX #29. This means that a full stop in an
+// abbreviation or a decimal number, such as in "e.g. this" or "3.50", does not
+// end a sentence, and closing quotation marks and brackets after the end of a
+// sentence are kept with it. Whitespace that comes between two sentences is
+// not a part of either one.
+//
+// Each paragraph is split into sentences on its own, so the end of a paragraph
+// always ends a sentence. Within a paragraph, each LineSeparator is treated as
+// a space, so a sentence goes on across the lines of text that has been
+// wrapped.
+//
+// Note that an abbreviation followed by a capitalized word, such as in
+// "Mr. Smith", cannot be told apart from the end of a sentence and is treated
+// as one.
+//
+// The start or end parameter may be negative, in which case it will be relative
+// to the end of the text; -1 would be the index of the last sentence, -2 would
+// be the index of the second-to-last sentence, etc.
+//
+// If one of the parameters specifies an index that is past the end of the
+// sentences, that index is assumed to be the end of the sentences. If either
+// specify an index that is before the first sentence, it is assumed to be 0.
+//
+// If end is less than start, it is assumed to be equal to start; the returned
+// Editor will then operate on the empty string at the start of the nth
+// sentence.
+//
+// This is a Sub-Editor function. See the note on [Editor] for more info.
+//
+// This function is affected by the following [Options]:
+//
+//   - LineSeparator is treated as a space within paragraphs.
+//   - ParagraphSeparator specifies the string that paragraphs are split by.
+func (ed Editor) Sentences(start, end int) Editor {
+	return ed.segmentsSubEd(ed.sentenceIndexes(), start, end)
+}
+
+// SentencesFrom produces an Editor to operate on a subset of the sentences in
+// the Editor's text. The returned Editor operates on text from the start of the
+// nth sentence up to the end of the last sentence, where n is start.
+//
+// Calling this function is identical to calling [Editor.Sentences] with the
+// given start and with end set to the end of the sentences.
+func (ed Editor) SentencesFrom(start int) Editor {
+	return ed.Sentences(start, End)
+}
+
+// SentencesTo produces an Editor to operate on a subset of the sentences in the
+// Editor's text. The returned Editor operates on text from the start of the
+// first sentence up to the end of the (n-1)th sentence, where n is end.
+//
+// Calling this function is identical to calling [Editor.Sentences] with the
+// given end and with start set to the first sentence.
+func (ed Editor) SentencesTo(end int) Editor {
+	return ed.Sentences(0, end)
+}
+
 // String returns the finished, fully edited string. If the Editor is a
 // sub-editor, CommitAll() is called first and Editor.Text from the resulting
 // editor is returned; otherwise, the current Editor's Text is returned.
@@ -302,6 +368,84 @@ func (ed Editor) String() string {
 	return ed.Text
 }
 
+// Words produces an Editor to operate on a subset of the words in the Editor's
+// text. The returned Editor operates on text from the start of the nth word up
+// to the end of the (i-1)th word, where n is start and i is end. Any spaces or
+// punctuation between those words are included, but any that come before the
+// first word or after the last word are not.
+//
+// Words are found using the word boundaries given by the Unicode Text
+// Segmentation algorithm in UAX #29. A word is any text between two word
+// boundaries that contains at least one letter or number; the spaces and
+// punctuation between words are not counted as words themselves. As a result,
+// contractions such as "can't" and numbers such as "3,456.78" are each treated
+// as a single word.
+//
+// The start or end parameter may be negative, in which case it will be relative
+// to the end of the text; -1 would be the index of the last word, -2 would be
+// the index of the second-to-last word, etc.
+//
+// If one of the parameters specifies an index that is past the end of the
+// words, that index is assumed to be the end of the words. If either specify an
+// index that is before the first word, it is assumed to be 0.
+//
+// If end is less than start, it is assumed to be equal to start; the returned
+// Editor will then operate on the empty string at the start of the nth word.
+//
+// This is a Sub-Editor function. See the note on [Editor] for more info.
+func (ed Editor) Words(start, end int) Editor {
+	return ed.segmentsSubEd(ed.wordIndexes(), start, end)
+}
+
+// WordsFrom produces an Editor to operate on a subset of the words in the
+// Editor's text. The returned Editor operates on text from the start of the nth
+// word up to the end of the last word, where n is start.
+//
+// Calling this function is identical to calling [Editor.Words] with the given
+// start and with end set to the end of the words.
+func (ed Editor) WordsFrom(start int) Editor {
+	return ed.Words(start, End)
+}
+
+// WordsTo produces an Editor to operate on a subset of the words in the
+// Editor's text. The returned Editor operates on text from the start of the
+// first word up to the end of the (n-1)th word, where n is end.
+//
+// Calling this function is identical to calling [Editor.Words] with the given
+// end and with start set to the first word.
+func (ed Editor) WordsTo(end int) Editor {
+	return ed.Words(0, end)
+}
+
+// gets a sub-editor that spans from the start of the segment at index start up
+// to the end of the segment at index end-1, where indexes holds the byte start
+// and end of every segment in the text. start and end are interpreted the same
+// as they are for Lines and Chars.
+func (ed Editor) segmentsSubEd(indexes [][]int, start, end int) Editor {
+	if start == End {
+		start = len(indexes)
+	}
+	if end == End {
+		end = len(indexes)
+	}
+
+	start, end = util.RangeToIndexes(len(indexes), start, end)
+
+	// if we know we are about to get past the end of the segments, skip the
+	// search and just get the end
+	if start >= len(indexes) {
+		return ed.subEd(len(ed.Text), len(ed.Text))
+	}
+
+	byteStart := indexes[start][0]
+	byteEnd := byteStart
+	if end > start {
+		byteEnd = indexes[end-1][1]
+	}
+
+	return ed.subEd(byteStart, byteEnd)
+}
+
 func (ed Editor) subEd(start, end int) Editor {
 	subEd := ed
 	subEd.ref = &parentRef{
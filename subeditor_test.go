@@ -1199,3 +1199,525 @@ func Test_Editor_LinesTo(t *testing.T) {
 		})
 	}
 }
+
+func Test_Editor_Sentences(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ed     Editor
+		start  int
+		end    int
+		expect Editor
+	}{
+		{
+			name: "typical subsection",
+			ed: Editor{
+				Text: "One. Two? Three! Four.",
+			},
+			start: 1,
+			end:   3,
+			expect: Editor{
+				Text: "Two? Three!",
+			},
+		},
+		{
+			name: "abbreviations and decimals",
+			ed: Editor{
+				Text: "See e.g. the docs. It costs 3.50 dollars. Buy it.",
+			},
+			start: 0,
+			end:   2,
+			expect: Editor{
+				Text: "See e.g. the docs. It costs 3.50 dollars.",
+			},
+		},
+		{
+			name: "closing quote is kept",
+			ed: Editor{
+				Text: "He said \"Stop.\" Then he left.",
+			},
+			start: 0,
+			end:   1,
+			expect: Editor{
+				Text: "He said \"Stop.\"",
+			},
+		},
+		{
+			name: "surrounding whitespace is excluded",
+			ed: Editor{
+				Text: "  One.  Two.\n",
+			},
+			start: 0,
+			end:   End,
+			expect: Editor{
+				Text: "One.  Two.",
+			},
+		},
+		{
+			name: "sentence goes across wrapped lines",
+			ed: Editor{
+				Text: "This is a wrapped\nparagraph that goes on. It has\ntwo sentences.",
+			},
+			start: 0,
+			end:   1,
+			expect: Editor{
+				Text: "This is a wrapped\nparagraph that goes on.",
+			},
+		},
+		{
+			name: "paragraph separator ends sentence",
+			ed: Editor{
+				Text: "No terminator\n\nNext paragraph",
+			},
+			start: 1,
+			end:   2,
+			expect: Editor{
+				Text: "Next paragraph",
+			},
+		},
+		{
+			name: "end > number of sentences",
+			ed: Editor{
+				Text: "One. Two? Three!",
+			},
+			start: 1,
+			end:   20,
+			expect: Editor{
+				Text: "Two? Three!",
+			},
+		},
+		{
+			name: "start < 0",
+			ed: Editor{
+				Text: "One. Two? Three!",
+			},
+			start: -2,
+			end:   End,
+			expect: Editor{
+				Text: "Two? Three!",
+			},
+		},
+		{
+			name: "both < 0",
+			ed: Editor{
+				Text: "One. Two? Three!",
+			},
+			start: -3,
+			end:   -1,
+			expect: Editor{
+				Text: "One. Two?",
+			},
+		},
+		{
+			name: "start > end",
+			ed: Editor{
+				Text: "One. Two? Three!",
+			},
+			start: 2,
+			end:   1,
+			expect: Editor{
+				Text: "",
+			},
+		},
+		{
+			name: "start past last sentence",
+			ed: Editor{
+				Text: "One. Two? Three!",
+			},
+			start: 3,
+			end:   End,
+			expect: Editor{
+				Text: "",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := tc.ed.Sentences(tc.start, tc.end)
+
+			// don't do a full Equal as that will compare unexported
+			// fields; instead just check the ones we care about
+
+			assert.Equal(tc.expect.Options, actual.Options)
+			assert.Equal(tc.expect.Text, actual.Text)
+		})
+	}
+}
+
+func Test_Editor_SentencesFrom(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ed     Editor
+		start  int
+		expect Editor
+	}{
+		{
+			name: "from middle",
+			ed: Editor{
+				Text: "One. Two? Three!",
+			},
+			start: 1,
+			expect: Editor{
+				Text: "Two? Three!",
+			},
+		},
+		{
+			name: "from negative",
+			ed: Editor{
+				Text: "One. Two? Three!\n",
+			},
+			start: -1,
+			expect: Editor{
+				Text: "Three!",
+			},
+		},
+		{
+			name: "from past end",
+			ed: Editor{
+				Text: "One. Two? Three!",
+			},
+			start: 5,
+			expect: Editor{
+				Text: "",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := tc.ed.SentencesFrom(tc.start)
+
+			// don't do a full Equal as that will compare unexported
+			// fields; instead just check the ones we care about
+
+			assert.Equal(tc.expect.Options, actual.Options)
+			assert.Equal(tc.expect.Text, actual.Text)
+		})
+	}
+}
+
+func Test_Editor_SentencesTo(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ed     Editor
+		end    int
+		expect Editor
+	}{
+		{
+			name: "to middle",
+			ed: Editor{
+				Text: "One. Two? Three!",
+			},
+			end: 2,
+			expect: Editor{
+				Text: "One. Two?",
+			},
+		},
+		{
+			name: "to negative",
+			ed: Editor{
+				Text: "  One. Two? Three!",
+			},
+			end: -2,
+			expect: Editor{
+				Text: "One.",
+			},
+		},
+		{
+			name: "to zero",
+			ed: Editor{
+				Text: "One. Two? Three!",
+			},
+			end: 0,
+			expect: Editor{
+				Text: "",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := tc.ed.SentencesTo(tc.end)
+
+			// don't do a full Equal as that will compare unexported
+			// fields; instead just check the ones we care about
+
+			assert.Equal(tc.expect.Options, actual.Options)
+			assert.Equal(tc.expect.Text, actual.Text)
+		})
+	}
+}
+
+func Test_Editor_Words(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ed     Editor
+		start  int
+		end    int
+		expect Editor
+	}{
+		{
+			name: "typical subsection",
+			ed: Editor{
+				Text: "some testing text to edit",
+			},
+			start: 1,
+			end:   3,
+			expect: Editor{
+				Text: "testing text",
+			},
+		},
+		{
+			name: "punctuation between words is included",
+			ed: Editor{
+				Text: "Hello, world! How are you?",
+			},
+			start: 0,
+			end:   2,
+			expect: Editor{
+				Text: "Hello, world",
+			},
+		},
+		{
+			name: "punctuation around words is excluded",
+			ed: Editor{
+				Text: "\"Quoted\" text.",
+			},
+			start: 0,
+			end:   End,
+			expect: Editor{
+				Text: "Quoted\" text",
+			},
+		},
+		{
+			name: "apostrophes and numbers",
+			ed: Editor{
+				Text: "It can't cost 3,456.78 dollars",
+			},
+			start: 1,
+			end:   4,
+			expect: Editor{
+				Text: "can't cost 3,456.78",
+			},
+		},
+		{
+			name: "end > number of words",
+			ed: Editor{
+				Text: "one two three",
+			},
+			start: 1,
+			end:   20,
+			expect: Editor{
+				Text: "two three",
+			},
+		},
+		{
+			name: "start < 0",
+			ed: Editor{
+				Text: "one two three",
+			},
+			start: -2,
+			end:   End,
+			expect: Editor{
+				Text: "two three",
+			},
+		},
+		{
+			name: "both < 0",
+			ed: Editor{
+				Text: "one two three",
+			},
+			start: -3,
+			end:   -1,
+			expect: Editor{
+				Text: "one two",
+			},
+		},
+		{
+			name: "start > end",
+			ed: Editor{
+				Text: "one two three",
+			},
+			start: 2,
+			end:   1,
+			expect: Editor{
+				Text: "",
+			},
+		},
+		{
+			name: "start past last word",
+			ed: Editor{
+				Text: "one two three",
+			},
+			start: 3,
+			end:   End,
+			expect: Editor{
+				Text: "",
+			},
+		},
+		{
+			name: "no words",
+			ed: Editor{
+				Text: "... ?!",
+			},
+			start: 0,
+			end:   End,
+			expect: Editor{
+				Text: "",
+			},
+		},
+		{
+			name: "decomposed grapheme in word",
+			ed: Editor{
+				Text: "Franc\u0327ais est beau",
+			},
+			start: 0,
+			end:   1,
+			expect: Editor{
+				Text: "Franc\u0327ais",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := tc.ed.Words(tc.start, tc.end)
+
+			// don't do a full Equal as that will compare unexported
+			// fields; instead just check the ones we care about
+
+			assert.Equal(tc.expect.Options, actual.Options)
+			assert.Equal(tc.expect.Text, actual.Text)
+		})
+	}
+}
+
+func Test_Editor_WordsFrom(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ed     Editor
+		start  int
+		expect Editor
+	}{
+		{
+			name: "from middle",
+			ed: Editor{
+				Text: "some testing text to edit",
+			},
+			start: 3,
+			expect: Editor{
+				Text: "to edit",
+			},
+		},
+		{
+			name: "from start",
+			ed: Editor{
+				Text: "  some text  ",
+			},
+			start: 0,
+			expect: Editor{
+				Text: "some text",
+			},
+		},
+		{
+			name: "from negative",
+			ed: Editor{
+				Text: "one two three.",
+			},
+			start: -1,
+			expect: Editor{
+				Text: "three",
+			},
+		},
+		{
+			name: "from past end",
+			ed: Editor{
+				Text: "one two three",
+			},
+			start: 5,
+			expect: Editor{
+				Text: "",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := tc.ed.WordsFrom(tc.start)
+
+			// don't do a full Equal as that will compare unexported
+			// fields; instead just check the ones we care about
+
+			assert.Equal(tc.expect.Options, actual.Options)
+			assert.Equal(tc.expect.Text, actual.Text)
+		})
+	}
+}
+
+func Test_Editor_WordsTo(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ed     Editor
+		end    int
+		expect Editor
+	}{
+		{
+			name: "to middle",
+			ed: Editor{
+				Text: "some testing text to edit",
+			},
+			end: 2,
+			expect: Editor{
+				Text: "some testing",
+			},
+		},
+		{
+			name: "to end",
+			ed: Editor{
+				Text: "  some text  ",
+			},
+			end: End,
+			expect: Editor{
+				Text: "some text",
+			},
+		},
+		{
+			name: "to negative",
+			ed: Editor{
+				Text: "one, two, three.",
+			},
+			end: -1,
+			expect: Editor{
+				Text: "one, two",
+			},
+		},
+		{
+			name: "to zero",
+			ed: Editor{
+				Text: "one two three",
+			},
+			end: 0,
+			expect: Editor{
+				Text: "",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := tc.ed.WordsTo(tc.end)
+
+			// don't do a full Equal as that will compare unexported
+			// fields; instead just check the ones we care about
+
+			assert.Equal(tc.expect.Options, actual.Options)
+			assert.Equal(tc.expect.Text, actual.Text)
+		})
+	}
+}
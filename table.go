@@ -0,0 +1,180 @@
+package rosed
+
+// this file contains the Table type for building up the data of a table one row
+// at a time.
+
+import (
+	"sort"
+
+	"github.com/dekarrin/rosed/internal/manip"
+)
+
+// Table is the data of a table that is built up one row at a time, with an
+// optional header and footer and with rules that separate groups of its rows.
+// It can be drawn with [Table.Render] or inserted into the text of an Editor
+// with [Editor.InsertTableObj].
+//
+// Table is immutable; each of its methods returns a new Table and does not
+// modify the one it is called on. The zero value is a Table with no rows and
+// no header or footer, ready to have rows added to it.
+type Table struct {
+	header []string
+	footer []string
+	rows   [][]string
+
+	// rules are the indexes of the rows in rows that a rule is drawn under.
+	rules []int
+}
+
+// AddRow returns a new Table identical to this one but with a row made of the
+// given cells added after its last row. Rows do not all need to have the same
+// number of cells.
+//
+// This function does not modify the Table it is called on.
+func (t Table) AddRow(cells ...string) Table {
+	row := make([]string, len(cells))
+	copy(row, cells)
+
+	rows := make([][]string, len(t.rows), len(t.rows)+1)
+	copy(rows, t.rows)
+	t.rows = append(rows, row)
+	return t
+}
+
+// AddSeparator returns a new Table identical to this one but with a rule drawn
+// under its last row to separate it from the rows that are added after it. If
+// the Table does not have any rows yet, or there is already a rule under its
+// last row, the returned Table is the same as this one.
+//
+// This function does not modify the Table it is called on.
+func (t Table) AddSeparator() Table {
+	last := len(t.rows) - 1
+	if last < 0 || (len(t.rules) > 0 && t.rules[len(t.rules)-1] == last) {
+		return t
+	}
+
+	rules := make([]int, len(t.rules), len(t.rules)+1)
+	copy(rules, t.rules)
+	t.rules = append(rules, last)
+	return t
+}
+
+// SetHeader returns a new Table identical to this one but with its header row
+// set to the given cells. The header is set off from the rows below it in the
+// same way as the header of a table created with TableHeaders set. If no cells
+// are given, the returned Table has no header.
+//
+// This function does not modify the Table it is called on.
+func (t Table) SetHeader(cells ...string) Table {
+	t.header = nil
+	if len(cells) > 0 {
+		t.header = make([]string, len(cells))
+		copy(t.header, cells)
+	}
+	return t
+}
+
+// SetFooter returns a new Table identical to this one but with its footer row
+// set to the given cells. The footer is drawn after every other row and is set
+// off from them with a rule of its own in the same way as the header. This is
+// useful for a row that gives the totals of the columns. If no cells are given,
+// the returned Table has no footer.
+//
+// This function does not modify the Table it is called on.
+func (t Table) SetFooter(cells ...string) Table {
+	t.footer = nil
+	if len(cells) > 0 {
+		t.footer = make([]string, len(cells))
+		copy(t.footer, cells)
+	}
+	return t
+}
+
+// SortBy returns a new Table identical to this one but with its rows sorted by
+// the cells in the column at index col. less reports whether the cell a must
+// be sorted before the cell b; if it is nil, cells are sorted in increasing
+// order of their strings. A row that does not have a cell at col is sorted as
+// though its cell were the empty string.
+//
+// The sort is stable, so rows with equal cells keep the order they were added
+// in. The rows between each pair of separators are sorted on their own and do
+// not move past the separators. The header and footer are never sorted.
+//
+// This function does not modify the Table it is called on.
+func (t Table) SortBy(col int, less func(a, b string) bool) Table {
+	if less == nil {
+		less = func(a, b string) bool {
+			return a < b
+		}
+	}
+	cell := func(row []string) string {
+		if col < 0 || col >= len(row) {
+			return ""
+		}
+		return row[col]
+	}
+
+	rows := make([][]string, len(t.rows))
+	copy(rows, t.rows)
+
+	groupEnds := make([]int, len(t.rules), len(t.rules)+1)
+	copy(groupEnds, t.rules)
+	groupEnds = append(groupEnds, len(rows)-1)
+
+	start := 0
+	for _, end := range groupEnds {
+		group := rows[start : end+1]
+		sort.SliceStable(group, func(i, j int) bool {
+			return less(cell(group[i]), cell(group[j]))
+		})
+		start = end + 1
+	}
+
+	t.rows = rows
+	return t
+}
+
+// Render creates the text of the table. The result is the same as inserting
+// the Table into an empty Editor with [Editor.InsertTableObjOpts].
+func (t Table) Render(width int, opts Options) string {
+	return Editor{}.InsertTableObjOpts(0, t, width, opts).Text
+}
+
+// spec returns the TableSpec that the Table is laid out from. opts must already
+// have had its defaults set.
+func (t Table) spec(width int, opts Options) manip.TableSpec {
+	var data [][]Cell
+	var rules []int
+
+	addRow := func(row []string) {
+		cells := make([]Cell, len(row))
+		for i := range row {
+			cells[i] = Cell{Text: row[i]}
+		}
+		data = append(data, cells)
+	}
+
+	if t.header != nil {
+		addRow(t.header)
+	}
+	for _, r := range t.rules {
+		rules = append(rules, r+len(data))
+	}
+	for _, row := range t.rows {
+		addRow(row)
+	}
+	if t.footer != nil {
+		addRow(t.footer)
+	}
+
+	return manip.TableSpec{
+		Data:   opts.tableCells(data),
+		Width:  width,
+		Header: t.header != nil,
+		Footer: t.footer != nil,
+		Rules:  rules,
+		Border: opts.TableBorders,
+		Style:  opts.tableStyle(),
+		Fit:    opts.TableFit,
+	}
+}
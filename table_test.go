@@ -0,0 +1,308 @@
+package rosed
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Table_AddRow(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  Table
+		cells  []string
+		expect Table
+	}{
+		{
+			name:   "to empty table",
+			input:  Table{},
+			cells:  []string{"a", "b"},
+			expect: Table{rows: [][]string{{"a", "b"}}},
+		},
+		{
+			name:   "to table with rows",
+			input:  Table{rows: [][]string{{"a", "b"}}},
+			cells:  []string{"c"},
+			expect: Table{rows: [][]string{{"a", "b"}, {"c"}}},
+		},
+		{
+			name:   "no cells",
+			input:  Table{},
+			cells:  nil,
+			expect: Table{rows: [][]string{{}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.AddRow(tc.cells...)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_Table_AddRow_doesNotModify(t *testing.T) {
+	assert := assert.New(t)
+
+	cells := []string{"a", "b"}
+	base := Table{}.AddRow("x")
+	first := base.AddRow(cells...)
+	second := base.AddRow("y")
+	cells[0] = "changed"
+
+	assert.Equal([][]string{{"x"}}, base.rows)
+	assert.Equal([][]string{{"x"}, {"a", "b"}}, first.rows)
+	assert.Equal([][]string{{"x"}, {"y"}}, second.rows)
+}
+
+func Test_Table_AddSeparator(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  Table
+		expect Table
+	}{
+		{
+			name:   "empty table",
+			input:  Table{},
+			expect: Table{},
+		},
+		{
+			name:   "after rows",
+			input:  Table{rows: [][]string{{"a"}, {"b"}}},
+			expect: Table{rows: [][]string{{"a"}, {"b"}}, rules: []int{1}},
+		},
+		{
+			name:   "after existing separator",
+			input:  Table{rows: [][]string{{"a"}, {"b"}}, rules: []int{1}},
+			expect: Table{rows: [][]string{{"a"}, {"b"}}, rules: []int{1}},
+		},
+		{
+			name:   "after more rows",
+			input:  Table{rows: [][]string{{"a"}, {"b"}}, rules: []int{0}},
+			expect: Table{rows: [][]string{{"a"}, {"b"}}, rules: []int{0, 1}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.AddSeparator()
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_Table_SetHeader(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  Table
+		cells  []string
+		expect Table
+	}{
+		{
+			name:   "set header",
+			input:  Table{},
+			cells:  []string{"a", "b"},
+			expect: Table{header: []string{"a", "b"}},
+		},
+		{
+			name:   "replace header",
+			input:  Table{header: []string{"a", "b"}},
+			cells:  []string{"c"},
+			expect: Table{header: []string{"c"}},
+		},
+		{
+			name:   "clear header",
+			input:  Table{header: []string{"a", "b"}},
+			cells:  nil,
+			expect: Table{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.SetHeader(tc.cells...)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_Table_SetFooter(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  Table
+		cells  []string
+		expect Table
+	}{
+		{
+			name:   "set footer",
+			input:  Table{},
+			cells:  []string{"a", "b"},
+			expect: Table{footer: []string{"a", "b"}},
+		},
+		{
+			name:   "replace footer",
+			input:  Table{footer: []string{"a", "b"}},
+			cells:  []string{"c"},
+			expect: Table{footer: []string{"c"}},
+		},
+		{
+			name:   "clear footer",
+			input:  Table{footer: []string{"a", "b"}},
+			cells:  nil,
+			expect: Table{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.SetFooter(tc.cells...)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}
+
+func Test_Table_SortBy(t *testing.T) {
+	numeric := func(a, b string) bool {
+		x, _ := strconv.Atoi(a)
+		y, _ := strconv.Atoi(b)
+		return x < y
+	}
+
+	testCases := []struct {
+		name   string
+		input  Table
+		col    int
+		less   func(a, b string) bool
+		expect [][]string
+	}{
+		{
+			name:   "empty table",
+			input:  Table{},
+			col:    0,
+			expect: [][]string{},
+		},
+		{
+			name:   "default comparison",
+			input:  Table{rows: [][]string{{"c", "1"}, {"a", "2"}, {"b", "3"}}},
+			col:    0,
+			expect: [][]string{{"a", "2"}, {"b", "3"}, {"c", "1"}},
+		},
+		{
+			name:   "custom comparison",
+			input:  Table{rows: [][]string{{"a", "10"}, {"b", "9"}, {"c", "100"}}},
+			col:    1,
+			less:   numeric,
+			expect: [][]string{{"b", "9"}, {"a", "10"}, {"c", "100"}},
+		},
+		{
+			name:   "stable for equal cells",
+			input:  Table{rows: [][]string{{"b", "1"}, {"a", "2"}, {"b", "0"}, {"a", "3"}}},
+			col:    0,
+			expect: [][]string{{"a", "2"}, {"a", "3"}, {"b", "1"}, {"b", "0"}},
+		},
+		{
+			name:   "missing cells sort as empty",
+			input:  Table{rows: [][]string{{"a", "b"}, {"c"}, {"d", "a"}}},
+			col:    1,
+			expect: [][]string{{"c"}, {"d", "a"}, {"a", "b"}},
+		},
+		{
+			name:   "groups sorted separately",
+			input:  Table{rows: [][]string{{"d"}, {"c"}, {"b"}, {"a"}}, rules: []int{1}},
+			col:    0,
+			expect: [][]string{{"c"}, {"d"}, {"a"}, {"b"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.input.SortBy(tc.col, tc.less)
+
+			assert.Equal(tc.expect, actual.rows)
+			assert.Equal(tc.input.rules, actual.rules)
+		})
+	}
+}
+
+func Test_Table_SortBy_doesNotModify(t *testing.T) {
+	assert := assert.New(t)
+
+	base := Table{}.AddRow("b").AddRow("a")
+	sorted := base.SortBy(0, nil)
+
+	assert.Equal([][]string{{"b"}, {"a"}}, base.rows)
+	assert.Equal([][]string{{"a"}, {"b"}}, sorted.rows)
+}
+
+func Test_Table_Render(t *testing.T) {
+	testCases := []struct {
+		name   string
+		table  Table
+		width  int
+		opts   Options
+		expect string
+	}{
+		{
+			name:   "empty table",
+			table:  Table{},
+			width:  10,
+			expect: "",
+		},
+		{
+			name:   "only footer is drawn as a row",
+			table:  Table{}.SetFooter("total", "1"),
+			width:  0,
+			expect: "total  1\n",
+		},
+		{
+			name:  "header and footer with no other rows",
+			table: Table{}.SetHeader("name", "qty").SetFooter("total", "1"),
+			width: 0,
+			opts:  Options{TableBorders: true},
+			expect: "+-------+-----+\n" +
+				"|  NAME | QTY |\n" +
+				"+-------+-----+\n" +
+				"| total | 1   |\n" +
+				"+-------+-----+\n",
+		},
+		{
+			name: "separator before footer is not doubled",
+			table: Table{}.
+				AddRow("a", "1").
+				AddSeparator().
+				SetFooter("b", "2"),
+			width: 0,
+			opts:  Options{TableBorders: true, NoTrailingLineSeparators: true},
+			expect: "+---+---+\n" +
+				"| a | 1 |\n" +
+				"+---+---+\n" +
+				"| b | 2 |\n" +
+				"+---+---+",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual := tc.table.Render(tc.width, tc.opts)
+
+			assert.Equal(tc.expect, actual)
+		})
+	}
+}